@@ -2,76 +2,253 @@ package main
 
 import (
 	"context"
+	"errors"
+	"flag"
 	"log"
+	"net/http"
+	"os/signal"
+	"syscall"
+	"time"
 
+	"github.com/Guilherme-G-Cadilhe/Go-AuctionHouse_Concurrency-Server-Api/configuration/config"
 	"github.com/Guilherme-G-Cadilhe/Go-AuctionHouse_Concurrency-Server-Api/configuration/database/mongodb"
+	"github.com/Guilherme-G-Cadilhe/Go-AuctionHouse_Concurrency-Server-Api/configuration/logger"
+	"github.com/Guilherme-G-Cadilhe/Go-AuctionHouse_Concurrency-Server-Api/configuration/tracing"
+	"github.com/Guilherme-G-Cadilhe/Go-AuctionHouse_Concurrency-Server-Api/internal/clock"
+	"github.com/Guilherme-G-Cadilhe/Go-AuctionHouse_Concurrency-Server-Api/internal/entity/bid_entity"
 	"github.com/Guilherme-G-Cadilhe/Go-AuctionHouse_Concurrency-Server-Api/internal/infra/api/web/controller/auction_controller"
 	"github.com/Guilherme-G-Cadilhe/Go-AuctionHouse_Concurrency-Server-Api/internal/infra/api/web/controller/bid_controller"
+	"github.com/Guilherme-G-Cadilhe/Go-AuctionHouse_Concurrency-Server-Api/internal/infra/api/web/controller/diagnostics_controller"
+	"github.com/Guilherme-G-Cadilhe/Go-AuctionHouse_Concurrency-Server-Api/internal/infra/api/web/controller/health_controller"
 	"github.com/Guilherme-G-Cadilhe/Go-AuctionHouse_Concurrency-Server-Api/internal/infra/api/web/controller/user_controller"
-	"github.com/Guilherme-G-Cadilhe/Go-AuctionHouse_Concurrency-Server-Api/internal/infra/database/auction"
-	"github.com/Guilherme-G-Cadilhe/Go-AuctionHouse_Concurrency-Server-Api/internal/infra/database/bid"
-	"github.com/Guilherme-G-Cadilhe/Go-AuctionHouse_Concurrency-Server-Api/internal/infra/database/user"
+	"github.com/Guilherme-G-Cadilhe/Go-AuctionHouse_Concurrency-Server-Api/internal/infra/api/web/middleware"
+	"github.com/Guilherme-G-Cadilhe/Go-AuctionHouse_Concurrency-Server-Api/internal/infra/database"
+	"github.com/Guilherme-G-Cadilhe/Go-AuctionHouse_Concurrency-Server-Api/internal/infra/mail"
+	"github.com/Guilherme-G-Cadilhe/Go-AuctionHouse_Concurrency-Server-Api/internal/infra/runtime_monitor"
+	"github.com/Guilherme-G-Cadilhe/Go-AuctionHouse_Concurrency-Server-Api/internal/jsontime"
 	"github.com/Guilherme-G-Cadilhe/Go-AuctionHouse_Concurrency-Server-Api/internal/usecase/auction_usecase"
 	"github.com/Guilherme-G-Cadilhe/Go-AuctionHouse_Concurrency-Server-Api/internal/usecase/bid_usecase"
 	"github.com/Guilherme-G-Cadilhe/Go-AuctionHouse_Concurrency-Server-Api/internal/usecase/user_usecase"
 	"github.com/gin-gonic/gin"
 	"github.com/joho/godotenv"
-	"go.mongodb.org/mongo-driver/mongo"
+	"go.uber.org/zap"
 )
 
+// shutdownTimeout limita o encerramento: requests em andamento + flush final dos lances
+const shutdownTimeout = 10 * time.Second
+
+// envFile é lido na inicialização e relido a cada SIGHUP (ver reload.go)
+const envFile = "cmd/auction/.env"
+
 func main() {
 
 	ctx := context.Background()
 	// Log de início
 	log.Println("=== STARTING APPLICATION ===")
-	if err := godotenv.Load("cmd/auction/.env"); err != nil {
+	if err := godotenv.Load(envFile); err != nil {
 		log.Println("Warning: .env file not found, using environment variables from Docker")
 	}
 
-	log.Println("=== CONNECTING TO DATABASE ===")
+	// Configuração lida UMA vez do ambiente e injetada nos construtores
+	cfg := config.LoadConfig()
+
+	// LOG_LEVEL=debug habilita, por exemplo, o tempo de cada query no MongoDB
+	logger.SetLevel(cfg.LogLevel)
+	bid_entity.SetAmountMode(cfg.Bid.AmountMode)
+	bid_entity.SetAmountEpsilon(cfg.Bid.AmountEpsilon)
+	bid_entity.SetRequireUUIDv4(cfg.Bid.RequireUUIDv4)
+	jsontime.SetFormat(cfg.HTTP.TimeFormat)
 
-	databaseConnection, err := mongodb.NewMongoDBConnection(ctx)
+	// OpenTelemetry: antes da conexão com o MongoDB, que registra o monitor de comandos se o tracing estiver ligado
+	shutdownTracing, err := tracing.Init(ctx, cfg.Tracing)
 	if err != nil {
-		log.Fatal(err.Error())
-		return
+		log.Println("Warning: could not initialize tracing, continuing without traces:", err.Error())
+	} else {
+		defer shutdownTracing(context.Background())
 	}
 
-	router := gin.Default()
+	// --in-memory tem o mesmo efeito de IN_MEMORY=true (útil para demonstrações: go run ./cmd/auction --in-memory)
+	inMemory := flag.Bool("in-memory", false, "use in-memory repositories instead of MongoDB")
+	flag.Parse()
+	if *inMemory {
+		cfg.InMemory = true
+	}
 
-	userController, bidController, auctionController := initDependencies(databaseConnection)
+	// Relógio real compartilhado - em testes é trocado por clock.NewFake
+	clk := clock.New()
+
+	var repositories *database.Repositories
+	if cfg.InMemory {
+		log.Println("=== USING IN-MEMORY REPOSITORIES (data is lost on restart) ===")
+		repositories = database.NewInMemoryRepositories(cfg, clk)
+	} else {
+		log.Println("=== CONNECTING TO DATABASE ===")
+
+		databaseConnection, err := mongodb.NewMongoDBConnection(ctx, cfg.Mongo)
+		if err != nil {
+			log.Fatal(err.Error())
+			return
+		}
+		repositories = database.NewMongoRepositories(databaseConnection, cfg, clk)
+	}
 
-	router.GET("/health", func(ctx *gin.Context) {
-		ctx.JSON(200, gin.H{
-			"status": "OK",
-		})
-	})
-	router.GET("/auctions", auctionController.FindAllAuctions)
+	// gin.New() em vez de gin.Default(): o logger em texto do Gin é substituído pelo access log JSON
+	router := gin.New()
+	router.Use(middleware.AccessLog(), middleware.Tracing(), gin.Recovery())
+	router.Use(middleware.RequestTimeout(cfg.HTTP))
+	router.Use(middleware.Gzip(cfg.HTTP))
+	router.Use(middleware.AuthUser())
+
+	userController, bidController, auctionController, healthController, bidUseCase := initDependencies(repositories, cfg, clk)
+
+	// Rede de segurança dos timers de fechamento - iniciada depois do RestoreAuctionCloseSchedules
+	stopReconciler := database.StartAuctionReconciler(repositories.Auction, cfg.Auction.ReconcileInterval, clk)
+
+	// Amostragem de goroutines (RUNTIME_GOROUTINE_WARN_THRESHOLD) - também alimenta o GET /internal/runtime
+	runtimeMonitor := runtime_monitor.NewMonitor(cfg.Runtime, clk)
+	stopRuntimeMonitor := runtimeMonitor.Start()
+
+	router.GET("/health", healthController.Health)
+	// Probes do Kubernetes: liveness (processo de pé) e readiness (bancos e goroutine do batch)
+	router.GET("/healthz", healthController.Liveness)
+	router.GET("/readyz", healthController.Readiness)
+
+	// Rotas operacionais separadas da API pública: token (INTERNAL_API_TOKEN) ou IP (INTERNAL_ALLOWED_IPS)
+	internal := router.Group("/internal", middleware.InternalAccess(cfg.HTTP))
+	internal.GET("/queue", bidController.QueueStats)
+	internal.GET("/config", middleware.RequireAdmin(cfg.Bid.AdminUserIds), diagnostics_controller.NewConfigController(cfg).Config)
+	internal.GET("/runtime", diagnostics_controller.NewRuntimeController(runtimeMonitor).Runtime)
+	internal.POST("/auctions/recompute-winners", middleware.RequireAdmin(cfg.Bid.AdminUserIds), middleware.StrictQuery(cfg.HTTP, "category"), auctionController.RecomputeWinners)
+
+	// StrictQuery lista os query params aceitos pelas listagens (STRICT_QUERY_PARAMS)
+	// MaxResponseSize limita o tamanho da resposta das listagens grandes (LIST_MAX_RESPONSE_BYTES)
+	router.GET("/auctions",
+		middleware.StrictQuery(cfg.HTTP, "status", "category", "productName", "featured", "featured_first", "view"),
+		middleware.MaxResponseSize(cfg.HTTP, "narrow it with status, category, productName, featured or view=summary"),
+		auctionController.FindAllAuctions)
+	router.GET("/auctions/categories/counts", middleware.StrictQuery(cfg.HTTP), auctionController.FindCategoryCounts)
+	router.GET("/conditions", middleware.StrictQuery(cfg.HTTP), auctionController.FindConditions)
+	router.GET("/auctions/winners", middleware.StrictQuery(cfg.HTTP, "category", "from", "to", "page", "page_size"), auctionController.FindAuctionWinners)
 	router.GET("/auctions/:auctionId", auctionController.FindAuctionById)
 	router.GET("/auctions/winner/:auctionId", auctionController.FindWinningBidByAuctionId)
-	router.POST("/auctions", auctionController.CreateAuction)
-
-	router.GET("/bid/:auctionId", bidController.FindBidByAuctionId)
-	router.POST("/bid", bidController.CreateBid)
+	router.GET("/auctions/:auctionId/activity", middleware.StrictQuery(cfg.HTTP), auctionController.FindAuctionActivity)
+	router.GET("/auctions/:auctionId/extensions", middleware.StrictQuery(cfg.HTTP), auctionController.FindAuctionExtensions)
+	router.GET("/auctions/:auctionId/price", middleware.StrictQuery(cfg.HTTP), auctionController.FindAuctionPrice)
+	router.GET("/auctions/:auctionId/watch", middleware.StrictQuery(cfg.HTTP), auctionController.FindAuctionWatchers)
+	router.POST("/auctions/:auctionId/watch", auctionController.WatchAuction)
+	router.DELETE("/auctions/:auctionId/watch", auctionController.UnwatchAuction)
+	// RequireJSON exige Content-Type: application/json nas rotas com corpo (REQUIRE_JSON_BODY)
+	requireJSON := middleware.RequireJSON(cfg.HTTP)
+	// BatchLimit limita corpo e quantidade de itens das rotas em lote (BATCH_MAX_BODY_BYTES / BATCH_MAX_ITEMS)
+	batchLimit := middleware.BatchLimit(cfg.HTTP)
+	router.POST("/auctions", requireJSON, auctionController.CreateAuction)
+	router.POST("/auctions/batch-get", requireJSON, batchLimit, auctionController.FindAuctionsByIds)
+	router.POST("/auctions/:auctionId/extend", requireJSON, auctionController.ExtendAuction)
+	router.POST("/auctions/:auctionId/reopen", requireJSON, auctionController.ReopenAuction)
+	router.PATCH("/auctions/:auctionId/featured", requireJSON, auctionController.SetAuctionFeatured)
+	router.POST("/auctions/:auctionId/winner", requireJSON, auctionController.OverrideAuctionWinner)
+	router.DELETE("/auctions/:auctionId", auctionController.DeleteAuction)
+
+	router.GET("/bid/:auctionId",
+		middleware.StrictQuery(cfg.HTTP, "since", "minAmount", "after", "limit"),
+		middleware.MaxResponseSize(cfg.HTTP, "request a smaller page with limit or narrow it with since or minAmount"),
+		bidController.FindBidByAuctionId)
+	router.GET("/bid/status/:submissionId", bidController.FindBidStatus)
+	router.POST("/bid", requireJSON, bidController.CreateBid)
+	router.POST("/bid/quote", requireJSON, bidController.QuoteBid)
 
 	router.GET("/user/:userId", userController.FindUserById)
-	router.POST("/user", userController.CreateUser)
-
-	err = router.Run(":8080")
-	if err != nil {
-		log.Fatal(err.Error())
+	router.GET("/user/:userId/winning", middleware.StrictQuery(cfg.HTTP, "page", "page_size"), auctionController.FindUserLeadingAuctions)
+	router.POST("/user", requireJSON, userController.CreateUser)
+	router.PATCH("/user/:userId", requireJSON, userController.UpdateUser)
+	router.DELETE("/user/:userId", userController.DeleteUser)
+	router.POST("/users/batch", requireJSON, batchLimit, userController.FindUsersByIds)
+
+	// http.Server em vez de router.Run: permite o Shutdown gracioso
+	// Similar ao server.close() do Node.js dentro de um process.on('SIGTERM')
+	server := &http.Server{Addr: ":8080", Handler: router}
+	serverErr := make(chan error, 1)
+	go func() {
+		serverErr <- server.ListenAndServe()
+	}()
+
+	signalCtx, stopSignals := signal.NotifyContext(ctx, syscall.SIGINT, syscall.SIGTERM)
+	defer stopSignals()
+
+	// SIGHUP relê o .env e aplica LOG_LEVEL e os parâmetros do batch sem reiniciar
+	stopReload := watchReload(cfg, bidUseCase)
+
+	select {
+	case err := <-serverErr:
+		if !errors.Is(err, http.ErrServerClosed) {
+			log.Fatal(err.Error())
+		}
 		return
+	case <-signalCtx.Done():
+	}
+
+	log.Println("=== SHUTTING DOWN ===")
+	shutdownCtx, cancel := context.WithTimeout(context.Background(), shutdownTimeout)
+	defer cancel()
+
+	// Primeiro o servidor: para de aceitar conexões e espera as requests em andamento
+	// Só depois o batch de lances - assim nenhum lance aceito fica sem flush
+	// Cada etapa é logada com duração e resultado ("shutdown phase") - a que não termina mostra onde travou
+	shutdownStart := time.Now()
+	runShutdownPhase(shutdownCtx, "stopping HTTP server", server.Shutdown)
+	runShutdownPhase(shutdownCtx, "stopping background routines", func(context.Context) error {
+		stopReload()
+		stopReconciler()
+		stopRuntimeMonitor()
+		return nil
+	})
+	// O batch registra as próprias etapas: "draining bid channel" e "flushing final batch"
+	runShutdownPhase(shutdownCtx, "closing bid batch", func(ctx context.Context) error {
+		if err := bidUseCase.Close(ctx); err != nil {
+			return err
+		}
+		return nil
+	})
+	if !cfg.InMemory {
+		runShutdownPhase(shutdownCtx, "closing Mongo connection", repositories.Close)
 	}
+	logger.Info("shutdown complete", zap.Duration("duration", time.Since(shutdownStart)))
 }
 
-func initDependencies(database *mongo.Database) (userController *user_controller.UserController, bidController *bid_controller.BidController, auctionController *auction_controller.AuctionController) {
+func initDependencies(repositories *database.Repositories, cfg *config.Config, clk clock.Clock) (userController *user_controller.UserController, bidController *bid_controller.BidController, auctionController *auction_controller.AuctionController, healthController *health_controller.HealthController, bidUseCase bid_usecase.BidUseCaseInterface) {
 
-	auctionRepository := auction.NewAuctionRepository(database)
-	bidRepository := bid.NewBidRepository(database, auctionRepository)
-	userRepository := user.NewUserRepository(database)
+	// MongoDB ou memória - daqui para baixo só as interfaces importam
+	auctionRepository := repositories.Auction
+	bidRepository := repositories.Bid
+	userRepository := repositories.User
 
-	userController = user_controller.NewUserController(user_usecase.NewUserUseCase(userRepository))
-	auctionController = auction_controller.NewAuctionController(auction_usecase.NewAuctionUseCase(auctionRepository, bidRepository))
-	bidController = bid_controller.NewBidController(bid_usecase.NewBidUseCase(bidRepository))
+	// Notificação por e-mail de vencedor e vendedor a cada fechamento
+	closeNotifier := auction_usecase.NewAuctionCloseNotifier(auctionRepository, bidRepository, userRepository, mail.NewMailer(cfg.Mail), cfg.Mail, clk)
+	auctionRepository.OnAuctionClosed(closeNotifier.NotifyAuctionClosed)
+
+	// Timers de fechamento vivem em memória - reagenda os leilões ativos após um restart
+	// Depois do OnAuctionClosed: leilões vencidos durante o downtime fecham aqui e também notificam
+	if err := auctionRepository.RestoreAuctionCloseSchedules(context.Background()); err != nil {
+		log.Println("Warning: could not restore auction close schedules:", err.Error())
+	}
+
+	userController = user_controller.NewUserController(user_usecase.NewUserUseCase(userRepository, bidRepository, cfg.User))
+	auctionController = auction_controller.NewAuctionController(auction_usecase.NewAuctionUseCase(auctionRepository, bidRepository, cfg.Auction, cfg.Bid, clk))
+	bidUseCase = bid_usecase.NewBidUseCase(bidRepository, auctionRepository, cfg.Bid, cfg.Auction, clk)
+	bidController = bid_controller.NewBidController(bidUseCase)
+	// Breakdown do /health e do /readyz: um ping por repositório mais o estado do batch de lances
+	healthController = health_controller.NewHealthController(cfg.HTTP.HealthCheckTimeout,
+		map[string]health_controller.Pinger{
+			"auctions_db": auctionRepository,
+			"bids_db":     bidRepository,
+			"users_db":    userRepository,
+		},
+		map[string]health_controller.DegradedChecker{
+			"bid_batch": bidUseCase,
+		},
+		map[string]health_controller.StallChecker{
+			"bid_batch": bidUseCase,
+		})
 
 	return
 }
@@ -0,0 +1,96 @@
+package main
+
+import (
+	"os"
+	"os/signal"
+	"syscall"
+
+	"github.com/Guilherme-G-Cadilhe/Go-AuctionHouse_Concurrency-Server-Api/configuration/config"
+	"github.com/Guilherme-G-Cadilhe/Go-AuctionHouse_Concurrency-Server-Api/configuration/logger"
+	"github.com/Guilherme-G-Cadilhe/Go-AuctionHouse_Concurrency-Server-Api/internal/usecase/bid_usecase"
+	"github.com/joho/godotenv"
+	"go.uber.org/zap"
+)
+
+// liveReloadKeys são as chaves (formato do GET /internal/config) aplicadas no SIGHUP sem restart
+// Qualquer outra mudança é só logada: conexões, timers e rotas já foram montados com o valor antigo
+var liveReloadKeys = map[string]bool{
+	"log_level":                   true,
+	"bid.max_batch_size":          true,
+	"bid.batch_insert_interval":   true,
+	"bid.batch_idle_interval":     true,
+	"bid.batch_failure_threshold": true,
+	"bid.slow_flush_threshold":    true,
+}
+
+// watchReload recarrega a configuração a cada SIGHUP (kill -HUP <pid>)
+// No Node.js seria um process.on('SIGHUP') relendo o .env
+// Retorna a função que para de observar o sinal - chamada no encerramento
+func watchReload(current *config.Config, bidUseCase bid_usecase.BidUseCaseInterface) (stop func()) {
+	hangups := make(chan os.Signal, 1)
+	signal.Notify(hangups, syscall.SIGHUP)
+	done := make(chan struct{})
+
+	// Cópia própria: o *Config compartilhado é lido pelos handlers e nunca é alterado
+	applied := *current
+
+	go func() {
+		for {
+			select {
+			case <-hangups:
+				applied = reloadConfig(applied, bidUseCase)
+			case <-done:
+				return
+			}
+		}
+	}()
+
+	return func() {
+		signal.Stop(hangups)
+		close(done)
+	}
+}
+
+// reloadConfig relê o ambiente, aplica o que muda em runtime e retorna a configuração efetiva
+// Overload (e não Load) porque as variáveis do .env já estão no processo desde o início
+// Variáveis do Docker/ambiente não mudam em um processo em execução - só o .env é relido
+func reloadConfig(applied config.Config, bidUseCase bid_usecase.BidUseCaseInterface) config.Config {
+	if err := godotenv.Overload(envFile); err != nil && !os.IsNotExist(err) {
+		logger.Error("error trying to reload .env file", err)
+	}
+
+	next := config.LoadConfig()
+	// --in-memory vem da linha de comando, não do ambiente
+	next.InMemory = applied.InMemory
+
+	var live, restartRequired []string
+	for _, key := range applied.ChangedKeys(next) {
+		if liveReloadKeys[key] {
+			live = append(live, key)
+		} else {
+			restartRequired = append(restartRequired, key)
+		}
+	}
+
+	if len(live) > 0 {
+		logger.SetLevel(next.LogLevel)
+		applied.LogLevel = next.LogLevel
+
+		bidUseCase.ReloadBatchConfig(next.Bid)
+		applied.Bid.MaxBatchSize = next.Bid.MaxBatchSize
+		applied.Bid.BatchInsertInterval = next.Bid.BatchInsertInterval
+		applied.Bid.BatchIdleInterval = next.Bid.BatchIdleInterval
+		applied.Bid.BatchFailureThreshold = next.Bid.BatchFailureThreshold
+		applied.Bid.SlowFlushThreshold = next.Bid.SlowFlushThreshold
+	}
+
+	// Mudanças sem efeito continuam aparecendo a cada SIGHUP até o restart
+	if len(restartRequired) > 0 {
+		logger.Warn("config changes require restart", zap.Strings("keys", restartRequired))
+	}
+	logger.Info("config reloaded",
+		zap.Strings("applied", live),
+		zap.String("log_level", logger.LevelName()))
+
+	return applied
+}
@@ -0,0 +1,27 @@
+package main
+
+import (
+	"context"
+	"time"
+
+	"github.com/Guilherme-G-Cadilhe/Go-AuctionHouse_Concurrency-Server-Api/configuration/logger"
+	"github.com/Guilherme-G-Cadilhe/Go-AuctionHouse_Concurrency-Server-Api/configuration/tracing"
+	"go.opentelemetry.io/otel/codes"
+)
+
+// runShutdownPhase executa uma etapa do encerramento medindo a duração
+// Cada etapa vira um log "shutdown phase" (phase, duration, outcome) e, com o tracing ligado, um span
+// Os spans são exportados no shutdownTracing do defer do main - depois de todas as etapas
+func runShutdownPhase(ctx context.Context, phase string, run func(ctx context.Context) error) error {
+	ctx, span := tracing.Start(ctx, "shutdown: "+phase)
+	defer span.End()
+
+	start := time.Now()
+	err := run(ctx)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+	logger.ShutdownPhase(phase, time.Since(start), err)
+	return err
+}
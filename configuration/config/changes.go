@@ -0,0 +1,45 @@
+package config
+
+import (
+	"encoding/json"
+	"reflect"
+	"sort"
+)
+
+// ChangedKeys lista as chaves com valor diferente entre duas configurações, no formato do
+// GET /internal/config (ex: "log_level", "bid.max_batch_size") - usado no reload por SIGHUP
+// A comparação usa o Diagnostics: segredos entram redigidos, então só a troca entre vazio e definido aparece
+func (c *Config) ChangedKeys(other *Config) []string {
+	before := flattenDiagnostics(c.Diagnostics())
+	after := flattenDiagnostics(other.Diagnostics())
+
+	var changed []string
+	for key, value := range after {
+		if !reflect.DeepEqual(before[key], value) {
+			changed = append(changed, key)
+		}
+	}
+	sort.Strings(changed)
+	return changed
+}
+
+// flattenDiagnostics transforma o JSON aninhado em chaves "secao.campo"
+// Passar pelo JSON reaproveita as tags e as conversões do Diagnostics (durações em texto)
+func flattenDiagnostics(diagnostics Diagnostics) map[string]any {
+	encoded, _ := json.Marshal(diagnostics)
+	var tree map[string]any
+	_ = json.Unmarshal(encoded, &tree)
+
+	flat := make(map[string]any)
+	for section, value := range tree {
+		fields, ok := value.(map[string]any)
+		if !ok {
+			flat[section] = value
+			continue
+		}
+		for field, fieldValue := range fields {
+			flat[section+"."+field] = fieldValue
+		}
+	}
+	return flat
+}
@@ -0,0 +1,442 @@
+// Package config centraliza a leitura das variáveis de ambiente da aplicação
+// No Node.js seria o equivalente a um módulo "config.js" que lê o process.env uma única vez
+// Os componentes recebem a configuração já tipada nos construtores - sem os.Getenv espalhado
+package config
+
+import (
+	"log"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Nomes das variáveis de ambiente
+// Em Go, é uma boa prática usar constantes para strings que não mudam
+const (
+	LOG_LEVEL = "LOG_LEVEL"
+	IN_MEMORY = "IN_MEMORY"
+
+	MONGODB_URI                      = "MONGODB_URI"
+	MONGODB_DATABASE                 = "MONGODB_DATABASE"
+	MONGODB_TLS_ENABLED              = "MONGODB_TLS_ENABLED"
+	MONGODB_TLS_CA_FILE              = "MONGODB_TLS_CA_FILE"
+	MONGODB_TLS_INSECURE_SKIP_VERIFY = "MONGODB_TLS_INSECURE_SKIP_VERIFY"
+
+	AUCTION_INTERVAL           = "AUCTION_INTERVAL"
+	AUCTION_REOPEN_GRACE       = "AUCTION_REOPEN_GRACE"
+	AUCTION_MIN_DURATION       = "AUCTION_MIN_DURATION"
+	AUCTION_MAX_DURATION       = "AUCTION_MAX_DURATION"
+	AUCTION_PERSIST_WINNER     = "AUCTION_PERSIST_WINNER"
+	AUCTION_CLOSE_SKEW         = "AUCTION_CLOSE_SKEW"
+	MAX_BIDS_PER_AUCTION       = "MAX_BIDS_PER_AUCTION"
+	AUCTION_MIN_BID_INCREMENT  = "AUCTION_MIN_BID_INCREMENT"
+	MIN_BID_INCREMENT          = "MIN_BID_INCREMENT"
+	AUCTION_STARTING_PRICE     = "AUCTION_STARTING_PRICE"
+	AUCTION_DEFAULT_CONDITION  = "AUCTION_DEFAULT_CONDITION"
+	AUCTION_UNFILTERED_LIMIT   = "AUCTION_UNFILTERED_LIMIT"
+	AUCTION_MAX_EXTENSIONS     = "AUCTION_MAX_EXTENSIONS"
+	FIRST_BID_POLICY           = "FIRST_BID_POLICY"
+	AUCTION_RECONCILE_INTERVAL = "AUCTION_RECONCILE_INTERVAL"
+
+	CREATE_AUCTION_BATCH          = "CREATE_AUCTION_BATCH"
+	CREATE_AUCTION_BATCH_WINDOW   = "CREATE_AUCTION_BATCH_WINDOW"
+	CREATE_AUCTION_BATCH_MAX_SIZE = "CREATE_AUCTION_BATCH_MAX_SIZE"
+
+	AUCTION_PRODUCT_NAME_MIN_LENGTH = "AUCTION_PRODUCT_NAME_MIN_LENGTH"
+	AUCTION_PRODUCT_NAME_MAX_LENGTH = "AUCTION_PRODUCT_NAME_MAX_LENGTH"
+	AUCTION_CATEGORY_MIN_LENGTH     = "AUCTION_CATEGORY_MIN_LENGTH"
+	AUCTION_CATEGORY_MAX_LENGTH     = "AUCTION_CATEGORY_MAX_LENGTH"
+	AUCTION_DESCRIPTION_MIN_LENGTH  = "AUCTION_DESCRIPTION_MIN_LENGTH"
+	AUCTION_DESCRIPTION_MAX_LENGTH  = "AUCTION_DESCRIPTION_MAX_LENGTH"
+
+	AUCTION_DESCRIPTION_ALLOWED_TAGS = "AUCTION_DESCRIPTION_ALLOWED_TAGS"
+	AUCTION_CONDITIONS               = "AUCTION_CONDITIONS"
+
+	AMOUNT_MODE             = "AMOUNT_MODE"
+	AMOUNT_EPSILON          = "AMOUNT_EPSILON"
+	BATCH_INSERT_INTERVAL   = "BATCH_INSERT_INTERVAL"
+	BATCH_IDLE_INTERVAL     = "BATCH_IDLE_INTERVAL"
+	BATCH_NEAR_END_FLUSH    = "BATCH_NEAR_END_FLUSH"
+	MAX_BATCH_SIZE          = "MAX_BATCH_SIZE"
+	BATCH_FAILURE_THRESHOLD = "BATCH_FAILURE_THRESHOLD"
+	SLOW_FLUSH_THRESHOLD    = "SLOW_FLUSH_THRESHOLD"
+	BATCH_STALL_TIMEOUT     = "BATCH_STALL_TIMEOUT"
+	FAILOVER_BACKOFF        = "FAILOVER_BACKOFF"
+	FAILOVER_MAX_REQUEUES   = "FAILOVER_MAX_REQUEUES"
+	MAX_BIDS_PER_WINDOW     = "MAX_BIDS_PER_WINDOW"
+	BID_RATE_WINDOW         = "BID_RATE_WINDOW"
+	BID_COOLDOWN            = "BID_COOLDOWN"
+	BID_STATUS_TTL          = "BID_STATUS_TTL"
+	GUEST_BIDS_ENABLED      = "GUEST_BIDS_ENABLED"
+	BID_RECEIPT_SECRET      = "BID_RECEIPT_SECRET"
+	MASK_BIDDER_IDS         = "MASK_BIDDER_IDS"
+	BIDDER_MASK_SECRET      = "BIDDER_MASK_SECRET"
+	ADMIN_USER_IDS          = "ADMIN_USER_IDS"
+
+	BID_MAX_AMOUNT_MULTIPLIER = "BID_MAX_AMOUNT_MULTIPLIER"
+	BID_ID_REQUIRE_UUID_V4    = "BID_ID_REQUIRE_UUID_V4"
+
+	LIST_MAX_RESPONSE_BYTES = "LIST_MAX_RESPONSE_BYTES"
+
+	ANONYMIZE_DELETED_USER_BIDS = "ANONYMIZE_DELETED_USER_BIDS"
+
+	GZIP_MIN_SIZE        = "GZIP_MIN_SIZE"
+	STRICT_QUERY_PARAMS  = "STRICT_QUERY_PARAMS"
+	REQUIRE_JSON_BODY    = "REQUIRE_JSON_BODY"
+	BATCH_MAX_ITEMS      = "BATCH_MAX_ITEMS"
+	BATCH_MAX_BODY_BYTES = "BATCH_MAX_BODY_BYTES"
+	INTERNAL_API_TOKEN   = "INTERNAL_API_TOKEN"
+	INTERNAL_ALLOWED_IPS = "INTERNAL_ALLOWED_IPS"
+	HEALTH_CHECK_TIMEOUT = "HEALTH_CHECK_TIMEOUT"
+	REQUEST_TIMEOUT      = "REQUEST_TIMEOUT"
+	JSON_TIME_FORMAT     = "JSON_TIME_FORMAT"
+
+	SMTP_HOST          = "SMTP_HOST"
+	SMTP_PORT          = "SMTP_PORT"
+	SMTP_USERNAME      = "SMTP_USERNAME"
+	SMTP_PASSWORD      = "SMTP_PASSWORD"
+	SMTP_FROM          = "SMTP_FROM"
+	MAIL_MAX_RETRIES   = "MAIL_MAX_RETRIES"
+	MAIL_RETRY_BACKOFF = "MAIL_RETRY_BACKOFF"
+
+	// Nomes padrão do OpenTelemetry - os mesmos lidos por collectors e SDKs de outras linguagens
+	OTEL_EXPORTER_OTLP_ENDPOINT = "OTEL_EXPORTER_OTLP_ENDPOINT"
+	OTEL_SERVICE_NAME           = "OTEL_SERVICE_NAME"
+
+	RUNTIME_GOROUTINE_WARN_THRESHOLD = "RUNTIME_GOROUTINE_WARN_THRESHOLD"
+	RUNTIME_MONITOR_INTERVAL         = "RUNTIME_MONITOR_INTERVAL"
+)
+
+// Config agrupa toda a configuração da aplicação
+// Cada seção é passada apenas para os componentes que precisam dela
+type Config struct {
+	LogLevel string
+	InMemory bool // true usa repositórios em memória (sem MongoDB) - também via flag --in-memory
+	Mongo    MongoConfig
+	Auction  AuctionConfig
+	Bid      BidConfig
+	User     UserConfig
+	HTTP     HTTPConfig
+	Mail     MailConfig
+	Tracing  TracingConfig
+	Runtime  RuntimeConfig
+}
+
+// MongoConfig é usada por mongodb.NewMongoDBConnection
+type MongoConfig struct {
+	URI                   string
+	Database              string
+	TLSEnabled            bool   // TLS desligado por padrão
+	TLSCAFile             string // Vazio usa os certificados do sistema
+	TLSInsecureSkipVerify bool   // APENAS para desenvolvimento
+}
+
+// AuctionConfig é usada pelo repositório e pelo caso de uso de leilões
+type AuctionConfig struct {
+	Interval          time.Duration // Duração padrão de um leilão
+	ReopenGraceWindow time.Duration // Prazo após o fechamento em que o leilão ainda pode ser reaberto
+	MinDuration       time.Duration // Menor tempo aberto aceito na reabertura (0 = sem mínimo)
+	MaxDuration       time.Duration // Maior tempo aberto aceito na extensão e na reabertura (0 = sem máximo)
+	PersistWinner     bool          // Grava o lance vencedor no leilão ao fechar (false = sempre calcula sob demanda)
+	CloseSkew         time.Duration // Tolerância somada ao fim efetivo - lances e fechamento usam o mesmo deadline
+	MaxBidsPerAuction int           // Lances gravados por leilão; acima disso só entram lances que superam o vencedor (0 = ilimitado)
+	MinBidIncrement   float64       // Incremento sugerido sobre o lance vencedor (mesma unidade do AMOUNT_MODE)
+	RequiredIncrement float64       // Quanto um lance precisa superar o vencedor para ser aceito (0 = basta ser maior)
+	StartingPrice     float64       // Lance mínimo sugerido quando o leilão ainda não tem lances
+	DefaultCondition  int           // Condição usada quando a criação omite "condition" - deve estar no AUCTION_CONDITIONS
+	UnfilteredLimit   int           // Máximo de leilões no GET /auctions sem filtros; acima disso responde 400 (0 = sem limite)
+	MaxExtensions     int           // Extensões de fim permitidas por leilão (0 = ilimitado)
+	FirstBidPolicy    string        // Mínimo do primeiro lance: any_positive (padrão), meet_start ou meet_start_plus_increment
+	ReconcileInterval time.Duration // Intervalo da varredura que fecha leilões Active vencidos (0 = desligada)
+
+	// Criação em lote: inserts de leilões criados juntos viram um InsertMany (desligado por padrão)
+	CreateBatch        bool
+	CreateBatchWindow  time.Duration // Espera máxima do primeiro leilão da janela antes do InsertMany
+	CreateBatchMaxSize int           // Leilões por InsertMany - ao atingir, grava sem esperar a janela
+
+	// Limites de tamanho (em caracteres) dos campos texto - Max 0 = sem limite
+	ProductNameMinLength int
+	ProductNameMaxLength int
+	CategoryMinLength    int
+	CategoryMaxLength    int
+	DescriptionMinLength int
+	DescriptionMaxLength int
+
+	// Tags HTML mantidas na descrição (sem atributos) - vazio remove todo o HTML
+	DescriptionAllowedTags []string
+
+	// Condições aceitas na criação, no formato "<valor>:<nome>" - vazio = 0:new, 1:used e 2:refurbished
+	Conditions []string
+}
+
+// BidConfig é usada pelo caso de uso de lances (batch, rate limit e comprovantes)
+type BidConfig struct {
+	AmountMode            string        // "float" (padrão) ou "cents"
+	AmountEpsilon         float64       // Tolerância das comparações de valores em modo float (abaixo de meio centavo)
+	MaxBatchSize          int           // Tamanho máximo do batch
+	BatchInsertInterval   time.Duration // Intervalo entre flushes
+	BatchIdleInterval     time.Duration // Intervalo após um flush vazio (0 = não re-arma até chegar lance)
+	NearEndFlushWindow    time.Duration // Lances de leilões a menos disso do fim são gravados na hora (0 = desabilitado)
+	BatchFailureThreshold int           // Flushes seguidos com erro até ficar "degraded"
+	SlowFlushThreshold    time.Duration // Flushes mais demorados geram um warning (0 desabilita)
+	BatchStallTimeout     time.Duration // Goroutine do batch sem girar por mais que isso = GET /readyz 503 (0 desabilita)
+	FailoverBackoff       time.Duration // Pausa dos flushes após um failover do MongoDB
+	FailoverMaxRequeues   int           // Vezes que um lance perdido em failover volta ao batch (0 = descarta)
+	MaxBidsPerWindow      int           // 0 desabilita o rate limit
+	RateWindow            time.Duration
+	Cooldown              time.Duration // Espera mínima entre lances do usuário no mesmo leilão (0 = desabilitado)
+	StatusTTL             time.Duration // Quanto tempo o resultado de cada lance fica no GET /bid/status (0 = desabilitado)
+	MaxAmountMultiplier   float64       // Lance acima de N x a referência exige confirm=true (<= 1 = desabilitado)
+	GuestBidsEnabled      bool          // Lances de convidados (apelido) nos leilões com allow_guest_bids
+	RequireUUIDv4         bool          // Ids de lance enviados pelo cliente precisam ser UUID v4 (false aceita qualquer versão)
+	ReceiptSecret         string        // Vazio desabilita o comprovante assinado
+	MaskBidderIds         bool          // true anonimiza o user_id nas listagens de todos os leilões (sealed sempre anonimiza)
+	BidderMaskSecret      string        // Chave do HMAC que gera os ids anonimizados
+	AdminUserIds          []string      // Usuários que sempre veem o user_id real dos lances e acessam GET /internal/config
+}
+
+// UserConfig é usada pelo caso de uso de usuários
+type UserConfig struct {
+	AnonymizeDeletedUserBids bool
+}
+
+// HTTPConfig é usada pelos middlewares da API
+type HTTPConfig struct {
+	GzipMinSize       int  // Respostas menores (bytes) não são comprimidas
+	StrictQueryParams bool // true rejeita (400) query params desconhecidos nas listagens
+	RequireJSONBody   bool // true rejeita (415) corpos sem Content-Type: application/json
+
+	// Limites das rotas em lote (POST /auctions/batch-get, POST /users/batch), checados antes de processar
+	BatchMaxItems     int   // Itens por request - acima disso 400
+	BatchMaxBodyBytes int64 // Tamanho do corpo - acima disso 413
+
+	// Tamanho máximo (bytes) da resposta de GET /auctions e GET /bid/:auctionId - acima disso 400 (0 = sem limite)
+	ListMaxResponseBytes int64
+
+	// Acesso às rotas /internal/*: token no header X-Internal-Token OU IP na allowlist
+	// Os dois vazios mantêm as rotas abertas
+	InternalToken      string
+	InternalAllowedIPs []string // IPs ou faixas CIDR
+
+	HealthCheckTimeout time.Duration // Prazo do ping de cada repositório no GET /health
+	RequestTimeout     time.Duration // Prazo do context de cada request - banco que não responde vira 504 (0 = sem prazo)
+	TimeFormat         string        // Horários das respostas de leilões e lances: rfc3339 (padrão), unix ou um layout do Go
+}
+
+// RuntimeConfig é usada pelo monitor de goroutines e pelo GET /internal/runtime
+type RuntimeConfig struct {
+	GoroutineWarnThreshold int           // Acima disso o monitor loga um warning (0 = desabilitado)
+	MonitorInterval        time.Duration // Frequência da amostragem do runtime.NumGoroutine
+}
+
+// TracingConfig é usada por tracing.Init
+type TracingConfig struct {
+	OTLPEndpoint string // Collector OTLP/HTTP (ex: http://localhost:4318) - vazio desliga o tracing (tracer no-op)
+	ServiceName  string // service.name exibido no Jaeger/Tempo
+}
+
+// MailConfig é usada pelo Mailer e pelas notificações de fechamento de leilão
+type MailConfig struct {
+	SMTPHost     string // Vazio usa o LogMailer (e-mails apenas logados)
+	SMTPPort     int
+	SMTPUsername string
+	SMTPPassword string
+	From         string
+	MaxRetries   int           // Novas tentativas após a primeira falha
+	RetryBackoff time.Duration // Espera entre tentativas (multiplicada pelo número da tentativa)
+}
+
+// LoadConfig lê as variáveis de ambiente uma única vez e aplica os defaults
+// Valores inválidos caem no default (mesmo comportamento dos antigos getX())
+func LoadConfig() *Config {
+	return &Config{
+		LogLevel: os.Getenv(LOG_LEVEL),
+		InMemory: getBool(IN_MEMORY, false),
+		Mongo: MongoConfig{
+			URI:                   os.Getenv(MONGODB_URI),
+			Database:              os.Getenv(MONGODB_DATABASE),
+			TLSEnabled:            getBool(MONGODB_TLS_ENABLED, false),
+			TLSCAFile:             os.Getenv(MONGODB_TLS_CA_FILE),
+			TLSInsecureSkipVerify: getBool(MONGODB_TLS_INSECURE_SKIP_VERIFY, false),
+		},
+		Auction: AuctionConfig{
+			Interval:          getDuration(AUCTION_INTERVAL, 5*time.Minute),
+			ReopenGraceWindow: getDuration(AUCTION_REOPEN_GRACE, time.Hour),
+			MinDuration:       getNonNegativeDuration(AUCTION_MIN_DURATION, 0),
+			MaxDuration:       getNonNegativeDuration(AUCTION_MAX_DURATION, 30*24*time.Hour),
+			PersistWinner:     getBool(AUCTION_PERSIST_WINNER, true),
+			CloseSkew:         getNonNegativeDuration(AUCTION_CLOSE_SKEW, 0),
+			MaxBidsPerAuction: getNonNegativeInt(MAX_BIDS_PER_AUCTION, 0),
+			MinBidIncrement:   getSuggestedBidIncrement(),
+			RequiredIncrement: getNonNegativeFloat(MIN_BID_INCREMENT, 0),
+			StartingPrice:     getPositiveFloat(AUCTION_STARTING_PRICE, 1),
+			DefaultCondition:  getNonNegativeInt(AUCTION_DEFAULT_CONDITION, 0),
+			UnfilteredLimit:   getNonNegativeInt(AUCTION_UNFILTERED_LIMIT, 500),
+			MaxExtensions:     getNonNegativeInt(AUCTION_MAX_EXTENSIONS, 0),
+			FirstBidPolicy:    getString(FIRST_BID_POLICY, "any_positive"),
+			ReconcileInterval: getNonNegativeDuration(AUCTION_RECONCILE_INTERVAL, time.Minute),
+
+			CreateBatch:        getBool(CREATE_AUCTION_BATCH, false),
+			CreateBatchWindow:  getDuration(CREATE_AUCTION_BATCH_WINDOW, 20*time.Millisecond),
+			CreateBatchMaxSize: getPositiveInt(CREATE_AUCTION_BATCH_MAX_SIZE, 100),
+
+			ProductNameMinLength: getNonNegativeInt(AUCTION_PRODUCT_NAME_MIN_LENGTH, 2),
+			ProductNameMaxLength: getNonNegativeInt(AUCTION_PRODUCT_NAME_MAX_LENGTH, 0),
+			CategoryMinLength:    getNonNegativeInt(AUCTION_CATEGORY_MIN_LENGTH, 3),
+			CategoryMaxLength:    getNonNegativeInt(AUCTION_CATEGORY_MAX_LENGTH, 0),
+			DescriptionMinLength: getNonNegativeInt(AUCTION_DESCRIPTION_MIN_LENGTH, 10),
+			DescriptionMaxLength: getNonNegativeInt(AUCTION_DESCRIPTION_MAX_LENGTH, 200),
+
+			DescriptionAllowedTags: getList(AUCTION_DESCRIPTION_ALLOWED_TAGS),
+			Conditions:             getList(AUCTION_CONDITIONS),
+		},
+		Bid: BidConfig{
+			AmountMode:            os.Getenv(AMOUNT_MODE),
+			AmountEpsilon:         getPositiveFloat(AMOUNT_EPSILON, 1e-9),
+			MaxBatchSize:          getPositiveInt(MAX_BATCH_SIZE, 5),
+			BatchInsertInterval:   getDuration(BATCH_INSERT_INTERVAL, 3*time.Minute),
+			BatchIdleInterval:     getNonNegativeDuration(BATCH_IDLE_INTERVAL, 0),
+			NearEndFlushWindow:    getNonNegativeDuration(BATCH_NEAR_END_FLUSH, 2*time.Second),
+			BatchFailureThreshold: getPositiveInt(BATCH_FAILURE_THRESHOLD, 3),
+			SlowFlushThreshold:    getNonNegativeDuration(SLOW_FLUSH_THRESHOLD, 2*time.Second),
+			BatchStallTimeout:     getNonNegativeDuration(BATCH_STALL_TIMEOUT, time.Minute),
+			FailoverBackoff:       getNonNegativeDuration(FAILOVER_BACKOFF, 5*time.Second),
+			FailoverMaxRequeues:   getNonNegativeInt(FAILOVER_MAX_REQUEUES, 3),
+			MaxBidsPerWindow:      getNonNegativeInt(MAX_BIDS_PER_WINDOW, 0),
+			RateWindow:            getDuration(BID_RATE_WINDOW, time.Second),
+			Cooldown:              getNonNegativeDuration(BID_COOLDOWN, 0),
+			StatusTTL:             getNonNegativeDuration(BID_STATUS_TTL, 10*time.Minute),
+			MaxAmountMultiplier:   getPositiveFloat(BID_MAX_AMOUNT_MULTIPLIER, 0),
+			GuestBidsEnabled:      getBool(GUEST_BIDS_ENABLED, false),
+			RequireUUIDv4:         getBool(BID_ID_REQUIRE_UUID_V4, true),
+			ReceiptSecret:         os.Getenv(BID_RECEIPT_SECRET),
+			MaskBidderIds:         getBool(MASK_BIDDER_IDS, false),
+			BidderMaskSecret:      os.Getenv(BIDDER_MASK_SECRET),
+			AdminUserIds:          getList(ADMIN_USER_IDS),
+		},
+		User: UserConfig{
+			AnonymizeDeletedUserBids: getBool(ANONYMIZE_DELETED_USER_BIDS, false),
+		},
+		HTTP: HTTPConfig{
+			GzipMinSize:       getNonNegativeInt(GZIP_MIN_SIZE, 1024),
+			StrictQueryParams: getBool(STRICT_QUERY_PARAMS, false),
+			RequireJSONBody:   getBool(REQUIRE_JSON_BODY, false),
+			BatchMaxItems:     getPositiveInt(BATCH_MAX_ITEMS, 100),
+			BatchMaxBodyBytes: int64(getPositiveInt(BATCH_MAX_BODY_BYTES, 64*1024)),
+
+			ListMaxResponseBytes: int64(getNonNegativeInt(LIST_MAX_RESPONSE_BYTES, 0)),
+
+			InternalToken:      os.Getenv(INTERNAL_API_TOKEN),
+			InternalAllowedIPs: getList(INTERNAL_ALLOWED_IPS),
+
+			HealthCheckTimeout: getDuration(HEALTH_CHECK_TIMEOUT, 2*time.Second),
+			RequestTimeout:     getNonNegativeDuration(REQUEST_TIMEOUT, 10*time.Second),
+			TimeFormat:         getString(JSON_TIME_FORMAT, "rfc3339"),
+		},
+		Mail: MailConfig{
+			SMTPHost:     os.Getenv(SMTP_HOST),
+			SMTPPort:     getPositiveInt(SMTP_PORT, 587),
+			SMTPUsername: os.Getenv(SMTP_USERNAME),
+			SMTPPassword: os.Getenv(SMTP_PASSWORD),
+			From:         getString(SMTP_FROM, "no-reply@auctionhouse.local"),
+			MaxRetries:   getNonNegativeInt(MAIL_MAX_RETRIES, 3),
+			RetryBackoff: getDuration(MAIL_RETRY_BACKOFF, 5*time.Second),
+		},
+		Tracing: TracingConfig{
+			OTLPEndpoint: os.Getenv(OTEL_EXPORTER_OTLP_ENDPOINT),
+			ServiceName:  getString(OTEL_SERVICE_NAME, "auction-house"),
+		},
+		Runtime: RuntimeConfig{
+			GoroutineWarnThreshold: getNonNegativeInt(RUNTIME_GOROUTINE_WARN_THRESHOLD, 10000),
+			MonitorInterval:        getDuration(RUNTIME_MONITOR_INTERVAL, 30*time.Second),
+		},
+	}
+}
+
+func getString(key, defaultValue string) string {
+	if value := os.Getenv(key); value != "" {
+		return value
+	}
+	return defaultValue
+}
+
+// getList lê uma lista separada por vírgula, ignorando espaços e itens vazios
+func getList(key string) []string {
+	var values []string
+	for _, value := range strings.Split(os.Getenv(key), ",") {
+		if value = strings.TrimSpace(value); value != "" {
+			values = append(values, value)
+		}
+	}
+	return values
+}
+
+func getDuration(key string, defaultValue time.Duration) time.Duration {
+	duration, err := time.ParseDuration(os.Getenv(key))
+	if err != nil || duration <= 0 {
+		return defaultValue
+	}
+	return duration
+}
+
+func getNonNegativeDuration(key string, defaultValue time.Duration) time.Duration {
+	duration, err := time.ParseDuration(os.Getenv(key))
+	if err != nil || duration < 0 {
+		return defaultValue
+	}
+	return duration
+}
+
+func getPositiveInt(key string, defaultValue int) int {
+	value, err := strconv.Atoi(os.Getenv(key))
+	if err != nil || value <= 0 {
+		return defaultValue
+	}
+	return value
+}
+
+func getNonNegativeInt(key string, defaultValue int) int {
+	value, err := strconv.Atoi(os.Getenv(key))
+	if err != nil || value < 0 {
+		return defaultValue
+	}
+	return value
+}
+
+func getPositiveFloat(key string, defaultValue float64) float64 {
+	value, err := strconv.ParseFloat(os.Getenv(key), 64)
+	if err != nil || value <= 0 {
+		return defaultValue
+	}
+	return value
+}
+
+// getSuggestedBidIncrement lê o AUCTION_MIN_BID_INCREMENT sem deixá-lo abaixo do MIN_BID_INCREMENT
+// Uma sugestão menor que o exigido faria o next_minimum_bid ser rejeitado no flush - o ajuste é avisado no log
+func getSuggestedBidIncrement() float64 {
+	suggested := getPositiveFloat(AUCTION_MIN_BID_INCREMENT, 1)
+	required := getNonNegativeFloat(MIN_BID_INCREMENT, 0)
+	if suggested < required {
+		log.Printf("Warning: %s=%v is below %s=%v, suggesting %v in next_minimum_bid",
+			AUCTION_MIN_BID_INCREMENT, suggested, MIN_BID_INCREMENT, required, required)
+		return required
+	}
+	return suggested
+}
+
+func getNonNegativeFloat(key string, defaultValue float64) float64 {
+	value, err := strconv.ParseFloat(os.Getenv(key), 64)
+	if err != nil || value < 0 {
+		return defaultValue
+	}
+	return value
+}
+
+func getBool(key string, defaultValue bool) bool {
+	value, err := strconv.ParseBool(os.Getenv(key))
+	if err != nil {
+		return defaultValue
+	}
+	return value
+}
@@ -0,0 +1,267 @@
+package config
+
+import (
+	"net/url"
+)
+
+// redactedValue substitui segredos configurados no diagnóstico
+// Segredos vazios continuam vazios - assim dá para ver que a variável não foi definida
+const redactedValue = "[REDACTED]"
+
+// Diagnostics é a configuração efetiva exposta em GET /internal/config
+// Não é o Config serializado direto: durações viram texto ("3m0s") e segredos nunca saem do processo
+// No Node.js seria um "toJSON()" que omite process.env sensíveis
+type Diagnostics struct {
+	LogLevel string             `json:"log_level"`
+	InMemory bool               `json:"in_memory"`
+	Mongo    MongoDiagnostics   `json:"mongo"`
+	Auction  AuctionDiagnostics `json:"auction"`
+	Bid      BidDiagnostics     `json:"bid"`
+	User     UserDiagnostics    `json:"user"`
+	HTTP     HTTPDiagnostics    `json:"http"`
+	Mail     MailDiagnostics    `json:"mail"`
+	Tracing  TracingDiagnostics `json:"tracing"`
+	Runtime  RuntimeDiagnostics `json:"runtime"`
+}
+
+type MongoDiagnostics struct {
+	URI                   string `json:"uri"` // Usuário, senha e query string removidos
+	Database              string `json:"database"`
+	TLSEnabled            bool   `json:"tls_enabled"`
+	TLSCAFile             string `json:"tls_ca_file"`
+	TLSInsecureSkipVerify bool   `json:"tls_insecure_skip_verify"`
+}
+
+type AuctionDiagnostics struct {
+	Interval               string   `json:"interval"`
+	ReopenGraceWindow      string   `json:"reopen_grace_window"`
+	MinDuration            string   `json:"min_duration"`
+	MaxDuration            string   `json:"max_duration"`
+	PersistWinner          bool     `json:"persist_winner"`
+	CloseSkew              string   `json:"close_skew"`
+	MaxBidsPerAuction      int      `json:"max_bids_per_auction"`
+	MinBidIncrement        float64  `json:"min_bid_increment"`
+	RequiredIncrement      float64  `json:"required_increment"`
+	StartingPrice          float64  `json:"starting_price"`
+	DefaultCondition       int      `json:"default_condition"`
+	UnfilteredLimit        int      `json:"unfiltered_limit"`
+	MaxExtensions          int      `json:"max_extensions"`
+	FirstBidPolicy         string   `json:"first_bid_policy"`
+	ReconcileInterval      string   `json:"reconcile_interval"`
+	CreateBatch            bool     `json:"create_batch"`
+	CreateBatchWindow      string   `json:"create_batch_window"`
+	CreateBatchMaxSize     int      `json:"create_batch_max_size"`
+	ProductNameMinLength   int      `json:"product_name_min_length"`
+	ProductNameMaxLength   int      `json:"product_name_max_length"`
+	CategoryMinLength      int      `json:"category_min_length"`
+	CategoryMaxLength      int      `json:"category_max_length"`
+	DescriptionMinLength   int      `json:"description_min_length"`
+	DescriptionMaxLength   int      `json:"description_max_length"`
+	DescriptionAllowedTags []string `json:"description_allowed_tags"`
+	Conditions             []string `json:"conditions"`
+}
+
+type BidDiagnostics struct {
+	AmountMode            string   `json:"amount_mode"`
+	AmountEpsilon         float64  `json:"amount_epsilon"`
+	MaxBatchSize          int      `json:"max_batch_size"`
+	ChannelBuffer         int      `json:"channel_buffer"` // Buffer do channel de lances - igual ao MAX_BATCH_SIZE
+	BatchInsertInterval   string   `json:"batch_insert_interval"`
+	BatchIdleInterval     string   `json:"batch_idle_interval"`
+	NearEndFlushWindow    string   `json:"near_end_flush_window"`
+	BatchFailureThreshold int      `json:"batch_failure_threshold"`
+	SlowFlushThreshold    string   `json:"slow_flush_threshold"`
+	BatchStallTimeout     string   `json:"batch_stall_timeout"`
+	FailoverBackoff       string   `json:"failover_backoff"`
+	FailoverMaxRequeues   int      `json:"failover_max_requeues"`
+	MaxBidsPerWindow      int      `json:"max_bids_per_window"`
+	RateWindow            string   `json:"rate_window"`
+	Cooldown              string   `json:"cooldown"`
+	StatusTTL             string   `json:"status_ttl"`
+	MaxAmountMultiplier   float64  `json:"max_amount_multiplier"`
+	GuestBidsEnabled      bool     `json:"guest_bids_enabled"`
+	RequireUUIDv4         bool     `json:"require_uuid_v4"`
+	ReceiptSecret         string   `json:"receipt_secret"`
+	MaskBidderIds         bool     `json:"mask_bidder_ids"`
+	BidderMaskSecret      string   `json:"bidder_mask_secret"`
+	AdminUserIds          []string `json:"admin_user_ids"`
+}
+
+type UserDiagnostics struct {
+	AnonymizeDeletedUserBids bool `json:"anonymize_deleted_user_bids"`
+}
+
+type HTTPDiagnostics struct {
+	GzipMinSize        int      `json:"gzip_min_size"`
+	StrictQueryParams  bool     `json:"strict_query_params"`
+	RequireJSONBody    bool     `json:"require_json_body"`
+	BatchMaxItems      int      `json:"batch_max_items"`
+	BatchMaxBodyBytes  int64    `json:"batch_max_body_bytes"`
+	InternalToken      string   `json:"internal_token"`
+	InternalAllowedIPs []string `json:"internal_allowed_ips"`
+	HealthCheckTimeout string   `json:"health_check_timeout"`
+	RequestTimeout     string   `json:"request_timeout"`
+	TimeFormat         string   `json:"time_format"`
+
+	ListMaxResponseBytes int64 `json:"list_max_response_bytes"`
+}
+
+type MailDiagnostics struct {
+	SMTPHost     string `json:"smtp_host"`
+	SMTPPort     int    `json:"smtp_port"`
+	SMTPUsername string `json:"smtp_username"`
+	SMTPPassword string `json:"smtp_password"`
+	From         string `json:"from"`
+	MaxRetries   int    `json:"max_retries"`
+	RetryBackoff string `json:"retry_backoff"`
+}
+
+type TracingDiagnostics struct {
+	OTLPEndpoint string `json:"otlp_endpoint"` // Mesma redação da URI do MongoDB
+	ServiceName  string `json:"service_name"`
+}
+
+type RuntimeDiagnostics struct {
+	GoroutineWarnThreshold int    `json:"goroutine_warn_threshold"`
+	MonitorInterval        string `json:"monitor_interval"`
+}
+
+// Diagnostics monta a visão segura da configuração carregada
+// Valores resolvidos fora do Config (nível de log, modo de valores) são preenchidos por quem chama
+func (c *Config) Diagnostics() Diagnostics {
+	return Diagnostics{
+		LogLevel: c.LogLevel,
+		InMemory: c.InMemory,
+		Mongo: MongoDiagnostics{
+			URI:                   redactURI(c.Mongo.URI),
+			Database:              c.Mongo.Database,
+			TLSEnabled:            c.Mongo.TLSEnabled,
+			TLSCAFile:             c.Mongo.TLSCAFile,
+			TLSInsecureSkipVerify: c.Mongo.TLSInsecureSkipVerify,
+		},
+		Auction: AuctionDiagnostics{
+			Interval:               c.Auction.Interval.String(),
+			ReopenGraceWindow:      c.Auction.ReopenGraceWindow.String(),
+			MinDuration:            c.Auction.MinDuration.String(),
+			MaxDuration:            c.Auction.MaxDuration.String(),
+			PersistWinner:          c.Auction.PersistWinner,
+			CloseSkew:              c.Auction.CloseSkew.String(),
+			MaxBidsPerAuction:      c.Auction.MaxBidsPerAuction,
+			MinBidIncrement:        c.Auction.MinBidIncrement,
+			RequiredIncrement:      c.Auction.RequiredIncrement,
+			StartingPrice:          c.Auction.StartingPrice,
+			DefaultCondition:       c.Auction.DefaultCondition,
+			UnfilteredLimit:        c.Auction.UnfilteredLimit,
+			MaxExtensions:          c.Auction.MaxExtensions,
+			FirstBidPolicy:         c.Auction.FirstBidPolicy,
+			ReconcileInterval:      c.Auction.ReconcileInterval.String(),
+			CreateBatch:            c.Auction.CreateBatch,
+			CreateBatchWindow:      c.Auction.CreateBatchWindow.String(),
+			CreateBatchMaxSize:     c.Auction.CreateBatchMaxSize,
+			ProductNameMinLength:   c.Auction.ProductNameMinLength,
+			ProductNameMaxLength:   c.Auction.ProductNameMaxLength,
+			CategoryMinLength:      c.Auction.CategoryMinLength,
+			CategoryMaxLength:      c.Auction.CategoryMaxLength,
+			DescriptionMinLength:   c.Auction.DescriptionMinLength,
+			DescriptionMaxLength:   c.Auction.DescriptionMaxLength,
+			DescriptionAllowedTags: emptyIfNil(c.Auction.DescriptionAllowedTags),
+			Conditions:             emptyIfNil(c.Auction.Conditions),
+		},
+		Bid: BidDiagnostics{
+			AmountMode:            c.Bid.AmountMode,
+			AmountEpsilon:         c.Bid.AmountEpsilon,
+			MaxBatchSize:          c.Bid.MaxBatchSize,
+			ChannelBuffer:         c.Bid.MaxBatchSize,
+			BatchInsertInterval:   c.Bid.BatchInsertInterval.String(),
+			BatchIdleInterval:     c.Bid.BatchIdleInterval.String(),
+			NearEndFlushWindow:    c.Bid.NearEndFlushWindow.String(),
+			BatchFailureThreshold: c.Bid.BatchFailureThreshold,
+			SlowFlushThreshold:    c.Bid.SlowFlushThreshold.String(),
+			BatchStallTimeout:     c.Bid.BatchStallTimeout.String(),
+			FailoverBackoff:       c.Bid.FailoverBackoff.String(),
+			FailoverMaxRequeues:   c.Bid.FailoverMaxRequeues,
+			MaxBidsPerWindow:      c.Bid.MaxBidsPerWindow,
+			RateWindow:            c.Bid.RateWindow.String(),
+			Cooldown:              c.Bid.Cooldown.String(),
+			StatusTTL:             c.Bid.StatusTTL.String(),
+			MaxAmountMultiplier:   c.Bid.MaxAmountMultiplier,
+			GuestBidsEnabled:      c.Bid.GuestBidsEnabled,
+			RequireUUIDv4:         c.Bid.RequireUUIDv4,
+			ReceiptSecret:         redactSecret(c.Bid.ReceiptSecret),
+			MaskBidderIds:         c.Bid.MaskBidderIds,
+			BidderMaskSecret:      redactSecret(c.Bid.BidderMaskSecret),
+			AdminUserIds:          emptyIfNil(c.Bid.AdminUserIds),
+		},
+		User: UserDiagnostics{
+			AnonymizeDeletedUserBids: c.User.AnonymizeDeletedUserBids,
+		},
+		HTTP: HTTPDiagnostics{
+			GzipMinSize:       c.HTTP.GzipMinSize,
+			StrictQueryParams: c.HTTP.StrictQueryParams,
+			RequireJSONBody:   c.HTTP.RequireJSONBody,
+			BatchMaxItems:     c.HTTP.BatchMaxItems,
+			BatchMaxBodyBytes: c.HTTP.BatchMaxBodyBytes,
+
+			ListMaxResponseBytes: c.HTTP.ListMaxResponseBytes,
+
+			InternalToken:      redactSecret(c.HTTP.InternalToken),
+			InternalAllowedIPs: emptyIfNil(c.HTTP.InternalAllowedIPs),
+			HealthCheckTimeout: c.HTTP.HealthCheckTimeout.String(),
+			RequestTimeout:     c.HTTP.RequestTimeout.String(),
+			TimeFormat:         c.HTTP.TimeFormat,
+		},
+		Mail: MailDiagnostics{
+			SMTPHost:     c.Mail.SMTPHost,
+			SMTPPort:     c.Mail.SMTPPort,
+			SMTPUsername: c.Mail.SMTPUsername,
+			SMTPPassword: redactSecret(c.Mail.SMTPPassword),
+			From:         c.Mail.From,
+			MaxRetries:   c.Mail.MaxRetries,
+			RetryBackoff: c.Mail.RetryBackoff.String(),
+		},
+		Tracing: TracingDiagnostics{
+			OTLPEndpoint: redactURI(c.Tracing.OTLPEndpoint),
+			ServiceName:  c.Tracing.ServiceName,
+		},
+		Runtime: RuntimeDiagnostics{
+			GoroutineWarnThreshold: c.Runtime.GoroutineWarnThreshold,
+			MonitorInterval:        c.Runtime.MonitorInterval.String(),
+		},
+	}
+}
+
+// redactSecret esconde o valor mas indica se ele foi configurado
+func redactSecret(secret string) string {
+	if secret == "" {
+		return ""
+	}
+	return redactedValue
+}
+
+// redactURI mantém esquema, hosts e path da connection string
+// Credenciais e query string (ex: authSource, tlsCertificateKeyFilePassword) são removidas
+// URIs que não podem ser interpretadas são escondidas por inteiro
+func redactURI(uri string) string {
+	if uri == "" {
+		return ""
+	}
+	parsed, err := url.Parse(uri)
+	if err != nil {
+		return redactedValue
+	}
+	if parsed.User != nil {
+		// Colchetes seriam codificados (%5B) na parte de usuário - usa o texto sem eles
+		parsed.User = url.User("REDACTED")
+	}
+	parsed.RawQuery = ""
+	parsed.Fragment = ""
+	return parsed.String()
+}
+
+// emptyIfNil serializa listas não configuradas como [] em vez de null
+func emptyIfNil(values []string) []string {
+	if values == nil {
+		return []string{}
+	}
+	return values
+}
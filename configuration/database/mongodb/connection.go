@@ -4,37 +4,44 @@ package mongodb
 
 import (
 	"context"
-	"os"
 
+	"github.com/Guilherme-G-Cadilhe/Go-AuctionHouse_Concurrency-Server-Api/configuration/config"
 	"github.com/Guilherme-G-Cadilhe/Go-AuctionHouse_Concurrency-Server-Api/configuration/logger"
+	"github.com/Guilherme-G-Cadilhe/Go-AuctionHouse_Concurrency-Server-Api/configuration/tracing"
 	mongo "go.mongodb.org/mongo-driver/mongo"
 	"go.mongodb.org/mongo-driver/mongo/options"
 )
 
-// Constantes para as variáveis de ambiente
-// Em Go, é uma boa prática usar constantes para strings que não mudam
-// Isso evita erros de digitação e facilita manutenção
-const (
-	MONGODB_URI      = "MONGODB_URI"
-	MONGODB_DATABASE = "MONGODB_DATABASE"
-)
-
 // NewMongoDBConnection estabelece conexão com MongoDB e retorna uma instância do database
 // Parâmetros:
 //   - ctx context.Context: Context do Go para controle de timeout/cancelamento (diferente do Node.js)
+//   - cfg config.MongoConfig: URI, database e TLS já lidos do ambiente por config.LoadConfig
 //
 // Retorna:
 //   - *mongo.Database: Ponteiro para o database (em Go usamos ponteiros para evitar cópias desnecessárias)
 //   - error: Interface de erro do Go (ao invés de try/catch como no Node.js)
-func NewMongoDBConnection(ctx context.Context) (*mongo.Database, error) {
-	// os.Getenv() busca variável de ambiente (equivale ao process.env do Node.js)
-	mongoURI := os.Getenv(MONGODB_URI)
-	mongoDatabase := os.Getenv(MONGODB_DATABASE)
+func NewMongoDBConnection(ctx context.Context, cfg config.MongoConfig) (*mongo.Database, error) {
+	clientOptions := options.Client().ApplyURI(cfg.URI)
+
+	// TLS opcional (ex: MongoDB Atlas) - falha cedo se o CA configurado for inválido
+	tlsConfig, err := buildTLSConfig(cfg)
+	if err != nil {
+		logger.Error("Error loading MongoDB TLS configuration", err)
+		return nil, err
+	}
+	if tlsConfig != nil {
+		clientOptions.SetTLSConfig(tlsConfig)
+	}
+
+	// Spans dos comandos só quando há exporter - evita o custo do monitor com tracing desligado
+	if tracing.Enabled() {
+		clientOptions.SetMonitor(newTracingMonitor())
+	}
 
 	// mongo.Connect() conecta ao MongoDB usando o context
 	// options.Client().ApplyURI() configura as opções de conexão
 	// Em Go, muitas funções retornam (valor, erro) - padrão da linguagem
-	client, err := mongo.Connect(ctx, options.Client().ApplyURI(mongoURI))
+	client, err := mongo.Connect(ctx, clientOptions)
 	if err != nil {
 		// Se houver erro, loga usando nosso sistema customizado e retorna
 		// Em Go, tratamos erros explicitamente (não há exceções como no Node.js)
@@ -51,6 +58,6 @@ func NewMongoDBConnection(ctx context.Context) (*mongo.Database, error) {
 
 	// client.Database() seleciona o database específico
 	// Retorna um ponteiro para o database (sucesso) e nil para erro
-	return client.Database(mongoDatabase), nil
+	return client.Database(cfg.Database), nil
 
 }
@@ -0,0 +1,30 @@
+package mongodb
+
+import (
+	"context"
+	"errors"
+
+	"github.com/Guilherme-G-Cadilhe/Go-AuctionHouse_Concurrency-Server-Api/internal/internal_error"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+// ClassifyMongoError converte um erro do driver no InternalError adequado
+//   - mongo.ErrNoDocuments -> not_found (404) com notFoundMessage
+//   - context.DeadlineExceeded / timeout do driver -> timeout (504)
+//   - context.Canceled -> request_canceled (499)
+//   - qualquer outro erro -> internal_server_error (500) com message
+//
+// notFoundMessage vazio trata ErrNoDocuments como erro interno (ex: Find, que nunca retorna ErrNoDocuments)
+func ClassifyMongoError(err error, notFoundMessage, message string) *internal_error.InternalError {
+	switch {
+	case notFoundMessage != "" && errors.Is(err, mongo.ErrNoDocuments):
+		return internal_error.NewNotFoundError(notFoundMessage)
+	// mongo.IsTimeout cobre também timeouts de rede/servidor do driver
+	case errors.Is(err, context.DeadlineExceeded) || mongo.IsTimeout(err):
+		return internal_error.NewTimeoutError(message + ": database timeout")
+	case errors.Is(err, context.Canceled):
+		return internal_error.NewRequestCanceledError(message + ": request canceled")
+	default:
+		return internal_error.NewInternalServerError(message)
+	}
+}
@@ -0,0 +1,45 @@
+package mongodb
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/Guilherme-G-Cadilhe/Go-AuctionHouse_Concurrency-Server-Api/configuration/rest_err"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+// O erro do driver chega embrulhado (ex: cursor abortado no meio da leitura) - errors.Is precisa achá-lo
+func TestClassifyMongoErrorStatusCodes(t *testing.T) {
+	tests := []struct {
+		name     string
+		err      error
+		wantErr  string
+		wantCode int
+	}{
+		{"deadline exceeded", fmt.Errorf("cursor next: %w", context.DeadlineExceeded), "timeout", http.StatusGatewayTimeout},
+		{"client canceled", fmt.Errorf("cursor next: %w", context.Canceled), "request_canceled", 499},
+		{"no documents", mongo.ErrNoDocuments, "not_found", http.StatusNotFound},
+		{"other error", errors.New("connection reset"), "internal_server_error", http.StatusInternalServerError},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ClassifyMongoError(tt.err, "auction not found", "error trying to find auctions")
+			if err.Err != tt.wantErr {
+				t.Fatalf("err = %q, want %q", err.Err, tt.wantErr)
+			}
+			if code := rest_err.ConvertErrors(err).Code; code != tt.wantCode {
+				t.Fatalf("status code = %d, want %d", code, tt.wantCode)
+			}
+		})
+	}
+}
+
+// Sem notFoundMessage (ex: Find) o ErrNoDocuments não vira 404
+func TestClassifyMongoErrorWithoutNotFoundMessage(t *testing.T) {
+	if err := ClassifyMongoError(mongo.ErrNoDocuments, "", "error trying to find auctions"); err.Err != "internal_server_error" {
+		t.Fatalf("err = %q, want internal_server_error", err.Err)
+	}
+}
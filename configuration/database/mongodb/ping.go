@@ -0,0 +1,19 @@
+package mongodb
+
+import (
+	"context"
+
+	"github.com/Guilherme-G-Cadilhe/Go-AuctionHouse_Concurrency-Server-Api/internal/internal_error"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+// Ping executa o comando "ping" no database da coleção - confirma que o servidor responde
+// Não lê documentos: é barato o bastante para ser chamado a cada probe do /health
+func Ping(ctx context.Context, collection *mongo.Collection) *internal_error.InternalError {
+	err := collection.Database().RunCommand(ctx, bson.D{{Key: "ping", Value: 1}}).Err()
+	if err != nil {
+		return ClassifyMongoError(err, "", "database ping failed")
+	}
+	return nil
+}
@@ -0,0 +1,29 @@
+package mongodb
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/Guilherme-G-Cadilhe/Go-AuctionHouse_Concurrency-Server-Api/configuration/logger"
+	"go.uber.org/zap"
+)
+
+// TrackQuery mede a duração de uma operação no MongoDB e loga em nível debug
+// Uso com defer (note os parênteses finais - TrackQuery retorna a função que encerra a medição):
+//
+//	defer mongodb.TrackQuery("FindAuctionById", filter)()
+//
+// Com LOG_LEVEL acima de debug não mede nem formata nada
+func TrackQuery(operation string, filter any) func() {
+	if !logger.DebugEnabled() {
+		return func() {}
+	}
+
+	start := time.Now()
+	return func() {
+		logger.Debug("mongodb query",
+			zap.String("operation", operation),
+			zap.String("filter", fmt.Sprintf("%v", filter)),
+			zap.Duration("duration", time.Since(start)))
+	}
+}
@@ -0,0 +1,43 @@
+package mongodb
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"os"
+
+	"github.com/Guilherme-G-Cadilhe/Go-AuctionHouse_Concurrency-Server-Api/configuration/config"
+)
+
+// buildTLSConfig monta a configuração TLS a partir da MongoConfig
+// Retorna (nil, nil) quando TLS não está habilitado
+// Retorna erro se o arquivo de CA não puder ser carregado - a aplicação não deve subir
+func buildTLSConfig(cfg config.MongoConfig) (*tls.Config, error) {
+	if !cfg.TLSEnabled {
+		return nil, nil
+	}
+
+	tlsConfig := &tls.Config{
+		// InsecureSkipVerify desabilita a validação do certificado - APENAS para desenvolvimento
+		InsecureSkipVerify: cfg.TLSInsecureSkipVerify,
+	}
+
+	caFile := cfg.TLSCAFile
+	if caFile == "" {
+		// Sem CA customizado usa os certificados do sistema (suficiente para o Atlas)
+		return tlsConfig, nil
+	}
+
+	caPEM, err := os.ReadFile(caFile)
+	if err != nil {
+		return nil, fmt.Errorf("error reading MongoDB TLS CA file %s: %w", caFile, err)
+	}
+
+	caPool := x509.NewCertPool()
+	if !caPool.AppendCertsFromPEM(caPEM) {
+		return nil, fmt.Errorf("MongoDB TLS CA file %s does not contain valid PEM certificates", caFile)
+	}
+	tlsConfig.RootCAs = caPool
+
+	return tlsConfig, nil
+}
@@ -0,0 +1,57 @@
+package mongodb
+
+import (
+	"context"
+	"errors"
+	"sync"
+
+	"github.com/Guilherme-G-Cadilhe/Go-AuctionHouse_Concurrency-Server-Api/configuration/tracing"
+	"go.mongodb.org/mongo-driver/event"
+	"go.opentelemetry.io/otel/codes"
+	semconv "go.opentelemetry.io/otel/semconv/v1.26.0"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// newTracingMonitor cria um span por comando enviado ao MongoDB (find, insert, update, aggregate...)
+// O driver chama o monitor com o ctx passado ao repositório - o span vira filho do span do use case
+// Assim nenhuma chamada ao Collection precisa ser alterada (mesma ideia do otelmongo do opentelemetry-go-contrib)
+func newTracingMonitor() *event.CommandMonitor {
+	// Started e Succeeded/Failed são callbacks separados - o RequestID liga o início ao fim do comando
+	var spans sync.Map // int64 (RequestID) -> trace.Span
+
+	finish := func(requestId int64, failure string) {
+		value, ok := spans.LoadAndDelete(requestId)
+		if !ok {
+			return
+		}
+		span := value.(trace.Span)
+		if failure != "" {
+			span.RecordError(errors.New(failure))
+			span.SetStatus(codes.Error, failure)
+		}
+		span.End()
+	}
+
+	return &event.CommandMonitor{
+		Started: func(ctx context.Context, evt *event.CommandStartedEvent) {
+			// O nome da coleção é o valor do próprio comando: {"find": "auctions", "filter": ...}
+			collection, _ := evt.Command.Lookup(evt.CommandName).StringValueOK()
+
+			_, span := tracing.Start(ctx, "mongodb."+evt.CommandName,
+				trace.WithSpanKind(trace.SpanKindClient),
+				trace.WithAttributes(
+					semconv.DBSystemMongoDB,
+					semconv.DBNamespace(evt.DatabaseName),
+					semconv.DBCollectionName(collection),
+					semconv.DBOperationName(evt.CommandName),
+				))
+			spans.Store(evt.RequestID, span)
+		},
+		Succeeded: func(ctx context.Context, evt *event.CommandSucceededEvent) {
+			finish(evt.RequestID, "")
+		},
+		Failed: func(ctx context.Context, evt *event.CommandFailedEvent) {
+			finish(evt.RequestID, evt.Failure)
+		},
+	}
+}
@@ -12,6 +12,12 @@ import (
 // O * indica que é um ponteiro para zap.Logger
 var (
 	log *zap.Logger
+
+	// accessLog grava o access log HTTP (uma linha por request) - ver Access
+	accessLog *zap.Logger
+
+	// level é um AtomicLevel - permite trocar o nível em runtime (ex: após carregar o .env)
+	level = zap.NewAtomicLevelAt(zap.InfoLevel)
 )
 
 // init() é uma função especial do Go que executa automaticamente quando o package é importado
@@ -22,7 +28,7 @@ func init() {
 	logConfiguration := zap.Config{
 		// Level define o nível mínimo de log que será registrado
 		// InfoLevel significa que vai logar: Info, Warn, Error, Fatal (mas não Debug)
-		Level: zap.NewAtomicLevelAt(zap.InfoLevel),
+		Level: level,
 
 		// Encoding define o formato de saída dos logs
 		// "json" significa que os logs serão estruturados em JSON (ótimo para produção)
@@ -30,23 +36,7 @@ func init() {
 		Encoding: "json",
 
 		// EncoderConfig configura como cada campo do log será formatado
-		EncoderConfig: zapcore.EncoderConfig{
-			MessageKey: "message", // Campo que conterá a mensagem principal do log
-			LevelKey:   "level",   // Campo que indica o nível do log (info, error, etc.)
-			TimeKey:    "time",    // Campo que conterá o timestamp
-
-			// EncodeLevel define como o nível será formatado
-			// LowercaseLevelEncoder = "info", "error" (minúsculo)
-			EncodeLevel: zapcore.LowercaseLevelEncoder,
-
-			// EncodeTime define o formato do timestamp
-			// ISO8601TimeEncoder = formato padrão internacional (2023-12-01T15:30:45Z)
-			EncodeTime: zapcore.ISO8601TimeEncoder,
-
-			// EncodeCaller mostra de onde o log foi chamado (arquivo:linha)
-			// ShortCallerEncoder = apenas o nome do arquivo e linha (não o path completo)
-			EncodeCaller: zapcore.ShortCallerEncoder,
-		},
+		EncoderConfig: encoderConfig(),
 	}
 
 	// Tenta construir o logger com a configuração definida
@@ -57,19 +47,93 @@ func init() {
 		// É similar ao throw de uma exceção não capturada no Node.js
 		panic(err)
 	}
+
+	// Access log: mesmo formato JSON, mas sempre no nível info (independente do LOG_LEVEL)
+	// e sem caller - a linha seria sempre a do middleware
+	accessConfiguration := logConfiguration
+	accessConfiguration.Level = zap.NewAtomicLevelAt(zap.InfoLevel)
+	accessConfiguration.DisableCaller = true
+	// Saída explícita: sem OutputPaths o zap não escreve em lugar nenhum
+	accessConfiguration.OutputPaths = []string{"stdout"}
+	accessConfiguration.ErrorOutputPaths = []string{"stderr"}
+	accessLog, err = accessConfiguration.Build()
+	if err != nil {
+		panic(err)
+	}
 }
 
-// info é uma função helper para logs de informação
+// encoderConfig define os campos comuns a todos os logs da aplicação
+// Compartilhado pelo logger da aplicação e pelo access log - a plataforma de logs recebe um único formato
+func encoderConfig() zapcore.EncoderConfig {
+	return zapcore.EncoderConfig{
+		MessageKey: "message", // Campo que conterá a mensagem principal do log
+		LevelKey:   "level",   // Campo que indica o nível do log (info, error, etc.)
+		TimeKey:    "time",    // Campo que conterá o timestamp
+
+		// EncodeLevel define como o nível será formatado
+		// LowercaseLevelEncoder = "info", "error" (minúsculo)
+		EncodeLevel: zapcore.LowercaseLevelEncoder,
+
+		// EncodeTime define o formato do timestamp
+		// ISO8601TimeEncoder = formato padrão internacional (2023-12-01T15:30:45Z)
+		EncodeTime: zapcore.ISO8601TimeEncoder,
+
+		// EncodeCaller mostra de onde o log foi chamado (arquivo:linha)
+		// ShortCallerEncoder = apenas o nome do arquivo e linha (não o path completo)
+		EncodeCaller: zapcore.ShortCallerEncoder,
+	}
+}
+
+// Info é uma função helper para logs de informação
 // Parâmetros:
 //   - message string: Mensagem principal do log
 //   - tags ...zap.Field: Campos adicionais (variadic - aceita N argumentos)
-func info(message string, tags ...zap.Field) {
+func Info(message string, tags ...zap.Field) {
 	// log.Info() registra um log de nível informativo
 	log.Info(message, tags...)
 	// log.Sync() força a escrita imediata do buffer (importante para garantir que o log seja escrito)
 	log.Sync()
 }
 
+// Access registra uma linha do access log HTTP
+// Sem log.Sync() por chamada: roda em toda request e o stdout não é bufferizado pelo zap
+func Access(message string, tags ...zap.Field) {
+	accessLog.Info(message, tags...)
+}
+
+// SetLevel altera o nível mínimo de log (debug, info, warn, error)
+// Valores inválidos ou vazios mantêm o nível atual
+func SetLevel(levelName string) {
+	var newLevel zapcore.Level
+	if err := newLevel.UnmarshalText([]byte(levelName)); err != nil {
+		return
+	}
+	level.SetLevel(newLevel)
+}
+
+// LevelName retorna o nível efetivo (ex: "info") - usado no diagnóstico GET /internal/config
+func LevelName() string {
+	return level.Level().String()
+}
+
+// DebugEnabled indica se logs de debug estão ativos
+// Útil para evitar montar mensagens caras quando o debug está desligado
+func DebugEnabled() bool {
+	return level.Enabled(zap.DebugLevel)
+}
+
+// Debug registra logs de diagnóstico - silenciosos em produção (nível info)
+func Debug(message string, tags ...zap.Field) {
+	log.Debug(message, tags...)
+	log.Sync()
+}
+
+// Warn registra situações anormais que não são erro (ex: flush lento do batch)
+func Warn(message string, tags ...zap.Field) {
+	log.Warn(message, tags...)
+	log.Sync()
+}
+
 // Error é uma função helper para logs de erro (note que é exportada - começa com maiúscula)
 // Parâmetros:
 //   - message string: Mensagem de contexto do erro
@@ -93,7 +157,7 @@ console.log('User created:', { userId: 123, email: 'user@example.com' });
 console.error('Database error:', error);
 
 Go com Zap:
-logger.info("User created",
+logger.Info("User created",
     zap.Int("userId", 123),
     zap.String("email", "user@example.com"))
 
@@ -0,0 +1,19 @@
+package logger
+
+import (
+	"time"
+
+	"go.uber.org/zap"
+)
+
+// ShutdownPhase registra uma etapa do encerramento gracioso com a duração e o resultado
+// Todas as etapas usam a mesma mensagem ("shutdown phase") - filtrar por ela mostra a sequência
+// inteira, e a última etapa sem "outcome":"ok" é onde o encerramento travou
+func ShutdownPhase(phase string, elapsed time.Duration, err error, tags ...zap.Field) {
+	tags = append(tags, zap.String("phase", phase), zap.Duration("duration", elapsed))
+	if err != nil {
+		Error("shutdown phase", err, append(tags, zap.String("outcome", "failed"))...)
+		return
+	}
+	Info("shutdown phase", append(tags, zap.String("outcome", "ok"))...)
+}
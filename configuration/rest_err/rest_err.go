@@ -3,11 +3,16 @@
 package rest_err
 
 import (
+	"math"
 	"net/http"
 
 	"github.com/Guilherme-G-Cadilhe/Go-AuctionHouse_Concurrency-Server-Api/internal/internal_error"
 )
 
+// StatusClientClosedRequest (499) não existe no pacote net/http
+// É o código não-oficial usado pelo nginx quando o cliente fecha a conexão antes da resposta
+const StatusClientClosedRequest = 499
+
 // RestErr é uma struct que representa um erro estruturado para APIs REST
 // Em Go, structs são similares a classes/objetos, mas sem herança
 // As tags `json:"..."` definem como os campos serão serializados para JSON
@@ -16,6 +21,9 @@ type RestErr struct {
 	Err     string   `json:"err"`     // Tipo/categoria do erro
 	Code    int      `json:"code"`    // Código HTTP do erro
 	Causes  []Causes `json:"causes"`  // Array de causas específicas (para validação)
+
+	// RetryAfter em segundos (429) - o controller o envia no header Retry-After
+	RetryAfter int `json:"retry_after,omitempty"`
 }
 
 // Causes representa erros específicos de campos (útil para validação de formulários)
@@ -45,10 +53,41 @@ func ConvertErrors(internalError *internal_error.InternalError) *RestErr {
 	switch internalError.Err {
 	case "bad_request":
 		// Erro de validação/dados inválidos -> 400 Bad Request
-		return NewBadRequestError(internalError.Error())
+		// Causas por campo da camada de domínio são repassadas para o cliente
+		causes := make([]Causes, len(internalError.Causes))
+		for i, cause := range internalError.Causes {
+			causes[i] = Causes{Field: cause.Field, Message: cause.Message}
+		}
+		return NewBadRequestError(internalError.Error(), causes...)
 	case "not_found":
 		// Recurso não encontrado -> 404 Not Found
 		return NewNotFoundError(internalError.Error())
+	case "unauthorized":
+		// Usuário não autenticado -> 401 Unauthorized
+		return NewUnauthorizedError(internalError.Error())
+	case "forbidden":
+		// Recurso existe mas não pode ser acessado agora -> 403 Forbidden
+		return NewForbiddenError(internalError.Error())
+	case "conflict":
+		// Operação incompatível com o estado atual do recurso -> 409 Conflict
+		return NewConflictError(internalError.Error())
+	case "too_many_requests":
+		// Limite de frequência excedido -> 429 Too Many Requests
+		restErr := NewTooManyRequestsError(internalError.Error())
+		if internalError.RetryAfter > 0 {
+			// Retry-After só aceita segundos inteiros - arredonda para cima para o cliente não voltar cedo demais
+			restErr.RetryAfter = int(math.Ceil(internalError.RetryAfter.Seconds()))
+		}
+		return restErr
+	case "timeout":
+		// Banco não respondeu dentro do prazo -> 504 Gateway Timeout
+		return NewGatewayTimeoutError(internalError.Error())
+	case "request_canceled":
+		// Cliente desistiu da request antes da resposta -> 499 (convenção do nginx)
+		return NewClientClosedRequestError(internalError.Error())
+	case "service_unavailable":
+		// Serviço encerrando e não aceita mais trabalho -> 503 Service Unavailable
+		return NewServiceUnavailableError(internalError.Error())
 	default:
 		// Qualquer outro erro -> 500 Internal Server Error
 		// Fallback seguro para erros inesperados
@@ -117,6 +156,92 @@ func NewNotFoundError(message string) *RestErr {
 	}
 }
 
+// NewUnauthorizedError cria erros de usuário não autenticado (401)
+// Usado quando uma operação protegida é chamada sem usuário no context
+func NewUnauthorizedError(message string) *RestErr {
+	return &RestErr{
+		Message: message,
+		Err:     "unauthorized",
+		Code:    http.StatusUnauthorized, // 401
+		Causes:  nil,
+	}
+}
+
+// NewForbiddenError cria erros de acesso negado (403)
+// Usado quando o recurso existe mas não pode ser exibido (ex: lances de leilão sealed-bid)
+func NewForbiddenError(message string) *RestErr {
+	return &RestErr{
+		Message: message,
+		Err:     "forbidden",
+		Code:    http.StatusForbidden, // 403
+		Causes:  nil,
+	}
+}
+
+// NewConflictError cria erros de conflito com o estado do recurso (409)
+// Usado quando a operação não é permitida no status atual (ex: estender leilão fechado)
+func NewConflictError(message string) *RestErr {
+	return &RestErr{
+		Message: message,
+		Err:     "conflict",
+		Code:    http.StatusConflict, // 409
+		Causes:  nil,
+	}
+}
+
+// NewTooManyRequestsError cria erros de limite de frequência excedido (429)
+func NewTooManyRequestsError(message string) *RestErr {
+	return &RestErr{
+		Message: message,
+		Err:     "too_many_requests",
+		Code:    http.StatusTooManyRequests, // 429
+		Causes:  nil,
+	}
+}
+
+// NewUnsupportedMediaTypeError cria erros de Content-Type não suportado (415)
+// Usado quando o corpo da request não é JSON (ex: form data ou sem Content-Type)
+func NewUnsupportedMediaTypeError(message string) *RestErr {
+	return &RestErr{
+		Message: message,
+		Err:     "unsupported_media_type",
+		Code:    http.StatusUnsupportedMediaType, // 415
+		Causes:  nil,
+	}
+}
+
+// NewPayloadTooLargeError cria erros de corpo acima do limite (413)
+// Usado pelas rotas em lote quando o corpo passa de BATCH_MAX_BODY_BYTES
+func NewPayloadTooLargeError(message string) *RestErr {
+	return &RestErr{
+		Message: message,
+		Err:     "payload_too_large",
+		Code:    http.StatusRequestEntityTooLarge, // 413
+		Causes:  nil,
+	}
+}
+
+// NewGatewayTimeoutError cria erros de timeout de dependência (504)
+// Usado quando o MongoDB não responde dentro do deadline do context
+func NewGatewayTimeoutError(message string) *RestErr {
+	return &RestErr{
+		Message: message,
+		Err:     "timeout",
+		Code:    http.StatusGatewayTimeout, // 504
+		Causes:  nil,
+	}
+}
+
+// NewClientClosedRequestError cria erros de request cancelada pelo cliente (499)
+func NewClientClosedRequestError(message string) *RestErr {
+	return &RestErr{
+		Message: message,
+		Err:     "request_canceled",
+		Code:    StatusClientClosedRequest, // 499
+		Causes:  nil,
+	}
+}
+
 /*
 EXEMPLO de uso comparado ao Node.js:
 
@@ -143,3 +268,14 @@ if err != nil {
     return
 }
 */
+
+// NewServiceUnavailableError cria erros de serviço indisponível (503)
+// Usado quando a aplicação está encerrando e não aceita novos lances
+func NewServiceUnavailableError(message string) *RestErr {
+	return &RestErr{
+		Message: message,
+		Err:     "service_unavailable",
+		Code:    http.StatusServiceUnavailable, // 503
+		Causes:  nil,
+	}
+}
@@ -0,0 +1,85 @@
+// Package tracing configura o OpenTelemetry e expõe o tracer da aplicação
+// Um span raiz por request (middleware), spans nos use cases e um span por comando do MongoDB
+// Todos ligados pelo context.Context - no Node.js seria o AsyncLocalStorage que o SDK do OTel usa por baixo
+package tracing
+
+import (
+	"context"
+	"net/url"
+	"strings"
+
+	"github.com/Guilherme-G-Cadilhe/Go-AuctionHouse_Concurrency-Server-Api/configuration/config"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracehttp"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	semconv "go.opentelemetry.io/otel/semconv/v1.26.0"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// instrumentationName identifica quem gerou os spans (atributo otel.scope.name)
+const instrumentationName = "github.com/Guilherme-G-Cadilhe/Go-AuctionHouse_Concurrency-Server-Api"
+
+// tracesPath é o path padrão do OTLP/HTTP para spans, somado quando o endpoint vem sem path
+const tracesPath = "/v1/traces"
+
+// enabled fica true só quando um exporter foi configurado em Init
+// Definido uma única vez na inicialização, antes de qualquer request
+var enabled bool
+
+// Init registra o TracerProvider global
+// Sem OTEL_EXPORTER_OTLP_ENDPOINT nada é registrado: o provider padrão do otel é no-op
+// e os spans da aplicação não custam quase nada
+// Retorna a função que descarrega os spans pendentes no encerramento
+func Init(ctx context.Context, cfg config.TracingConfig) (func(context.Context) error, error) {
+	if cfg.OTLPEndpoint == "" {
+		return func(context.Context) error { return nil }, nil
+	}
+
+	exporter, err := otlptracehttp.New(ctx, otlptracehttp.WithEndpointURL(tracesURL(cfg.OTLPEndpoint)))
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := resource.Merge(resource.Default(), resource.NewSchemaless(semconv.ServiceName(cfg.ServiceName)))
+	if err != nil {
+		return nil, err
+	}
+
+	// Batcher agrupa os spans e exporta em background - a request não espera o collector
+	provider := sdktrace.NewTracerProvider(
+		sdktrace.WithBatcher(exporter),
+		sdktrace.WithResource(res),
+	)
+	otel.SetTracerProvider(provider)
+
+	// W3C traceparent/tracestate: continua traces iniciados por outros serviços (ex: API gateway)
+	otel.SetTextMapPropagator(propagation.NewCompositeTextMapPropagator(propagation.TraceContext{}, propagation.Baggage{}))
+
+	enabled = true
+	return provider.Shutdown, nil
+}
+
+// Enabled indica se há um exporter configurado
+// Usado por instrumentações que têm custo próprio mesmo com tracer no-op (ex: monitor do MongoDB)
+func Enabled() bool {
+	return enabled
+}
+
+// Start abre um span filho do span presente no ctx
+// Uso: ctx, span := tracing.Start(ctx, "AuctionUseCase.CreateAuction"); defer span.End()
+func Start(ctx context.Context, spanName string, opts ...trace.SpanStartOption) (context.Context, trace.Span) {
+	return otel.Tracer(instrumentationName).Start(ctx, spanName, opts...)
+}
+
+// tracesURL segue a convenção do OTEL_EXPORTER_OTLP_ENDPOINT: a URL é a base do collector
+// e o path do sinal (/v1/traces) é somado quando não informado
+func tracesURL(endpoint string) string {
+	parsed, err := url.Parse(endpoint)
+	if err != nil || strings.Trim(parsed.Path, "/") != "" {
+		return endpoint
+	}
+	parsed.Path = tracesPath
+	return parsed.String()
+}
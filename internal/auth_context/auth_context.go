@@ -0,0 +1,35 @@
+// Package auth_context propaga o usuário autenticado pelo context.Context
+// O middleware de autenticação grava o id; os use cases leem sem depender de DTOs
+package auth_context
+
+import (
+	"context"
+
+	"github.com/Guilherme-G-Cadilhe/Go-AuctionHouse_Concurrency-Server-Api/internal/internal_error"
+)
+
+// userIDKey é um tipo não exportado para a chave do context
+// Evita colisão com chaves de outros packages (boa prática do package context)
+type userIDKey struct{}
+
+// WithUserID retorna um novo context carregando o id do usuário autenticado
+func WithUserID(ctx context.Context, userId string) context.Context {
+	return context.WithValue(ctx, userIDKey{}, userId)
+}
+
+// UserIDFromContext lê o id do usuário autenticado
+// ok = false quando nenhum middleware gravou o usuário no context
+func UserIDFromContext(ctx context.Context) (string, bool) {
+	userId, ok := ctx.Value(userIDKey{}).(string)
+	return userId, ok && userId != ""
+}
+
+// RequireUserID é usado por use cases protegidos
+// Retorna erro unauthorized quando o context não possui usuário autenticado
+func RequireUserID(ctx context.Context) (string, *internal_error.InternalError) {
+	userId, ok := UserIDFromContext(ctx)
+	if !ok {
+		return "", internal_error.NewUnauthorizedError("authenticated user is required for this operation")
+	}
+	return userId, nil
+}
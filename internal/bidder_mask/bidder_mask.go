@@ -0,0 +1,85 @@
+// Package bidder_mask anonimiza quem deu o lance nas respostas da API
+// Usado por todo use case que monta um BidOutputDTO - lista de lances, vencedor, atividade, relatórios
+package bidder_mask
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+
+	"github.com/Guilherme-G-Cadilhe/Go-AuctionHouse_Concurrency-Server-Api/configuration/config"
+	"github.com/Guilherme-G-Cadilhe/Go-AuctionHouse_Concurrency-Server-Api/internal/auth_context"
+	"github.com/Guilherme-G-Cadilhe/Go-AuctionHouse_Concurrency-Server-Api/internal/entity/auction_entity"
+	"github.com/Guilherme-G-Cadilhe/Go-AuctionHouse_Concurrency-Server-Api/internal/entity/bid_entity"
+)
+
+// MaskedUserIdPrefix identifica um user_id anonimizado nas respostas
+const MaskedUserIdPrefix = "anon-"
+
+// Masker decide se o user_id de um lance é exibido ou anonimizado
+// Leilões sealed-bid sempre mascaram; MASK_BIDDER_IDS=true mascara em todos os leilões
+// O dono do lance e os administradores (ADMIN_USER_IDS) sempre veem o id real
+type Masker struct {
+	maskAll bool
+	secret  string
+	admins  map[string]struct{} // map como "set" - struct{} não ocupa memória
+}
+
+func NewMasker(cfg config.BidConfig) *Masker {
+	admins := make(map[string]struct{}, len(cfg.AdminUserIds))
+	for _, userId := range cfg.AdminUserIds {
+		admins[userId] = struct{}{}
+	}
+
+	return &Masker{
+		maskAll: cfg.MaskBidderIds,
+		secret:  cfg.BidderMaskSecret,
+		admins:  admins,
+	}
+}
+
+// VisibleUserId retorna o user_id que o usuário autenticado no ctx pode ver para o lance
+func (m *Masker) VisibleUserId(ctx context.Context, auction *auction_entity.Auction, userId string) string {
+	if !m.maskAll && !auction.Sealed {
+		return userId
+	}
+	// O tombstone de usuário removido já não identifica ninguém; lances de convidados não têm user_id
+	if userId == bid_entity.DeletedUserId || userId == "" {
+		return userId
+	}
+
+	if requesterId, ok := auth_context.UserIDFromContext(ctx); ok {
+		if _, isAdmin := m.admins[requesterId]; isAdmin || requesterId == userId {
+			return userId
+		}
+	}
+
+	return m.maskUserId(auction.Id, userId)
+}
+
+// VisibleHandle aplica a mesma regra ao apelido dos lances de convidados
+// Convidados não se autenticam, então só administradores veem o apelido de um lance mascarado
+func (m *Masker) VisibleHandle(ctx context.Context, auction *auction_entity.Auction, handle string) string {
+	if handle == "" || (!m.maskAll && !auction.Sealed) {
+		return handle
+	}
+
+	if requesterId, ok := auth_context.UserIDFromContext(ctx); ok {
+		if _, isAdmin := m.admins[requesterId]; isAdmin {
+			return handle
+		}
+	}
+
+	// Prefixo "guest:" - o pseudônimo de um apelido nunca coincide com o de um user_id
+	return m.maskUserId(auction.Id, "guest:"+handle)
+}
+
+// maskUserId gera um pseudônimo estável: o mesmo usuário tem o mesmo id anonimizado dentro do leilão
+// O id do leilão entra no HMAC para que o pseudônimo não permita ligar o usuário entre leilões
+func (m *Masker) maskUserId(auctionId, userId string) string {
+	mac := hmac.New(sha256.New, []byte(m.secret))
+	mac.Write([]byte(auctionId + "|" + userId))
+	// 16 bytes (32 caracteres hex) bastam para não haver colisão entre participantes
+	return MaskedUserIdPrefix + hex.EncodeToString(mac.Sum(nil)[:16])
+}
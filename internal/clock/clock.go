@@ -0,0 +1,65 @@
+// Package clock abstrai o relógio usado pela lógica baseada em tempo
+// (timers do batch de lances, fim dos leilões e timestamps dos lances)
+// No Node.js seria como usar os "fake timers" do Jest - aqui a troca é feita por injeção de dependência
+package clock
+
+import "time"
+
+// Clock é a interface injetada nos componentes que dependem de tempo
+// Em produção usa-se New() (relógio real); em testes, NewFake() permite avançar o tempo manualmente
+type Clock interface {
+	Now() time.Time
+	NewTimer(d time.Duration) Timer
+	After(d time.Duration) <-chan time.Time
+	// AfterFunc executa f quando d expirar (usado no fechamento automático dos leilões)
+	AfterFunc(d time.Duration, f func()) Timer
+}
+
+// Timer espelha os métodos de *time.Timer
+// C é um método (e não campo) para que a implementação fake possa controlar o channel
+type Timer interface {
+	C() <-chan time.Time
+	Stop() bool
+	Reset(d time.Duration) bool
+}
+
+// realClock delega tudo para o pacote time
+type realClock struct{}
+
+// New retorna o relógio real do sistema
+func New() Clock {
+	return realClock{}
+}
+
+func (realClock) Now() time.Time {
+	return time.Now()
+}
+
+func (realClock) NewTimer(d time.Duration) Timer {
+	return &realTimer{timer: time.NewTimer(d)}
+}
+
+func (realClock) After(d time.Duration) <-chan time.Time {
+	return time.After(d)
+}
+
+func (realClock) AfterFunc(d time.Duration, f func()) Timer {
+	return &realTimer{timer: time.AfterFunc(d, f)}
+}
+
+type realTimer struct {
+	timer *time.Timer
+}
+
+// C retorna nil para timers criados com AfterFunc (mesmo comportamento do time.Timer)
+func (rt *realTimer) C() <-chan time.Time {
+	return rt.timer.C
+}
+
+func (rt *realTimer) Stop() bool {
+	return rt.timer.Stop()
+}
+
+func (rt *realTimer) Reset(d time.Duration) bool {
+	return rt.timer.Reset(d)
+}
@@ -0,0 +1,123 @@
+package clock
+
+import (
+	"sort"
+	"sync"
+	"time"
+)
+
+// FakeClock é um relógio controlado manualmente para testes determinísticos
+// O tempo só anda quando Advance é chamado - sem sleeps reais
+//
+// Exemplo:
+//
+//	fake := clock.NewFake(time.Now())
+//	useCase := bid_usecase.NewBidUseCase(bidRepo, auctionRepo, cfg, fake)
+//	fake.Advance(cfg.BatchInsertInterval) // dispara o flush do batch por tempo
+type FakeClock struct {
+	mutex  sync.Mutex
+	now    time.Time
+	timers map[*fakeTimer]struct{} // Timers ativos aguardando o deadline
+}
+
+// NewFake cria um FakeClock parado no instante informado
+func NewFake(now time.Time) *FakeClock {
+	return &FakeClock{
+		now:    now,
+		timers: make(map[*fakeTimer]struct{}),
+	}
+}
+
+func (fc *FakeClock) Now() time.Time {
+	fc.mutex.Lock()
+	defer fc.mutex.Unlock()
+	return fc.now
+}
+
+func (fc *FakeClock) NewTimer(d time.Duration) Timer {
+	timer := &fakeTimer{clock: fc, channel: make(chan time.Time, 1)}
+	timer.Reset(d)
+	return timer
+}
+
+func (fc *FakeClock) After(d time.Duration) <-chan time.Time {
+	return fc.NewTimer(d).C()
+}
+
+func (fc *FakeClock) AfterFunc(d time.Duration, f func()) Timer {
+	timer := &fakeTimer{clock: fc, fn: f}
+	timer.Reset(d)
+	return timer
+}
+
+// Advance avança o relógio e dispara, em ordem de deadline, os timers vencidos
+// Funções de AfterFunc rodam de forma SÍNCRONA (na goroutine de quem chamou Advance)
+// Timers com duração <= 0 só disparam no próximo Advance (inclusive Advance(0))
+func (fc *FakeClock) Advance(d time.Duration) {
+	fc.mutex.Lock()
+	fc.now = fc.now.Add(d)
+	now := fc.now
+
+	var due []*fakeTimer
+	for timer := range fc.timers {
+		if !timer.deadline.After(now) {
+			due = append(due, timer)
+			delete(fc.timers, timer)
+		}
+	}
+	fc.mutex.Unlock()
+
+	sort.Slice(due, func(i, j int) bool {
+		return due[i].deadline.Before(due[j].deadline)
+	})
+
+	// Dispara fora do lock - a função pode reagendar timers no próprio relógio
+	for _, timer := range due {
+		if timer.fn != nil {
+			timer.fn()
+			continue
+		}
+		// Envio não-bloqueante: igual ao time.Timer, um disparo não lido é descartado
+		select {
+		case timer.channel <- now:
+		default:
+		}
+	}
+}
+
+// Set move o relógio para um instante absoluto (equivale a Advance(t - Now()))
+func (fc *FakeClock) Set(t time.Time) {
+	fc.Advance(t.Sub(fc.Now()))
+}
+
+type fakeTimer struct {
+	clock    *FakeClock
+	deadline time.Time
+	channel  chan time.Time // nil para timers de AfterFunc
+	fn       func()
+}
+
+func (ft *fakeTimer) C() <-chan time.Time {
+	return ft.channel
+}
+
+// Stop retorna true se o timer ainda estava ativo
+func (ft *fakeTimer) Stop() bool {
+	ft.clock.mutex.Lock()
+	defer ft.clock.mutex.Unlock()
+
+	_, active := ft.clock.timers[ft]
+	delete(ft.clock.timers, ft)
+	return active
+}
+
+// Reset reagenda o timer para Now() + d e retorna true se ele ainda estava ativo
+func (ft *fakeTimer) Reset(d time.Duration) bool {
+	ft.clock.mutex.Lock()
+	defer ft.clock.mutex.Unlock()
+
+	_, active := ft.clock.timers[ft]
+	ft.deadline = ft.clock.now.Add(d)
+	ft.clock.timers[ft] = struct{}{}
+	return active
+}
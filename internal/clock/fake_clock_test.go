@@ -0,0 +1,79 @@
+package clock
+
+import (
+	"testing"
+	"time"
+)
+
+var testStart = time.Date(2024, 1, 2, 15, 0, 0, 0, time.UTC)
+
+func TestFakeClockTimerFiresOnlyAfterDeadline(t *testing.T) {
+	fake := NewFake(testStart)
+	timer := fake.NewTimer(time.Minute)
+
+	fake.Advance(59 * time.Second)
+	select {
+	case <-timer.C():
+		t.Fatal("timer fired before its deadline")
+	default:
+	}
+
+	fake.Advance(time.Second)
+	select {
+	case fired := <-timer.C():
+		if !fired.Equal(testStart.Add(time.Minute)) {
+			t.Fatalf("timer fired at %v, want %v", fired, testStart.Add(time.Minute))
+		}
+	default:
+		t.Fatal("timer did not fire at its deadline")
+	}
+}
+
+func TestFakeClockStopAndReset(t *testing.T) {
+	fake := NewFake(testStart)
+	timer := fake.NewTimer(time.Minute)
+
+	if !timer.Stop() {
+		t.Fatal("Stop on an active timer should return true")
+	}
+	fake.Advance(time.Hour)
+	select {
+	case <-timer.C():
+		t.Fatal("stopped timer fired")
+	default:
+	}
+
+	if timer.Reset(time.Second) {
+		t.Fatal("Reset on a stopped timer should return false")
+	}
+	fake.Advance(time.Second)
+	select {
+	case <-timer.C():
+	default:
+		t.Fatal("reset timer did not fire")
+	}
+}
+
+// AfterFunc roda na goroutine do Advance, em ordem de deadline
+func TestFakeClockAfterFuncRunsInDeadlineOrder(t *testing.T) {
+	fake := NewFake(testStart)
+	var order []string
+	fake.AfterFunc(2*time.Second, func() { order = append(order, "second") })
+	fake.AfterFunc(time.Second, func() { order = append(order, "first") })
+
+	fake.Advance(3 * time.Second)
+	if len(order) != 2 || order[0] != "first" || order[1] != "second" {
+		t.Fatalf("AfterFunc order = %v, want [first second]", order)
+	}
+}
+
+func TestFakeClockSet(t *testing.T) {
+	fake := NewFake(testStart)
+	fired := false
+	fake.AfterFunc(time.Hour, func() { fired = true })
+
+	fake.Set(testStart.Add(time.Hour))
+	if !fired || !fake.Now().Equal(testStart.Add(time.Hour)) {
+		t.Fatalf("Set did not move the clock and fire the due timer (now %v, fired %v)", fake.Now(), fired)
+	}
+}
@@ -0,0 +1,29 @@
+package auction_entity
+
+import (
+	"github.com/Guilherme-G-Cadilhe/Go-AuctionHouse_Concurrency-Server-Api/internal/entity/bid_entity"
+	"github.com/Guilherme-G-Cadilhe/Go-AuctionHouse_Concurrency-Server-Api/internal/internal_error"
+)
+
+// CloseReason explica o resultado de um leilão fechado - gravado no fechamento e devolvido na consulta do vencedor
+// Vazio enquanto o leilão está ativo (e em documentos fechados antes do campo existir)
+type CloseReason string
+
+const (
+	CloseReasonSold   CloseReason = "sold"    // Fechou com um lance vencedor
+	CloseReasonNoBids CloseReason = "no_bids" // Fechou sem nenhum lance
+)
+
+// CloseReasonFor deriva o motivo a partir da busca do lance vencedor
+// Retorna vazio quando a busca falhou por outro motivo - o resultado ainda não é conhecido
+// Não existe preço de reserva neste projeto, então "reserve_not_met" ainda não é produzido
+func CloseReasonFor(winningBid *bid_entity.Bid, err *internal_error.InternalError) CloseReason {
+	switch {
+	case err == nil && winningBid != nil:
+		return CloseReasonSold
+	case err != nil && err.Err == "not_found":
+		return CloseReasonNoBids
+	default:
+		return ""
+	}
+}
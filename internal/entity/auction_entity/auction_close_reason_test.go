@@ -0,0 +1,28 @@
+package auction_entity
+
+import (
+	"testing"
+
+	"github.com/Guilherme-G-Cadilhe/Go-AuctionHouse_Concurrency-Server-Api/internal/entity/bid_entity"
+	"github.com/Guilherme-G-Cadilhe/Go-AuctionHouse_Concurrency-Server-Api/internal/internal_error"
+)
+
+func TestCloseReasonFor(t *testing.T) {
+	tests := []struct {
+		name       string
+		winningBid *bid_entity.Bid
+		err        *internal_error.InternalError
+		want       CloseReason
+	}{
+		{"winning bid found", &bid_entity.Bid{Amount: 10}, nil, CloseReasonSold},
+		{"no bids", nil, internal_error.NewNotFoundError("no bids"), CloseReasonNoBids},
+		{"storage failure is unknown", nil, internal_error.NewInternalServerError("boom"), ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := CloseReasonFor(tt.winningBid, tt.err); got != tt.want {
+				t.Fatalf("CloseReasonFor = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
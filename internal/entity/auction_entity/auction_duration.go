@@ -0,0 +1,33 @@
+package auction_entity
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/Guilherme-G-Cadilhe/Go-AuctionHouse_Concurrency-Server-Api/internal/internal_error"
+)
+
+// DurationBounds limita quanto tempo um leilão pode ficar aberto (AUCTION_MIN_DURATION / AUCTION_MAX_DURATION)
+// Zero não limita aquele lado
+type DurationBounds struct {
+	Min time.Duration
+	Max time.Duration
+}
+
+// Check retorna a causa do campo quando a duração está fora dos limites (nil quando está dentro)
+// Os limites são inclusivos: exatamente Min ou Max são aceitos
+func (b DurationBounds) Check(field string, duration time.Duration) *internal_error.Cause {
+	if b.Min > 0 && duration < b.Min {
+		return &internal_error.Cause{
+			Field:   field,
+			Message: fmt.Sprintf("auction duration %s is below the minimum of %s", duration, b.Min),
+		}
+	}
+	if b.Max > 0 && duration > b.Max {
+		return &internal_error.Cause{
+			Field:   field,
+			Message: fmt.Sprintf("auction duration %s is above the maximum of %s", duration, b.Max),
+		}
+	}
+	return nil
+}
@@ -0,0 +1,39 @@
+package auction_entity
+
+import (
+	"testing"
+	"time"
+)
+
+func TestDurationBoundsCheck(t *testing.T) {
+	bounds := DurationBounds{Min: time.Minute, Max: time.Hour}
+
+	tests := []struct {
+		duration time.Duration
+		wantOk   bool
+	}{
+		{time.Minute - time.Nanosecond, false},
+		{time.Minute, true}, // Limites inclusivos
+		{time.Hour, true},
+		{time.Hour + time.Nanosecond, false},
+	}
+	for _, tt := range tests {
+		cause := bounds.Check("duration", tt.duration)
+		if (cause == nil) != tt.wantOk {
+			t.Errorf("Check(%s) = %+v, want ok %v", tt.duration, cause, tt.wantOk)
+		}
+		if cause != nil && cause.Field != "duration" {
+			t.Errorf("Check(%s) field = %q, want duration", tt.duration, cause.Field)
+		}
+	}
+}
+
+// Zero não limita aquele lado - o padrão é permissivo
+func TestDurationBoundsZeroIsUnbounded(t *testing.T) {
+	if cause := (DurationBounds{}).Check("duration", time.Nanosecond); cause != nil {
+		t.Fatalf("zero bounds rejected 1ns: %+v", cause)
+	}
+	if cause := (DurationBounds{}).Check("duration", 365*24*time.Hour); cause != nil {
+		t.Fatalf("zero bounds rejected one year: %+v", cause)
+	}
+}
@@ -4,35 +4,45 @@ package auction_entity
 
 import (
 	"context"
+	"fmt"
 	"time"
+	"unicode/utf8"
 
+	"github.com/Guilherme-G-Cadilhe/Go-AuctionHouse_Concurrency-Server-Api/internal/entity/bid_entity"
 	"github.com/Guilherme-G-Cadilhe/Go-AuctionHouse_Concurrency-Server-Api/internal/internal_error"
+	"github.com/Guilherme-G-Cadilhe/Go-AuctionHouse_Concurrency-Server-Api/internal/sanitize"
 	"github.com/google/uuid" // Biblioteca para gerar UUIDs únicos
 )
 
 // CreateAuctionBody é uma FUNÇÃO FACTORY para criar uma nova instância de Auction
 // Este é um padrão comum em Go - função que cria e valida entidades
 // No Node.js seria como um método estático ou um constructor com validação
+// A descrição é sanitizada antes da validação - o limite de tamanho vale para o texto que será gravado
 func CreateAuctionBody(
 	productName string,
 	category string,
 	description string,
-	condition ProductCondition) (*Auction, *internal_error.InternalError) {
+	condition ProductCondition,
+	bounds AuctionFieldBounds,
+	descriptionPolicy *sanitize.HTMLPolicy,
+	now time.Time) (*Auction, *internal_error.InternalError) {
 
 	// Cria uma nova instância de Auction com valores iniciais
+	// now vem do Clock do caso de uso - criação e EndTime seguem o relógio injetado, não o do sistema
 	auction := &Auction{
 		Id:          uuid.New().String(),
 		ProductName: productName,
 		Category:    category,
-		Description: description,
+		Description: descriptionPolicy.Sanitize(description),
 		Condition:   condition,
-		Status:      Active,     // Todo leilão inicia como "Active"
-		Timestamp:   time.Now(), // Timestamp de criação
+		Status:      Active, // Todo leilão inicia como "Active"
+		Timestamp:   now,    // Timestamp de criação (CreatedAt)
+		UpdatedAt:   now,
 	}
 
 	// Valida a entidade antes de retornar
 	// Se inválida, retorna erro sem criar o objeto
-	err := auction.Validate()
+	err := auction.Validate(bounds)
 	if err != nil {
 		return nil, err
 	}
@@ -40,26 +50,138 @@ func CreateAuctionBody(
 	return auction, nil
 }
 
+// LengthBounds define o tamanho mínimo/máximo (em caracteres) de um campo texto
+// Max 0 significa sem limite máximo
+type LengthBounds struct {
+	Min int
+	Max int
+}
+
+// AuctionFieldBounds agrupa os limites de tamanho e as condições aceitas, configuráveis por deployment
+type AuctionFieldBounds struct {
+	ProductName LengthBounds
+	Category    LengthBounds
+	Description LengthBounds
+	Conditions  ProductConditions // AUCTION_CONDITIONS (vazio = new, used e refurbished)
+}
+
 // Validate é um METHOD da struct Auction que valida suas regras de negócio
 // "(au *Auction)" é o METHOD RECEIVER - vincula o método à struct
 // Este método implementa as REGRAS DE DOMÍNIO da entidade
-func (au *Auction) Validate() *internal_error.InternalError {
-	if len(au.ProductName) <= 1 || len(au.Category) <= 2 || len(au.Description) <= 10 && (au.Condition != New && au.Condition != Used && au.Condition != Refurbished) {
-		return internal_error.NewBadRequestError("invalid data")
+// Cada regra é checada de forma independente e gera uma causa por campo
+func (au *Auction) Validate(bounds AuctionFieldBounds) *internal_error.InternalError {
+	var causes []internal_error.Cause
+
+	causes = appendLengthCause(causes, "product_name", au.ProductName, bounds.ProductName)
+	causes = appendLengthCause(causes, "category", au.Category, bounds.Category)
+	causes = appendLengthCause(causes, "description", au.Description, bounds.Description)
+
+	if !bounds.Conditions.Allows(au.Condition) {
+		causes = append(causes, internal_error.Cause{
+			Field:   "condition",
+			Message: "condition must be one of " + bounds.Conditions.Describe(),
+		})
+	}
+
+	if len(causes) > 0 {
+		return internal_error.NewBadRequestError("invalid data", causes...)
 	}
 	return nil
 }
 
+// appendLengthCause adiciona uma causa se o valor estiver fora dos limites
+// utf8.RuneCountInString conta caracteres (não bytes) - "ç" conta como 1
+func appendLengthCause(causes []internal_error.Cause, field, value string, bounds LengthBounds) []internal_error.Cause {
+	length := utf8.RuneCountInString(value)
+
+	if length < bounds.Min {
+		return append(causes, internal_error.Cause{
+			Field:   field,
+			Message: fmt.Sprintf("%s must be at least %d characters", field, bounds.Min),
+		})
+	}
+	if bounds.Max > 0 && length > bounds.Max {
+		return append(causes, internal_error.Cause{
+			Field:   field,
+			Message: fmt.Sprintf("%s must be at most %d characters", field, bounds.Max),
+		})
+	}
+	return causes
+}
+
+// BidsAreHidden indica se os lances devem ficar ocultos
+// Em leilões sealed-bid os lances só são revelados após o fechamento (Completed)
+func (au *Auction) BidsAreHidden() bool {
+	return au.Sealed && au.Status == Active
+}
+
+// CloseDeadline é o instante em que o leilão deixa de aceitar lances: fim efetivo + tolerância (AUCTION_CLOSE_SKEW)
+// O timer de fechamento automático dispara exatamente neste instante
+func CloseDeadline(endTime time.Time, closeSkew time.Duration) time.Time {
+	return endTime.Add(closeSkew)
+}
+
+// IsOpenAt é a ÚNICA regra de "o leilão aceita lances agora?"
+// Usada na validação dos lances (cache e banco) - o fechamento agenda o timer no mesmo CloseDeadline
+// Limite: no instante exato do deadline o leilão já está fechado (now < deadline, não <=)
+func IsOpenAt(status AuctionStatus, endTime time.Time, closeSkew time.Duration, now time.Time) bool {
+	return status == Active && now.Before(CloseDeadline(endTime, closeSkew))
+}
+
+// Motivos pelos quais IsOpenAt recusou o lance - vão no campo "reason" do log "bid rejected: auction is not open"
+// Não há início agendado neste projeto (o leilão abre na criação), então não existe "scheduled_not_started"
+const (
+	NotOpenCacheClosed     = "cache_closed"      // Status em cache já era Completed (ex: arremate holandês)
+	NotOpenStatusNotActive = "status_not_active" // Status lido do banco não é Active
+	NotOpenTimeExpired     = "time_expired"      // Ainda Active, mas o deadline (fim + AUCTION_CLOSE_SKEW) passou
+)
+
+// NotOpenReason explica por que IsOpenAt retorna false (vazio quando o leilão está aberto)
+// cached indica que status e fim vieram do cache do repositório de lances, e não do banco
+func NotOpenReason(status AuctionStatus, endTime time.Time, closeSkew time.Duration, now time.Time, cached bool) string {
+	switch {
+	case status != Active && cached:
+		return NotOpenCacheClosed
+	case status != Active:
+		return NotOpenStatusNotActive
+	case !now.Before(CloseDeadline(endTime, closeSkew)):
+		return NotOpenTimeExpired
+	default:
+		return ""
+	}
+}
+
 // Auction é a ENTIDADE PRINCIPAL de domínio para leilões
 // Define a estrutura de dados e comportamentos de um leilão
 type Auction struct {
-	Id          string           `json:"id"` // UUID único do leilão
-	ProductName string           `json:"product_name"`
-	Category    string           `json:"category"`
-	Description string           `json:"description"`
-	Condition   ProductCondition `json:"condition"` // Estado do produto (enum)
-	Status      AuctionStatus    `json:"status"`    // Status do leilão (enum)
-	Timestamp   time.Time        // Data/hora de criação (sem tag JSON - não exposto na API)
+	Id             string           `json:"id"` // UUID único do leilão
+	ProductName    string           `json:"product_name"`
+	Category       string           `json:"category"`
+	Description    string           `json:"description"`
+	Condition      ProductCondition `json:"condition"`        // Estado do produto (enum)
+	Status         AuctionStatus    `json:"status"`           // Status do leilão (enum)
+	OwnerId        string           `json:"owner_id"`         // Usuário autenticado que criou o leilão
+	Sealed         bool             `json:"sealed"`           // Sealed-bid: lances ocultos até o fechamento
+	Featured       bool             `json:"featured"`         // Destaque do marketplace - definido apenas por administradores
+	AllowGuestBids bool             `json:"allow_guest_bids"` // Aceita lances de convidados (GUEST_BIDS_ENABLED)
+	Timestamp      time.Time        // Data/hora de criação - o CreatedAt (sem tag JSON - não exposto na API)
+	UpdatedAt      time.Time        // Última modificação persistida (status, fim, destaque, preço, vencedor)
+	EndTime        time.Time        // Fim efetivo do leilão - criação + AUCTION_INTERVAL, podendo ser estendido
+
+	ExtensionCount int // Extensões do fim já aplicadas (limitadas por AUCTION_MAX_EXTENSIONS)
+
+	// Vencedor gravado no fechamento - vazio enquanto ativo, sem lances ou com AUCTION_PERSIST_WINNER=false
+	WinningBidId  string
+	WinningAmount float64
+	CloseReason   CloseReason // Motivo gravado no fechamento (sold / no_bids) - vazio enquanto ativo
+
+	// Leilão holandês (Type == Dutch) - ver dutch_auction.go
+	Type         AuctionType
+	Dutch        DutchSchedule
+	CurrentPrice float64 // Último preço gravado pela rotina de queda de preço
+
+	// Tabela de incrementos por faixa de preço - ver increment_schedule.go (vazia = incremento único)
+	IncrementSchedule IncrementSchedule
 }
 
 // ProductCondition é um TIPO CUSTOMIZADO baseado em int
@@ -76,14 +198,67 @@ const (
 	Completed                      // 1 - Leilão finalizado
 )
 
-// Constantes para ProductCondition
-// New = 0, Used = 1, Refurbished = 2
+// Constantes para ProductCondition - as condições padrão do AUCTION_CONDITIONS
+// New = 0, Used = 1, Refurbished = 2; outras são registradas por configuração (ver product_condition.go)
 const (
 	New         ProductCondition = iota // 0 - Produto novo
 	Used                                // 1 - Produto usado
 	Refurbished                         // 2 - Produto recondicionado
 )
 
+// CategoryCount é o resultado da agregação de leilões por categoria
+type CategoryCount struct {
+	Category string
+	Count    int64
+}
+
+// AuctionListFilter filtra a listagem de leilões; campos zero não filtram
+// Status 0 (Active) também não filtra - mesmo comportamento histórico do GET /auctions
+// FeaturedFirst ordena os leilões em destaque antes dos demais, mantendo a ordem de criação em cada grupo
+// Status é ponteiro porque Active é o zero value do enum: nil = todos os status, &Active = só ativos
+type AuctionListFilter struct {
+	Status        *AuctionStatus
+	Category      string
+	ProductName   string // Regex case-insensitive
+	FeaturedOnly  bool
+	FeaturedFirst bool
+	Limit         int // Máximo de leilões retornados (0 = sem limite)
+
+	// SummaryOnly pede só os campos da visão resumida (id, nome, status e o necessário ao preço holandês)
+	// Os demais campos da entidade voltam zerados - quem pede não deve lê-los
+	SummaryOnly bool
+}
+
+// IsUnfiltered indica que nenhum filtro restringe a busca - a listagem percorreria a coleção inteira
+// FeaturedFirst só ordena, não conta como filtro
+func (f AuctionListFilter) IsUnfiltered() bool {
+	return f.Status == nil && f.Category == "" && f.ProductName == "" && !f.FeaturedOnly
+}
+
+// AuctionWinnersFilter filtra e pagina o relatório de vencedores
+// Datas zero não limitam o período; o período é aplicado sobre o fim efetivo (EndTime)
+type AuctionWinnersFilter struct {
+	Category  string
+	EndedFrom time.Time
+	EndedTo   time.Time
+	Offset    int
+	Limit     int
+}
+
+// UserLeadingFilter pagina os leilões ativos em que o usuário tem o maior lance
+type UserLeadingFilter struct {
+	UserId string
+	Offset int
+	Limit  int
+}
+
+// AuctionWinner é um leilão fechado com seu lance vencedor (nil quando não houve lances)
+// Também usado para leilões ativos com o lance que lidera no momento
+type AuctionWinner struct {
+	Auction    Auction
+	WinningBid *bid_entity.Bid
+}
+
 // AuctionRepositoryInterface define o CONTRATO para persistência de leilões
 // Interface na camada de domínio = independente de implementação (MongoDB, PostgreSQL, etc.)
 type AuctionRepositoryInterface interface {
@@ -93,10 +268,43 @@ type AuctionRepositoryInterface interface {
 	FindAuctionById(ctx context.Context, id string) (*Auction, *internal_error.InternalError)
 	// FindAllAuctions busca leilões com filtros opcionais
 	// Se os filtros forem vazios/zero, busca todos
-	FindAllAuctions(
-		ctx context.Context,
-		status AuctionStatus,
-		category, productName string) ([]Auction, *internal_error.InternalError) // Retorna slice de leilões
+	FindAllAuctions(ctx context.Context, filter AuctionListFilter) ([]Auction, *internal_error.InternalError) // Retorna slice de leilões
+	// FindAuctionsByIds busca vários leilões em uma única query; ids inexistentes são omitidos
+	FindAuctionsByIds(ctx context.Context, ids []string) ([]Auction, *internal_error.InternalError)
+	// AggregateCategoryCounts conta leilões por categoria para o status informado
+	AggregateCategoryCounts(ctx context.Context, status AuctionStatus) ([]CategoryCount, *internal_error.InternalError)
+	// FindAuctionWinners busca uma página de leilões fechados com o lance vencedor e o total sem paginação
+	FindAuctionWinners(ctx context.Context, filter AuctionWinnersFilter) ([]AuctionWinner, int64, *internal_error.InternalError)
+	// FindAuctionsLeadByUser busca leilões ativos (não sealed) cujo maior lance é do usuário, dos que terminam antes
+	FindAuctionsLeadByUser(ctx context.Context, filter UserLeadingFilter) ([]AuctionWinner, *internal_error.InternalError)
+	// UpdateAuctionEndTime altera o fim efetivo de um leilão ativo e reagenda o fechamento
+	// maxExtensions > 0 só aplica a extensão enquanto ExtensionCount < maxExtensions (conflict caso contrário)
+	UpdateAuctionEndTime(ctx context.Context, auctionId string, endTime time.Time, maxExtensions int) *internal_error.InternalError
+	// SetAuctionFeatured marca ou desmarca o leilão como destaque
+	SetAuctionFeatured(ctx context.Context, auctionId string, featured bool) *internal_error.InternalError
+	// SetAuctionWinner grava o lance informado como vencedor de um leilão fechado, independente do valor
+	// Leilão ativo (ou inexistente) retorna conflict - o fechamento recalcularia o vencedor
+	SetAuctionWinner(ctx context.Context, auctionId string, bid bid_entity.Bid) *internal_error.InternalError
+	// UpdateAuctionWinner grava o vencedor recalculado de um leilão fechado; nil remove o vencedor (no_bids)
+	// Mesma regra do SetAuctionWinner: leilão ativo (ou inexistente) retorna conflict
+	UpdateAuctionWinner(ctx context.Context, auctionId string, winningBid *bid_entity.Bid) *internal_error.InternalError
+	// DeleteAuction remove um leilão fechado com seus lances, histórico e observadores - sem lances órfãos
+	// Mesma regra do SetAuctionWinner: leilão ativo (ou inexistente) retorna conflict
+	DeleteAuction(ctx context.Context, auctionId string) *internal_error.InternalError
+	// AddAuctionWatcher / RemoveAuctionWatcher incluem ou removem o usuário dos observadores do leilão
+	// (idempotentes) e retornam quantos observadores o leilão tem depois da operação
+	AddAuctionWatcher(ctx context.Context, auctionId, userId string) (int64, *internal_error.InternalError)
+	RemoveAuctionWatcher(ctx context.Context, auctionId, userId string) (int64, *internal_error.InternalError)
+	// CountAuctionWatchers conta os observadores do leilão (0 se ninguém observa)
+	CountAuctionWatchers(ctx context.Context, auctionId string) (int64, *internal_error.InternalError)
+	// ReopenAuction volta um leilão Completed para Active com um novo fim e reagenda o fechamento
+	ReopenAuction(ctx context.Context, auctionId string, endTime time.Time) *internal_error.InternalError
+	// CreateAuctionEvent registra uma transição de status (created, closed, cancelled, reopened)
+	CreateAuctionEvent(ctx context.Context, event *AuctionEvent) *internal_error.InternalError
+	// FindAuctionEventsByAuctionId busca as transições de status de um leilão
+	FindAuctionEventsByAuctionId(ctx context.Context, auctionId string) ([]AuctionEvent, *internal_error.InternalError)
+	// Ping verifica se o armazenamento está acessível (healthcheck)
+	Ping(ctx context.Context) *internal_error.InternalError
 }
 
 /*
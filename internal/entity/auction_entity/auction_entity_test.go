@@ -0,0 +1,55 @@
+package auction_entity
+
+import (
+	"testing"
+	"time"
+)
+
+func TestIsOpenAtBoundary(t *testing.T) {
+	endTime := time.Date(2024, 1, 2, 15, 0, 0, 0, time.UTC)
+	const skew = 2 * time.Second
+
+	tests := []struct {
+		name       string
+		status     AuctionStatus
+		now        time.Time
+		wantOpen   bool
+		wantReason string
+	}{
+		{"before end", Active, endTime.Add(-time.Second), true, ""},
+		{"at end, inside the skew", Active, endTime, true, ""},
+		{"just before the deadline", Active, endTime.Add(skew - time.Nanosecond), true, ""},
+		{"exactly at the deadline", Active, endTime.Add(skew), false, NotOpenTimeExpired},
+		{"after the deadline", Active, endTime.Add(time.Minute), false, NotOpenTimeExpired},
+		{"completed before the end", Completed, endTime.Add(-time.Minute), false, NotOpenStatusNotActive},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := IsOpenAt(tt.status, endTime, skew, tt.now); got != tt.wantOpen {
+				t.Fatalf("IsOpenAt = %v, want %v", got, tt.wantOpen)
+			}
+			if got := NotOpenReason(tt.status, endTime, skew, tt.now, false); got != tt.wantReason {
+				t.Fatalf("NotOpenReason = %q, want %q", got, tt.wantReason)
+			}
+		})
+	}
+}
+
+func TestIsOpenAtWithoutSkewClosesAtEndTime(t *testing.T) {
+	endTime := time.Date(2024, 1, 2, 15, 0, 0, 0, time.UTC)
+
+	if !IsOpenAt(Active, endTime, 0, endTime.Add(-time.Nanosecond)) {
+		t.Fatal("auction should be open right before the end time")
+	}
+	if IsOpenAt(Active, endTime, 0, endTime) {
+		t.Fatal("auction should be closed at the end time when there is no skew")
+	}
+}
+
+func TestNotOpenReasonCached(t *testing.T) {
+	endTime := time.Date(2024, 1, 2, 15, 0, 0, 0, time.UTC)
+
+	if got := NotOpenReason(Completed, endTime, 0, endTime.Add(-time.Minute), true); got != NotOpenCacheClosed {
+		t.Fatalf("NotOpenReason = %q, want %q", got, NotOpenCacheClosed)
+	}
+}
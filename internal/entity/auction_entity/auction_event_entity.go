@@ -0,0 +1,49 @@
+package auction_entity
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// AuctionEventType identifica o tipo de transição de status registrada
+type AuctionEventType string
+
+const (
+	AuctionCreatedEvent   AuctionEventType = "created"
+	AuctionClosedEvent    AuctionEventType = "closed"
+	AuctionCancelledEvent AuctionEventType = "cancelled"
+	AuctionReopenedEvent  AuctionEventType = "reopened"
+	AuctionExtendedEvent  AuctionEventType = "extended"
+	// Vencedor definido manualmente por um administrador (resolução de disputas)
+	AuctionWinnerOverriddenEvent AuctionEventType = "winner_overridden"
+	// Vencedor gravado de novo pela reconciliação (POST /internal/auctions/recompute-winners)
+	AuctionWinnerRecomputedEvent AuctionEventType = "winner_recomputed"
+)
+
+// AuctionEvent registra uma mudança de status do leilão com o momento em que ocorreu
+// Usado para montar o histórico (activity feed) junto com os lances
+type AuctionEvent struct {
+	Id        string
+	AuctionId string
+	Type      AuctionEventType
+	Timestamp time.Time
+	EndTime   time.Time // Novo fim efetivo - preenchido apenas em eventos "extended"
+}
+
+// NewAuctionEvent cria um evento de status no instante now (Clock do repositório)
+func NewAuctionEvent(auctionId string, eventType AuctionEventType, now time.Time) *AuctionEvent {
+	return &AuctionEvent{
+		Id:        uuid.New().String(),
+		AuctionId: auctionId,
+		Type:      eventType,
+		Timestamp: now,
+	}
+}
+
+// NewAuctionExtendedEvent registra uma extensão do leilão com o novo fim efetivo
+func NewAuctionExtendedEvent(auctionId string, endTime, now time.Time) *AuctionEvent {
+	event := NewAuctionEvent(auctionId, AuctionExtendedEvent, now)
+	event.EndTime = endTime
+	return event
+}
@@ -0,0 +1,91 @@
+package auction_entity
+
+import (
+	"math"
+	"time"
+
+	"github.com/Guilherme-G-Cadilhe/Go-AuctionHouse_Concurrency-Server-Api/internal/internal_error"
+)
+
+// AuctionType define a dinâmica de preço do leilão
+type AuctionType int
+
+const (
+	English AuctionType = iota // 0 - Lances crescentes, vence o maior (padrão)
+	Dutch                      // 1 - Preço decrescente, vence o primeiro lance >= preço atual
+)
+
+// DutchSchedule é a tabela de preços de um leilão holandês
+// O preço começa em StartPrice e cai PriceDecrement a cada DecrementInterval até FloorPrice
+// Valores na mesma unidade do AMOUNT_MODE (centavos em modo cents)
+type DutchSchedule struct {
+	StartPrice        float64
+	FloorPrice        float64
+	PriceDecrement    float64
+	DecrementInterval time.Duration
+}
+
+// ConfigureDutch transforma o leilão em holandês com a tabela de preços informada
+// Sealed-bid não se aplica: no holandês o primeiro lance válido encerra o leilão
+func (au *Auction) ConfigureDutch(schedule DutchSchedule) *internal_error.InternalError {
+	var causes []internal_error.Cause
+
+	if schedule.FloorPrice <= 0 {
+		causes = append(causes, internal_error.Cause{Field: "floor_price", Message: "floor_price must be greater than 0"})
+	}
+	if schedule.StartPrice <= schedule.FloorPrice {
+		causes = append(causes, internal_error.Cause{Field: "start_price", Message: "start_price must be greater than floor_price"})
+	}
+	if schedule.PriceDecrement <= 0 {
+		causes = append(causes, internal_error.Cause{Field: "price_decrement", Message: "price_decrement must be greater than 0"})
+	}
+	if schedule.DecrementInterval <= 0 {
+		causes = append(causes, internal_error.Cause{Field: "decrement_interval", Message: "decrement_interval must be a positive duration (e.g. 30s, 1m)"})
+	}
+	if au.Sealed {
+		causes = append(causes, internal_error.Cause{Field: "sealed", Message: "dutch auctions cannot be sealed"})
+	}
+
+	if len(causes) > 0 {
+		return internal_error.NewBadRequestError("invalid dutch auction", causes...)
+	}
+
+	au.Type = Dutch
+	au.Dutch = schedule
+	au.CurrentPrice = schedule.StartPrice
+	return nil
+}
+
+// IsDutch indica se o leilão usa preço decrescente
+func (au *Auction) IsDutch() bool {
+	return au.Type == Dutch
+}
+
+// DutchPriceAt calcula o preço do leilão holandês no instante "now" a partir da tabela
+// É a referência para aceitar lances - CurrentPrice (gravado pela rotina de queda) pode estar um passo atrasado
+// Arredonda em centavos para não acumular ruído de ponto flutuante (ex: 100 - 3*0.1)
+func (au *Auction) DutchPriceAt(now time.Time) float64 {
+	steps := au.dutchStepsAt(now)
+	price := au.Dutch.StartPrice - float64(steps)*au.Dutch.PriceDecrement
+	price = math.Round(price*100) / 100
+	return math.Max(price, au.Dutch.FloorPrice)
+}
+
+// NextPriceDropAt retorna o instante da próxima queda de preço
+// false quando o preço já chegou ao piso - não há mais quedas a agendar
+func (au *Auction) NextPriceDropAt(now time.Time) (time.Time, bool) {
+	if au.DutchPriceAt(now) <= au.Dutch.FloorPrice {
+		return time.Time{}, false
+	}
+	steps := au.dutchStepsAt(now)
+	return au.Timestamp.Add(time.Duration(steps+1) * au.Dutch.DecrementInterval), true
+}
+
+// dutchStepsAt conta quantas quedas de preço já aconteceram desde a criação
+func (au *Auction) dutchStepsAt(now time.Time) int64 {
+	elapsed := now.Sub(au.Timestamp)
+	if elapsed <= 0 || au.Dutch.DecrementInterval <= 0 {
+		return 0
+	}
+	return int64(elapsed / au.Dutch.DecrementInterval)
+}
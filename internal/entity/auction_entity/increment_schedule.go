@@ -0,0 +1,82 @@
+package auction_entity
+
+import (
+	"fmt"
+
+	"github.com/Guilherme-G-Cadilhe/Go-AuctionHouse_Concurrency-Server-Api/internal/internal_error"
+)
+
+// maxIncrementTiers limita o tamanho da tabela gravada no documento do leilão
+const maxIncrementTiers = 20
+
+// IncrementTier é uma faixa da tabela de incrementos: a partir de Threshold, o próximo lance sobe Increment
+// Valores na mesma unidade do AMOUNT_MODE (centavos em modo cents)
+type IncrementTier struct {
+	Threshold float64
+	Increment float64
+}
+
+// IncrementSchedule é a tabela de incrementos do leilão, como nas casas de leilão tradicionais
+// Ex: [{0, 1}, {100, 5}, {500, 25}] = +1 abaixo de 100, +5 de 100 a 499.99, +25 a partir de 500
+// Vazia = leilão sem tabela (o lance só precisa superar o vencedor pelo MIN_BID_INCREMENT)
+type IncrementSchedule []IncrementTier
+
+// ConfigureIncrementSchedule grava a tabela de incrementos no leilão
+// As faixas precisam estar em ordem crescente de threshold, sem repetição (uma faixa por preço)
+// Leilão holandês não tem tabela: o preço cai, não sobe por lances
+func (au *Auction) ConfigureIncrementSchedule(schedule IncrementSchedule) *internal_error.InternalError {
+	if len(schedule) == 0 {
+		return nil
+	}
+
+	var causes []internal_error.Cause
+	if au.IsDutch() {
+		causes = append(causes, internal_error.Cause{Field: "increment_schedule", Message: "dutch auctions cannot have an increment schedule"})
+	}
+	if len(schedule) > maxIncrementTiers {
+		causes = append(causes, internal_error.Cause{Field: "increment_schedule", Message: fmt.Sprintf("increment_schedule must have at most %d tiers", maxIncrementTiers)})
+	}
+	for i, tier := range schedule {
+		field := fmt.Sprintf("increment_schedule[%d]", i)
+		if tier.Threshold < 0 {
+			causes = append(causes, internal_error.Cause{Field: field, Message: "threshold must not be negative"})
+		}
+		if tier.Increment <= 0 {
+			causes = append(causes, internal_error.Cause{Field: field, Message: "increment must be greater than 0"})
+		}
+		if i > 0 && tier.Threshold <= schedule[i-1].Threshold {
+			causes = append(causes, internal_error.Cause{Field: field, Message: "thresholds must be in ascending order without repeats"})
+		}
+	}
+
+	if len(causes) > 0 {
+		return internal_error.NewBadRequestError("invalid increment schedule", causes...)
+	}
+
+	au.IncrementSchedule = schedule
+	return nil
+}
+
+// HasIncrementSchedule indica se o leilão exige o incremento da tabela nos lances
+func (au *Auction) HasIncrementSchedule() bool {
+	return len(au.IncrementSchedule) > 0
+}
+
+// IncrementFor retorna o incremento da faixa em que o preço atual se encontra
+// A faixa vale a partir do seu threshold (inclusive); preço abaixo da primeira faixa usa defaultIncrement
+func (s IncrementSchedule) IncrementFor(price, defaultIncrement float64) float64 {
+	increment := defaultIncrement
+	for _, tier := range s {
+		if price < tier.Threshold {
+			break
+		}
+		increment = tier.Increment
+	}
+	return increment
+}
+
+// BidIncrementAt é o incremento exigido sobre o lance vencedor "price"
+// Sem tabela, o incremento único do AUCTION_MIN_BID_INCREMENT
+func (au *Auction) BidIncrementAt(price, defaultIncrement float64) float64 {
+	return au.IncrementSchedule.IncrementFor(price, defaultIncrement)
+}
@@ -0,0 +1,75 @@
+package auction_entity
+
+import "testing"
+
+// testSchedule: incremento padrão abaixo de 10, +1 até 99.99, +5 de 100 a 499.99, +25 a partir de 500
+func testSchedule() IncrementSchedule {
+	return IncrementSchedule{{Threshold: 10, Increment: 1}, {Threshold: 100, Increment: 5}, {Threshold: 500, Increment: 25}}
+}
+
+func TestIncrementForTierBoundaries(t *testing.T) {
+	tests := []struct {
+		price float64
+		want  float64
+	}{
+		{5, 2}, // Abaixo da primeira faixa: incremento padrão
+		{10, 1},
+		{99.99, 1},
+		{100, 5}, // O threshold já pertence à faixa
+		{499.99, 5},
+		{500, 25},
+		{10000, 25},
+	}
+	for _, tt := range tests {
+		if got := testSchedule().IncrementFor(tt.price, 2); got != tt.want {
+			t.Errorf("IncrementFor(%v) = %v, want %v", tt.price, got, tt.want)
+		}
+	}
+}
+
+func TestBidIncrementAtWithoutSchedule(t *testing.T) {
+	auction := &Auction{}
+	if got := auction.BidIncrementAt(1000, 2); got != 2 {
+		t.Fatalf("BidIncrementAt without schedule = %v, want the default 2", got)
+	}
+}
+
+func TestConfigureIncrementSchedule(t *testing.T) {
+	tests := []struct {
+		name      string
+		auction   Auction
+		schedule  IncrementSchedule
+		wantField string // "" = aceita
+	}{
+		{"ascending tiers", Auction{}, testSchedule(), ""},
+		{"repeated threshold", Auction{}, IncrementSchedule{{0, 1}, {100, 5}, {100, 10}}, "increment_schedule[2]"},
+		{"descending thresholds", Auction{}, IncrementSchedule{{100, 5}, {0, 1}}, "increment_schedule[1]"},
+		{"zero increment", Auction{}, IncrementSchedule{{0, 0}}, "increment_schedule[0]"},
+		{"negative threshold", Auction{}, IncrementSchedule{{-1, 1}}, "increment_schedule[0]"},
+		{"dutch auction", Auction{Type: Dutch}, testSchedule(), "increment_schedule"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			auction := tt.auction
+			err := auction.ConfigureIncrementSchedule(tt.schedule)
+			if tt.wantField == "" {
+				if err != nil {
+					t.Fatalf("ConfigureIncrementSchedule: %v", err)
+				}
+				if !auction.HasIncrementSchedule() {
+					t.Fatal("accepted schedule was not stored")
+				}
+				return
+			}
+			if err == nil || err.Err != "bad_request" {
+				t.Fatalf("err = %v, want bad_request", err)
+			}
+			if len(err.Causes) != 1 || err.Causes[0].Field != tt.wantField {
+				t.Fatalf("causes = %+v, want a single %s", err.Causes, tt.wantField)
+			}
+			if auction.HasIncrementSchedule() {
+				t.Fatal("rejected schedule was stored")
+			}
+		})
+	}
+}
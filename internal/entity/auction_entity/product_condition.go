@@ -0,0 +1,94 @@
+package auction_entity
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// ConditionDefinition associa o valor gravado no leilão ao nome exibido pela UI
+type ConditionDefinition struct {
+	Value ProductCondition
+	Name  string
+}
+
+// ProductConditions é o conjunto de condições aceitas na criação (AUCTION_CONDITIONS)
+// O valor numérico continua sendo o que é gravado - por isso cada entrada fixa o próprio número:
+// reordenar ou remover uma condição não muda o significado dos leilões já gravados
+type ProductConditions []ConditionDefinition
+
+// DefaultProductConditions são as condições históricas (New, Used, Refurbished)
+func DefaultProductConditions() ProductConditions {
+	return ProductConditions{
+		{Value: New, Name: "new"},
+		{Value: Used, Name: "used"},
+		{Value: Refurbished, Name: "refurbished"},
+	}
+}
+
+// ParseProductConditions interpreta entradas "<valor>:<nome>" (ex: "3:for_parts")
+// Lista vazia mantém as condições padrão
+func ParseProductConditions(entries []string) (ProductConditions, error) {
+	if len(entries) == 0 {
+		return DefaultProductConditions(), nil
+	}
+
+	conditions := make(ProductConditions, 0, len(entries))
+	seenValues := make(map[ProductCondition]struct{}, len(entries))
+	seenNames := make(map[string]struct{}, len(entries))
+	for _, entry := range entries {
+		rawValue, name, found := strings.Cut(entry, ":")
+		name = strings.TrimSpace(name)
+		if !found || name == "" {
+			return nil, fmt.Errorf("condition %q must use the format <value>:<name>", entry)
+		}
+		value, err := strconv.Atoi(strings.TrimSpace(rawValue))
+		if err != nil || value < 0 {
+			return nil, fmt.Errorf("condition %q must have a non-negative integer value", entry)
+		}
+
+		condition := ProductCondition(value)
+		if _, ok := seenValues[condition]; ok {
+			return nil, fmt.Errorf("condition value %d is repeated", value)
+		}
+		if _, ok := seenNames[name]; ok {
+			return nil, fmt.Errorf("condition name %q is repeated", name)
+		}
+		seenValues[condition] = struct{}{}
+		seenNames[name] = struct{}{}
+
+		conditions = append(conditions, ConditionDefinition{Value: condition, Name: name})
+	}
+	return conditions, nil
+}
+
+// Allows indica se a condição faz parte do conjunto
+// Conjunto vazio (zero value) usa as condições padrão
+func (pc ProductConditions) Allows(condition ProductCondition) bool {
+	for _, definition := range pc.orDefault() {
+		if definition.Value == condition {
+			return true
+		}
+	}
+	return false
+}
+
+// Describe monta a lista usada na mensagem de validação: "0 (new), 1 (used) or 2 (refurbished)"
+func (pc ProductConditions) Describe() string {
+	conditions := pc.orDefault()
+	parts := make([]string, len(conditions))
+	for i, definition := range conditions {
+		parts[i] = fmt.Sprintf("%d (%s)", definition.Value, definition.Name)
+	}
+	if len(parts) == 1 {
+		return parts[0]
+	}
+	return strings.Join(parts[:len(parts)-1], ", ") + " or " + parts[len(parts)-1]
+}
+
+func (pc ProductConditions) orDefault() ProductConditions {
+	if len(pc) == 0 {
+		return DefaultProductConditions()
+	}
+	return pc
+}
@@ -0,0 +1,212 @@
+package bid_entity
+
+import (
+	"cmp"
+	"math"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/Guilherme-G-Cadilhe/Go-AuctionHouse_Concurrency-Server-Api/internal/internal_error"
+)
+
+// AmountMode define como os valores dos lances são interpretados e persistidos
+//   - float: valores decimais (ex: 10.50) - modo padrão, compatível com dados antigos
+//   - cents: valores inteiros em centavos (ex: 1050) - evita erros de ponto flutuante
+type AmountMode string
+
+const (
+	AmountModeFloat AmountMode = "float"
+	AmountModeCents AmountMode = "cents"
+)
+
+// amountMode é definido uma única vez na inicialização (SetAmountMode)
+// É regra de domínio usada por Validate/CompareAmounts, por isso fica no pacote da entidade
+var amountMode = AmountModeFloat
+
+// SetAmountMode configura o modo de valores a partir do AMOUNT_MODE já carregado pelo config
+// Qualquer valor diferente de "cents" mantém o modo float (backward compatibility)
+func SetAmountMode(mode string) {
+	if strings.EqualFold(mode, string(AmountModeCents)) {
+		amountMode = AmountModeCents
+		return
+	}
+	amountMode = AmountModeFloat
+}
+
+// GetAmountMode retorna o modo de valores configurado
+func GetAmountMode() AmountMode {
+	return amountMode
+}
+
+// DefaultAmountEpsilon absorve o erro de representação do float64 (~1e-15 em valores de lance)
+// sem chegar perto de um centavo
+const DefaultAmountEpsilon = 1e-9
+
+// maxAmountEpsilon é meio centavo: acima disso 10.10 e 10.09 passariam a empatar
+const maxAmountEpsilon = 0.005
+
+// amountEpsilon é a tolerância das comparações em modo float (AMOUNT_EPSILON)
+var amountEpsilon = DefaultAmountEpsilon
+
+// SetAmountEpsilon configura a tolerância a partir do AMOUNT_EPSILON já carregado pelo config
+// Mesmo critério do SetAmountMode: valor inválido (<= 0 ou >= meio centavo) mantém o padrão
+func SetAmountEpsilon(epsilon float64) {
+	if epsilon <= 0 || epsilon >= maxAmountEpsilon {
+		amountEpsilon = DefaultAmountEpsilon
+		return
+	}
+	amountEpsilon = epsilon
+}
+
+// CompareAmounts é a ÚNICA comparação de valores do pipeline de lances e do vencedor
+// Retorna -1 (a < b), 0 (empate) ou 1 (a > b)
+//   - cents: inteiros, comparação exata
+//   - float: diferenças até o epsilon são empate - 10.10 vence 10.09, mas 0.1+0.2 empata com 0.3
+//
+// Necessário porque valores calculados (ex: preço do leilão holandês = início - n * decremento)
+// não são bit a bit iguais ao mesmo valor digitado pelo usuário
+func CompareAmounts(a, b float64) int {
+	if GetAmountMode() == AmountModeCents {
+		return cmp.Compare(int64(a), int64(b))
+	}
+	if math.Abs(a-b) <= amountEpsilon {
+		return 0
+	}
+	return cmp.Compare(a, b)
+}
+
+// ToCents converte um valor decimal para centavos inteiros
+// math.Round evita que 10.29 * 100 = 1028.9999... vire 1028
+func ToCents(amount float64) int64 {
+	return int64(math.Round(amount * 100))
+}
+
+// amountDecimals é a menor unidade da moeda (centavos do USD) - a mesma escala do ToCents
+const amountDecimals = 2
+
+// RoundAmount arredonda o valor para a menor unidade da moeda com round-half-even ("arredondamento bancário")
+// É aplicado na criação do lance: o valor gravado, devolvido e comparado é sempre o arredondado
+//   - 10.004 -> 10.00; 10.006 -> 10.01
+//   - meio centavo vai para o centavo par: 10.005 -> 10.00 e 10.015 -> 10.02 (sem viés para cima)
+//
+// O arredondamento é feito no texto decimal mais curto do float64 ("10.005"), não em amount*100:
+// em binário 10.005 vale 10.00499999..., e o resultado dependeria do erro da multiplicação
+// Em modo cents o valor já é um inteiro de centavos e não muda
+func RoundAmount(amount float64) float64 {
+	if GetAmountMode() == AmountModeCents || math.IsNaN(amount) || math.IsInf(amount, 0) {
+		return amount
+	}
+
+	literal := strconv.FormatFloat(math.Abs(amount), 'f', -1, 64)
+	whole, fraction, _ := strings.Cut(literal, ".")
+	if len(fraction) <= amountDecimals {
+		return amount
+	}
+
+	cents, err := strconv.ParseInt(whole+fraction[:amountDecimals], 10, 64)
+	if err != nil {
+		// Só acontece muito acima do limite do ParseAmount (2^53 centavos) - não há centavo a arredondar
+		return amount
+	}
+
+	// rest e half têm o mesmo tamanho, então a comparação de strings é a comparação numérica
+	rest := fraction[amountDecimals:]
+	half := "5" + strings.Repeat("0", len(rest)-1)
+	if rest > half || (rest == half && cents%2 == 1) {
+		cents++
+	}
+
+	rounded := FromCents(cents)
+	if amount < 0 {
+		return -rounded
+	}
+	return rounded
+}
+
+// FromCents converte centavos inteiros de volta para valor decimal
+func FromCents(cents int64) float64 {
+	return float64(cents) / 100
+}
+
+// IsWholeAmount indica se o valor não possui parte fracionária
+// Em modo cents a API só aceita valores inteiros
+func IsWholeAmount(amount float64) bool {
+	return amount == math.Trunc(amount)
+}
+
+// AmountInCents retorna o valor do lance como inteiro em centavos
+// Em modo cents o Amount já está em centavos; em modo float é convertido
+func (b *Bid) AmountInCents() int64 {
+	if GetAmountMode() == AmountModeCents {
+		return int64(b.Amount)
+	}
+	return ToCents(b.Amount)
+}
+
+// IsHigherThan compara dois lances com CompareAmounts - valores empatados não são "maiores"
+// Compara os valores arredondados: lances gravados antes do RoundAmount (ex: 10.004 e 10.00) empatam
+// e o desempate fica com a ordem de submissão, como nos lances novos
+func (b *Bid) IsHigherThan(other *Bid) bool {
+	if other == nil {
+		return true
+	}
+	return CompareAmounts(RoundAmount(b.Amount), RoundAmount(other.Amount)) > 0
+}
+
+// numberLiteral é a gramática de número do JSON (RFC 8259)
+// Exclui o que o strconv.ParseFloat aceitaria a mais: "NaN", "Inf", hexadecimal e "_"
+var numberLiteral = regexp.MustCompile(`^-?(0|[1-9][0-9]*)(\.[0-9]+)?([eE][+-]?[0-9]+)?$`)
+
+// maxExactInteger é 2^53: acima disso o float64 não representa todos os inteiros
+const maxExactInteger = 1 << 53
+
+// ParseAmount converte o valor textual de um lance (ex: "10.50", "1e3") em float64
+// As regras são checadas no TEXTO, antes da conversão - depois dela a precisão perdida não aparece mais:
+//   - NaN/Infinity e números fora do alcance do float64 são rejeitados
+//   - em modo cents o valor não pode ter casas decimais ("100.0" e "1.5e2" são inteiros)
+//   - valores grandes demais para o float64 guardar exatamente (centavos acima de 2^53) são rejeitados
+func ParseAmount(literal string) (float64, *internal_error.InternalError) {
+	literal = strings.TrimSpace(literal)
+	if !numberLiteral.MatchString(literal) {
+		return 0, internal_error.NewBadRequestError("amount must be a number")
+	}
+
+	amount, err := strconv.ParseFloat(literal, 64)
+	if err != nil || math.IsInf(amount, 0) || math.IsNaN(amount) {
+		return 0, internal_error.NewBadRequestError("amount is out of range")
+	}
+
+	if GetAmountMode() == AmountModeCents && decimalPlaces(literal) > 0 {
+		return 0, internal_error.NewBadRequestError("amount must be an integer number of cents")
+	}
+
+	// Em modo float o limite vale para o valor em centavos - é o que ToCents grava e compara
+	cents := math.Abs(amount)
+	if GetAmountMode() == AmountModeFloat {
+		cents *= 100
+	}
+	if cents > maxExactInteger {
+		return 0, internal_error.NewBadRequestError("amount is too large to be represented exactly")
+	}
+
+	return amount, nil
+}
+
+// decimalPlaces conta as casas decimais significativas de um número já validado pela numberLiteral
+// O expoente desloca a vírgula: "1.25e1" = 12.5 tem 1 casa; zeros à direita não contam ("10.50" tem 1)
+func decimalPlaces(literal string) int {
+	mantissa, exponent := literal, 0
+	if index := strings.IndexAny(literal, "eE"); index >= 0 {
+		mantissa = literal[:index]
+		// Expoente gigante já foi rejeitado pelo ParseFloat (fora do alcance) ou vira 0 (underflow)
+		exponent, _ = strconv.Atoi(literal[index+1:])
+	}
+
+	fraction := ""
+	if index := strings.IndexByte(mantissa, '.'); index >= 0 {
+		fraction = strings.TrimRight(mantissa[index+1:], "0")
+	}
+
+	return max(len(fraction)-exponent, 0)
+}
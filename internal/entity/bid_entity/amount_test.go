@@ -0,0 +1,176 @@
+package bid_entity
+
+import (
+	"testing"
+	"time"
+)
+
+// useAmountMode troca o modo global de valores durante o teste e restaura o padrão no fim
+func useAmountMode(t *testing.T, mode AmountMode) {
+	t.Helper()
+	SetAmountMode(string(mode))
+	t.Cleanup(func() { SetAmountMode(string(AmountModeFloat)) })
+}
+
+func TestRoundAmount(t *testing.T) {
+	useAmountMode(t, AmountModeFloat)
+
+	tests := []struct {
+		amount float64
+		want   float64
+	}{
+		{10.004, 10.00},
+		{10.005, 10.00}, // Meio centavo vai para o par
+		{10.015, 10.02},
+		{10.006, 10.01},
+		{10.5, 10.5},
+		{-10.005, -10.00},
+		{0.125, 0.12},
+		{0.135, 0.14},
+	}
+	for _, tt := range tests {
+		if got := RoundAmount(tt.amount); got != tt.want {
+			t.Errorf("RoundAmount(%v) = %v, want %v", tt.amount, got, tt.want)
+		}
+	}
+}
+
+func TestRoundAmountKeepsCentsModeValues(t *testing.T) {
+	useAmountMode(t, AmountModeCents)
+
+	if got := RoundAmount(1005); got != 1005 {
+		t.Fatalf("RoundAmount(1005) in cents mode = %v, want 1005", got)
+	}
+}
+
+// Valores que arredondam para o mesmo centavo empatam: vence quem foi submetido antes
+func TestEqualRoundedAmountsTieByTimestamp(t *testing.T) {
+	useAmountMode(t, AmountModeFloat)
+	now := time.Date(2024, 1, 2, 15, 0, 0, 0, time.UTC)
+
+	earlier := &Bid{Amount: 10.004, Timestamp: now}
+	later := &Bid{Amount: 10.00, Timestamp: now.Add(time.Second)}
+
+	if earlier.IsHigherThan(later) || later.IsHigherThan(earlier) {
+		t.Fatal("10.004 and 10.00 should tie after rounding")
+	}
+	if !earlier.Outranks(later) {
+		t.Fatal("earlier bid should outrank a later bid with the same rounded amount")
+	}
+	if later.Outranks(earlier) {
+		t.Fatal("later bid should not outrank an earlier bid with the same rounded amount")
+	}
+
+	higher := &Bid{Amount: 10.006, Timestamp: now.Add(2 * time.Second)}
+	if !higher.Outranks(earlier) {
+		t.Fatal("10.006 rounds to 10.01 and should outrank 10.00 regardless of timestamp")
+	}
+}
+
+func TestParseAmount(t *testing.T) {
+	useAmountMode(t, AmountModeFloat)
+
+	tests := []struct {
+		literal string
+		want    float64
+		wantErr bool
+	}{
+		{literal: "10.50", want: 10.5},
+		{literal: " 10.50 ", want: 10.5},
+		{literal: "1e3", want: 1000},
+		{literal: "1.25E1", want: 12.5},
+		{literal: "90071992547409.91", want: 90071992547409.91},
+		{literal: "NaN", wantErr: true},
+		{literal: "Infinity", wantErr: true},
+		{literal: "-Inf", wantErr: true},
+		{literal: "0x10", wantErr: true},
+		{literal: "1_000", wantErr: true},
+		{literal: "01.5", wantErr: true},
+		{literal: "1e400", wantErr: true},
+		{literal: "100000000000000000", wantErr: true}, // Centavos acima de 2^53
+		{literal: "", wantErr: true},
+		{literal: "ten", wantErr: true},
+	}
+	for _, tt := range tests {
+		got, err := ParseAmount(tt.literal)
+		if tt.wantErr {
+			if err == nil {
+				t.Errorf("ParseAmount(%q) = %v, want error", tt.literal, got)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("ParseAmount(%q) returned error %v", tt.literal, err)
+			continue
+		}
+		if got != tt.want {
+			t.Errorf("ParseAmount(%q) = %v, want %v", tt.literal, got, tt.want)
+		}
+	}
+}
+
+func TestParseAmountCentsModeRejectsDecimals(t *testing.T) {
+	useAmountMode(t, AmountModeCents)
+
+	for _, literal := range []string{"100", "100.0", "1.5e2", "1e3"} {
+		if _, err := ParseAmount(literal); err != nil {
+			t.Errorf("ParseAmount(%q) in cents mode returned error %v", literal, err)
+		}
+	}
+	for _, literal := range []string{"10.5", "1.25e1", "1e-1"} {
+		if _, err := ParseAmount(literal); err == nil {
+			t.Errorf("ParseAmount(%q) in cents mode should reject decimals", literal)
+		}
+	}
+}
+
+func TestCompareAmountsWithEpsilon(t *testing.T) {
+	useAmountMode(t, AmountModeFloat)
+	t.Cleanup(func() { SetAmountEpsilon(DefaultAmountEpsilon) })
+
+	tests := []struct {
+		name string
+		a, b float64
+		want int
+	}{
+		{"10.10 beats 10.09", 10.10, 10.09, 1},
+		{"10.09 loses to 10.10", 10.09, 10.10, -1},
+		{"0.1+0.2 ties 0.3", 0.1 + 0.2, 0.3, 0},
+		{"computed dutch price ties typed value", 100 - 3*0.1, 99.7, 0},
+		{"one cent is never a tie", 1000000.01, 1000000.00, 1},
+		{"same value", 42.42, 42.42, 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := CompareAmounts(tt.a, tt.b); got != tt.want {
+				t.Fatalf("CompareAmounts(%v, %v) = %d, want %d", tt.a, tt.b, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSetAmountEpsilonRejectsInvalidValues(t *testing.T) {
+	useAmountMode(t, AmountModeFloat)
+	t.Cleanup(func() { SetAmountEpsilon(DefaultAmountEpsilon) })
+
+	// Meio centavo ou mais faria 10.10 empatar com 10.09 - volta ao padrão
+	for _, epsilon := range []float64{0, -1, maxAmountEpsilon, 0.01} {
+		SetAmountEpsilon(epsilon)
+		if CompareAmounts(10.10, 10.09) != 1 {
+			t.Fatalf("SetAmountEpsilon(%v) made 10.10 tie with 10.09", epsilon)
+		}
+	}
+
+	SetAmountEpsilon(0.001)
+	if CompareAmounts(10.0005, 10.0) != 0 {
+		t.Fatal("values within a configured epsilon of 0.001 should tie")
+	}
+}
+
+func TestCompareAmountsCentsModeIsExact(t *testing.T) {
+	useAmountMode(t, AmountModeCents)
+
+	if CompareAmounts(1010, 1009) != 1 || CompareAmounts(1009, 1009) != 0 || CompareAmounts(1008, 1009) != -1 {
+		t.Fatal("cents mode should compare integer cents exactly")
+	}
+}
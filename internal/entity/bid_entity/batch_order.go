@@ -0,0 +1,37 @@
+package bid_entity
+
+import "sort"
+
+// GroupBatchByAuction separa o batch por leilão, com o lance mais forte (Outranks) primeiro em cada grupo
+// Os grupos seguem a ordem em que cada leilão aparece no batch
+// Processar um grupo em sequência torna o resultado determinístico: entre dois lances do mesmo leilão
+// no mesmo batch, o maior é avaliado primeiro e vira o vencedor; o menor já não supera e é rejeitado
+func GroupBatchByAuction(bids []Bid) [][]Bid {
+	index := make(map[string]int)
+	var groups [][]Bid
+	for _, bid := range bids {
+		i, ok := index[bid.AuctionId]
+		if !ok {
+			i = len(groups)
+			index[bid.AuctionId] = i
+			groups = append(groups, nil)
+		}
+		groups[i] = append(groups[i], bid)
+	}
+
+	for _, group := range groups {
+		sort.SliceStable(group, func(a, b int) bool {
+			return group[a].Outranks(&group[b])
+		})
+	}
+	return groups
+}
+
+// BeatsWinningBid informa se o lance supera o vencedor atual pelo incremento exigido
+// Incremento 0 = basta ser maior; empate nunca supera (o vencedor foi submetido antes)
+func (b *Bid) BeatsWinningBid(winningAmount, increment float64) bool {
+	if CompareAmounts(b.Amount, winningAmount) <= 0 {
+		return false
+	}
+	return increment <= 0 || b.MeetsMinimum(NextMinimumBid(&Bid{Amount: winningAmount}, 0, increment, 0))
+}
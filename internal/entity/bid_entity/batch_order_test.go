@@ -0,0 +1,55 @@
+package bid_entity
+
+import "testing"
+
+func TestGroupBatchByAuction(t *testing.T) {
+	bids := []Bid{
+		{Id: "a1", AuctionId: "a", Amount: 10, Sequence: 1},
+		{Id: "b1", AuctionId: "b", Amount: 5, Sequence: 2},
+		{Id: "a2", AuctionId: "a", Amount: 30, Sequence: 3},
+		{Id: "a3", AuctionId: "a", Amount: 30, Sequence: 4},
+		{Id: "b2", AuctionId: "b", Amount: 7, Sequence: 5},
+	}
+
+	groups := GroupBatchByAuction(bids)
+
+	want := [][]string{{"a2", "a3", "a1"}, {"b2", "b1"}}
+	if len(groups) != len(want) {
+		t.Fatalf("got %d groups, want %d", len(groups), len(want))
+	}
+	for i, group := range groups {
+		if len(group) != len(want[i]) {
+			t.Fatalf("group %d has %d bids, want %d", i, len(group), len(want[i]))
+		}
+		for j, bid := range group {
+			if bid.Id != want[i][j] {
+				t.Errorf("group %d position %d = %s, want %s", i, j, bid.Id, want[i][j])
+			}
+		}
+	}
+}
+
+func TestBeatsWinningBid(t *testing.T) {
+	tests := []struct {
+		name      string
+		amount    float64
+		winning   float64
+		increment float64
+		want      bool
+	}{
+		{"higher without increment", 10.01, 10, 0, true},
+		{"tie never beats", 10, 10, 0, false},
+		{"lower", 9, 10, 0, false},
+		{"exactly winning plus increment", 15, 10, 5, true},
+		{"below winning plus increment", 14.99, 10, 5, false},
+		{"cent rounding of the minimum", 10.3, 10.1, 0.2, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			bid := &Bid{Amount: tt.amount}
+			if got := bid.BeatsWinningBid(tt.winning, tt.increment); got != tt.want {
+				t.Errorf("BeatsWinningBid(%v, %v) with %v = %v, want %v", tt.winning, tt.increment, tt.amount, got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,13 @@
+package bid_entity
+
+// BatchObserver recebe os lances gravados por um CreateBidBatch, todos de uma vez, depois do flush
+// Ponto de extensão para fan-out (notificações de lance superado, webhooks, streaming): o custo do
+// aviso é pago uma vez por batch, fora das goroutines de insert - o caminho quente continua enxuto
+//
+// Entrega "pelo menos uma vez": um lance reenviado após failover que já estava gravado (duplicate key)
+// aparece de novo - o observer deve tolerar ids repetidos
+type BatchObserver interface {
+	// OnBatchAccepted é chamado pela goroutine do batch; bloquear aqui atrasa o próximo flush
+	// O mesmo slice vai para todos os observers - somente leitura
+	OnBatchAccepted(bids []Bid)
+}
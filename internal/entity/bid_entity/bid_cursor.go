@@ -0,0 +1,58 @@
+package bid_entity
+
+import (
+	"encoding/base64"
+	"errors"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// BidCursor é a posição do último lance visto na paginação por cursor (keyset) do histórico
+// A ordem da página é (timestamp, id) crescente; o timestamp tem a precisão gravada no banco (segundos)
+// O id desempata lances do mesmo segundo - sem ele, lances no limite da página se repetiriam ou sumiriam
+type BidCursor struct {
+	Timestamp time.Time
+	Id        string
+}
+
+// NewBidCursor cria o cursor que aponta para depois do lance informado
+func NewBidCursor(bid Bid) BidCursor {
+	return BidCursor{Timestamp: time.Unix(bid.Timestamp.Unix(), 0), Id: bid.Id}
+}
+
+// Encode gera o token opaco devolvido ao cliente (base64 de "<unix>:<id>")
+// O formato não faz parte do contrato - clientes apenas devolvem o token no param "after"
+func (c BidCursor) Encode() string {
+	raw := strconv.FormatInt(c.Timestamp.Unix(), 10) + ":" + c.Id
+	return base64.RawURLEncoding.EncodeToString([]byte(raw))
+}
+
+// Before indica se o lance vem antes (ou é o próprio) cursor na ordem (timestamp, id)
+// Usado pelo repositório em memória; o MongoDB faz a mesma comparação no filtro
+func (c BidCursor) Before(bid Bid) bool {
+	seconds := bid.Timestamp.Unix()
+	if seconds != c.Timestamp.Unix() {
+		return seconds < c.Timestamp.Unix()
+	}
+	return bid.Id <= c.Id
+}
+
+// DecodeBidCursor interpreta o token recebido no param "after"
+func DecodeBidCursor(token string) (*BidCursor, error) {
+	raw, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return nil, errors.New("cursor is not valid base64")
+	}
+
+	seconds, id, found := strings.Cut(string(raw), ":")
+	if !found || id == "" {
+		return nil, errors.New("cursor is malformed")
+	}
+	unix, err := strconv.ParseInt(seconds, 10, 64)
+	if err != nil {
+		return nil, errors.New("cursor is malformed")
+	}
+
+	return &BidCursor{Timestamp: time.Unix(unix, 0), Id: id}, nil
+}
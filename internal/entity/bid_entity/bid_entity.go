@@ -11,24 +11,86 @@ import (
 type Bid struct {
 	Id        string  `json:"id"`
 	UserId    string  `json:"user_id"`
+	Handle    string  `json:"handle,omitempty"` // Apelido de convidado - preenchido só em lances sem user_id
 	AuctionId string  `json:"auction_id"`
 	Amount    float64 `json:"amount"`
 	Timestamp time.Time
+
+	// Sequence é a ordem de submissão, atribuída no enfileiramento (síncrono) - nunca se repete e só cresce
+	// O Timestamp é gravado em segundos e o batch grava os lances em paralelo, então ele não desempata
+	// Lances antigos (antes da sequência existir) têm Sequence 0
+	Sequence int64 `json:"sequence"`
+}
+
+// BidFilter filtra a listagem de lances de um leilão; campos zero não filtram
+// MinAmount segue o AMOUNT_MODE (em modo cents, o valor já está em centavos)
+// After/Limit paginam por cursor: com After os lances vêm ordenados por (timestamp, id) a partir do cursor
+type BidFilter struct {
+	Since     time.Time
+	MinAmount float64
+	After     *BidCursor
+	Limit     int // 0 = sem limite
+}
+
+// Paginated indica se a busca deve ser ordenada pela chave do cursor
+func (f BidFilter) Paginated() bool {
+	return f.After != nil || f.Limit > 0
 }
 
 type BidEntityRepository interface {
 	FindWinningBidByAuctionId(ctx context.Context, auctionId string) (*Bid, *internal_error.InternalError)
-	FindBidByAuctionId(ctx context.Context, auctionId string) ([]Bid, *internal_error.InternalError)
-	CreateBidBatch(ctx context.Context, bidEntities []Bid) *internal_error.InternalError
+	// ComputeWinningBidByAuctionId calcula o vencedor a partir dos lances, ignorando o vencedor gravado
+	// not_found = leilão sem lances
+	ComputeWinningBidByAuctionId(ctx context.Context, auctionId string) (*Bid, *internal_error.InternalError)
+	// FindBidById busca um lance pelo id (not_found se não existir)
+	FindBidById(ctx context.Context, bidId string) (*Bid, *internal_error.InternalError)
+	// FindBidByAuctionId busca os lances do leilão; BidFilter{} não filtra
+	FindBidByAuctionId(ctx context.Context, auctionId string, filter BidFilter) ([]Bid, *internal_error.InternalError)
+	// CreateBidBatch grava o batch; os lances retornados falharam por failover do banco e podem ser reenviados
+	CreateBidBatch(ctx context.Context, bidEntities []Bid) ([]Bid, *internal_error.InternalError)
+	// OnBidRejected registra um listener chamado para cada lance descartado pelo CreateBidBatch
+	// Deve ser chamado na inicialização, antes do primeiro batch
+	OnBidRejected(listener BidRejectedListener)
+	// AddBatchObserver registra um observer avisado uma vez por batch com os lances gravados
+	// Também na inicialização, antes do primeiro batch
+	AddBatchObserver(observer BatchObserver)
+	// CountBidsByAuctionId conta os lances gravados do leilão (usado pelo limite MAX_BIDS_PER_AUCTION)
+	CountBidsByAuctionId(ctx context.Context, auctionId string) (int64, *internal_error.InternalError)
+	// InvalidateAuctionCache descarta status/fim em cache do leilão
+	// Necessário quando o leilão muda fora do fluxo de lances (ex: extensão)
+	InvalidateAuctionCache(auctionId string)
+	// AnonymizeBidsByUserId substitui o autor dos lances pelo DeletedUserId
+	AnonymizeBidsByUserId(ctx context.Context, userId string) *internal_error.InternalError
+	// FindBidPriceSummary calcula maior valor e quantidade de lances sem trazer os lances
+	FindBidPriceSummary(ctx context.Context, auctionId string) (*BidPriceSummary, *internal_error.InternalError)
+	// Ping verifica se o armazenamento está acessível (healthcheck)
+	Ping(ctx context.Context) *internal_error.InternalError
 }
 
-func CreateBid(userId, auctionId string, amount float64) (*Bid, *internal_error.InternalError) {
+// BidPriceSummary resume os lances de um leilão para o GET /auctions/:auctionId/price
+// BidCount 0 = leilão sem lances (HighestAmount fica 0)
+type BidPriceSummary struct {
+	HighestAmount float64 // Mesma unidade do AMOUNT_MODE
+	BidCount      int64
+}
+
+// DeletedUserId é o tombstone gravado em user_id dos lances de usuários removidos
+const DeletedUserId = "deleted"
+
+// CreateBid recebe o timestamp de quem chama (o relógio é injetado no caso de uso)
+// id é opcional: o cliente pode gerar o UUID do lance para tornar o reenvio idempotente
+// Vazio = id gerado pelo servidor
+func CreateBid(id, userId, auctionId string, amount float64, timestamp time.Time) (*Bid, *internal_error.InternalError) {
+	if id == "" {
+		id = uuid.New().String()
+	}
+
 	bid := &Bid{
-		Id:        uuid.New().String(),
+		Id:        id,
 		UserId:    userId,
 		AuctionId: auctionId,
-		Amount:    amount,
-		Timestamp: time.Now(),
+		Amount:    RoundAmount(amount), // Valor na menor unidade da moeda - é o que é gravado e comparado
+		Timestamp: timestamp,
 	}
 	if err := bid.Validate(); err != nil {
 		return nil, err
@@ -37,8 +99,32 @@ func CreateBid(userId, auctionId string, amount float64) (*Bid, *internal_error.
 	return bid, nil
 }
 
+// Outranks é a regra de vencedor: maior valor; empate -> o lance submetido primeiro (menor Sequence)
+// Lances com o mesmo Sequence (dados antigos, Sequence 0) desempatam pelo Timestamp
+func (b *Bid) Outranks(other *Bid) bool {
+	if other == nil {
+		return true
+	}
+	if b.IsHigherThan(other) || other.IsHigherThan(b) {
+		return b.IsHigherThan(other)
+	}
+	if b.Sequence != other.Sequence {
+		return b.Sequence < other.Sequence
+	}
+	return b.Timestamp.Before(other.Timestamp)
+}
+
 func (b *Bid) Validate() *internal_error.InternalError {
-	if err := uuid.Validate(b.UserId); err != nil {
+	if err := ValidateBidId(b.Id); err != nil {
+		return err
+	}
+
+	// Lance de convidado: o apelido substitui o user_id (ver guest_bid.go)
+	if b.IsGuest() {
+		if err := b.validateHandle(); err != nil {
+			return err
+		}
+	} else if err := uuid.Validate(b.UserId); err != nil {
 		return internal_error.NewBadRequestError("user id is not a valid id")
 	}
 
@@ -50,5 +136,9 @@ func (b *Bid) Validate() *internal_error.InternalError {
 		return internal_error.NewBadRequestError("amount must be greater than 0")
 	}
 
+	if GetAmountMode() == AmountModeCents && !IsWholeAmount(b.Amount) {
+		return internal_error.NewBadRequestError("amount must be an integer number of cents")
+	}
+
 	return nil
 }
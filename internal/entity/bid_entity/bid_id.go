@@ -0,0 +1,51 @@
+package bid_entity
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/Guilherme-G-Cadilhe/Go-AuctionHouse_Concurrency-Server-Api/internal/internal_error"
+	"github.com/google/uuid"
+)
+
+// requireUUIDv4 exige que ids de lance gerados pelo cliente sejam UUID v4 (BID_ID_REQUIRE_UUID_V4)
+// O servidor só gera v4 (uuid.New) - outra versão indica um cliente gerando ids de outra forma
+// Definido uma única vez na inicialização, como o AMOUNT_MODE
+var requireUUIDv4 = true
+
+// SetRequireUUIDv4 configura a exigência a partir do BID_ID_REQUIRE_UUID_V4 já carregado pelo config
+// false aceita qualquer versão (ex: integrações que reenviam ids v1 ou v5 de outro sistema)
+func SetRequireUUIDv4(required bool) {
+	requireUUIDv4 = required
+}
+
+// ValidateBidId valida um id de lance vindo do cliente (corpo do POST /bid ou o submissionId do status)
+// Sempre exige um UUID; com BID_ID_REQUIRE_UUID_V4=true exige também a versão 4
+func ValidateBidId(id string) *internal_error.InternalError {
+	if err := uuid.Validate(id); err != nil {
+		return internal_error.NewBadRequestError("bid id is not a valid id")
+	}
+	if !requireUUIDv4 {
+		return nil
+	}
+	if err := validateUUIDv4(id); err != nil {
+		return internal_error.NewBadRequestError(fmt.Sprintf("bid id %s", err))
+	}
+	return nil
+}
+
+// validateUUIDv4 confere versão e variante de um UUID já validado
+// uuid.Validate aceita qualquer versão: "00000000-0000-1000-8000-000000000000" (v1) passaria
+func validateUUIDv4(id string) error {
+	parsed, err := uuid.Parse(id)
+	if err != nil {
+		return err
+	}
+	if parsed.Variant() != uuid.RFC4122 {
+		return errors.New("must be an RFC 4122 UUID")
+	}
+	if parsed.Version() != 4 {
+		return fmt.Errorf("must be a version 4 UUID (got version %d)", parsed.Version())
+	}
+	return nil
+}
@@ -0,0 +1,59 @@
+package bid_entity
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+)
+
+func useRequireUUIDv4(t *testing.T, required bool) {
+	t.Helper()
+	SetRequireUUIDv4(required)
+	t.Cleanup(func() { SetRequireUUIDv4(true) })
+}
+
+func TestValidateBidIdVersions(t *testing.T) {
+	v7, err := uuid.NewV7()
+	if err != nil {
+		t.Fatalf("NewV7: %v", err)
+	}
+
+	tests := []struct {
+		name       string
+		id         string
+		wantStrict bool // aceito com BID_ID_REQUIRE_UUID_V4=true
+		wantLoose  bool // aceito com BID_ID_REQUIRE_UUID_V4=false
+	}{
+		{"v1", "6ba7b810-9dad-11d1-80b4-00c04fd430c8", false, true},
+		{"v3", uuid.NewMD5(uuid.NameSpaceDNS, []byte("auction")).String(), false, true},
+		{"v4", uuid.NewString(), true, true},
+		{"v5", uuid.NewSHA1(uuid.NameSpaceDNS, []byte("auction")).String(), false, true},
+		{"v7", v7.String(), false, true},
+		{"v4 with a non RFC 4122 variant", "5b6f1c3e-8d2a-4f1b-c3d4-0a1b2c3d4e5f", false, true},
+		{"not a uuid", "bid-1", false, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			useRequireUUIDv4(t, true)
+			if err := ValidateBidId(tt.id); (err == nil) != tt.wantStrict {
+				t.Fatalf("strict: ValidateBidId(%q) = %v, want accepted %v", tt.id, err, tt.wantStrict)
+			}
+			SetRequireUUIDv4(false)
+			if err := ValidateBidId(tt.id); (err == nil) != tt.wantLoose {
+				t.Fatalf("loose: ValidateBidId(%q) = %v, want accepted %v", tt.id, err, tt.wantLoose)
+			}
+		})
+	}
+}
+
+func TestValidateBidIdReportsVersion(t *testing.T) {
+	useRequireUUIDv4(t, true)
+
+	err := ValidateBidId("6ba7b810-9dad-11d1-80b4-00c04fd430c8")
+	if err == nil || err.Err != "bad_request" {
+		t.Fatalf("err = %v, want bad_request", err)
+	}
+	if want := "bid id must be a version 4 UUID (got version 1)"; err.Message != want {
+		t.Fatalf("message = %q, want %q", err.Message, want)
+	}
+}
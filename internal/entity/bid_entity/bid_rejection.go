@@ -0,0 +1,20 @@
+package bid_entity
+
+// Motivos de rejeição reportados pelo CreateBidBatch aos listeners de OnBidRejected
+// São os mesmos casos que geram os logs "bid rejected: ..." dos repositórios
+const (
+	RejectAuctionNotFound  = "auction not found"
+	RejectAuctionClosed    = "auction is closed"
+	RejectFirstBidMinimum  = "first bid below FIRST_BID_POLICY minimum"
+	RejectBidCapReached    = "auction reached MAX_BIDS_PER_AUCTION"
+	RejectBelowIncrement   = "bid below the winning bid plus the increment of its tier"
+	RejectBelowWinning     = "bid does not beat the winning bid by MIN_BID_INCREMENT"
+	RejectBelowDutchPrice  = "below dutch auction current price"
+	RejectDutchClaimed     = "dutch auction already claimed"
+	RejectStorageFailure   = "bid could not be stored"
+	RejectFailoverRequeues = "bid dropped after MongoDB failover requeue limit"
+)
+
+// BidRejectedListener recebe cada lance descartado pelo batch e o motivo
+// Chamado pelas goroutines do batch - a implementação precisa ser segura para uso concorrente
+type BidRejectedListener func(bid Bid, reason string)
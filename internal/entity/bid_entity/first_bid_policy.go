@@ -0,0 +1,66 @@
+package bid_entity
+
+import (
+	"math"
+	"strings"
+)
+
+// FirstBidPolicy define o valor mínimo do PRIMEIRO lance de um leilão (FIRST_BID_POLICY)
+//   - any_positive: qualquer valor positivo - comportamento original, padrão
+//   - meet_start: pelo menos o preço inicial (AUCTION_STARTING_PRICE)
+//   - meet_start_plus_increment: preço inicial + AUCTION_MIN_BID_INCREMENT
+//
+// Só o primeiro lance é afetado: depois dele a validação volta a aceitar qualquer valor positivo
+type FirstBidPolicy string
+
+const (
+	FirstBidAnyPositive            FirstBidPolicy = "any_positive"
+	FirstBidMeetStart              FirstBidPolicy = "meet_start"
+	FirstBidMeetStartPlusIncrement FirstBidPolicy = "meet_start_plus_increment"
+)
+
+// ParseFirstBidPolicy converte o valor do config; valores desconhecidos mantêm any_positive
+// Mesmo critério do SetAmountMode: configuração inválida não muda o comportamento padrão
+func ParseFirstBidPolicy(policy string) FirstBidPolicy {
+	switch FirstBidPolicy(strings.ToLower(strings.TrimSpace(policy))) {
+	case FirstBidMeetStart:
+		return FirstBidMeetStart
+	case FirstBidMeetStartPlusIncrement:
+		return FirstBidMeetStartPlusIncrement
+	default:
+		return FirstBidAnyPositive
+	}
+}
+
+// MinimumFirstBid retorna o valor mínimo do primeiro lance (0 = sem mínimo além de ser positivo)
+// startingPrice e increment usam a unidade do AMOUNT_MODE, como o valor dos lances
+func (p FirstBidPolicy) MinimumFirstBid(startingPrice, increment float64) float64 {
+	switch p {
+	case FirstBidMeetStart:
+		return startingPrice
+	case FirstBidMeetStartPlusIncrement:
+		// Arredonda em centavos, como o next_minimum_bid (ex: 10.1 + 0.2 = 10.299999...)
+		return math.Round((startingPrice+increment)*100) / 100
+	default:
+		return 0
+	}
+}
+
+// MeetsMinimum informa se o lance alcança o valor mínimo
+// A comparação é feita em centavos para que 10.3 não fique "abaixo" de 10.299999...
+func (b *Bid) MeetsMinimum(minimum float64) bool {
+	if GetAmountMode() == AmountModeCents {
+		return b.AmountInCents() >= int64(math.Ceil(minimum))
+	}
+	return b.AmountInCents() >= ToCents(minimum)
+}
+
+// NextMinimumBid é o valor sugerido para o próximo lance
+// Sem lances (winning nil): o preço inicial, ou o mínimo do FIRST_BID_POLICY quando for maior
+// Com lances: vencedor + incremento, arredondado em centavos (ex: 10.1 + 0.2 = 10.299999...)
+func NextMinimumBid(winning *Bid, startingPrice, increment, firstBidMinimum float64) float64 {
+	if winning == nil {
+		return max(startingPrice, firstBidMinimum)
+	}
+	return math.Round((winning.Amount+increment)*100) / 100
+}
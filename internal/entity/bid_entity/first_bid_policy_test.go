@@ -0,0 +1,53 @@
+package bid_entity
+
+import "testing"
+
+func TestParseFirstBidPolicy(t *testing.T) {
+	tests := map[string]FirstBidPolicy{
+		"any_positive":               FirstBidAnyPositive,
+		"meet_start":                 FirstBidMeetStart,
+		" MEET_START_PLUS_INCREMENT": FirstBidMeetStartPlusIncrement,
+		"":                           FirstBidAnyPositive,
+		"strict":                     FirstBidAnyPositive,
+	}
+	for input, want := range tests {
+		if got := ParseFirstBidPolicy(input); got != want {
+			t.Errorf("ParseFirstBidPolicy(%q) = %q, want %q", input, got, want)
+		}
+	}
+}
+
+func TestMinimumFirstBid(t *testing.T) {
+	tests := []struct {
+		policy FirstBidPolicy
+		want   float64
+	}{
+		{FirstBidAnyPositive, 0},
+		{FirstBidMeetStart, 10.1},
+		{FirstBidMeetStartPlusIncrement, 10.3}, // 10.1 + 0.2 arredondado em centavos
+	}
+	for _, tt := range tests {
+		if got := tt.policy.MinimumFirstBid(10.1, 0.2); got != tt.want {
+			t.Errorf("%s.MinimumFirstBid(10.1, 0.2) = %v, want %v", tt.policy, got, tt.want)
+		}
+	}
+}
+
+func TestMeetsMinimum(t *testing.T) {
+	useAmountMode(t, AmountModeFloat)
+
+	if !(&Bid{Amount: 10.3}).MeetsMinimum(10.1 + 0.2) {
+		t.Fatal("10.3 should meet a minimum of 10.1+0.2 (10.299999...)")
+	}
+	if (&Bid{Amount: 10.29}).MeetsMinimum(10.3) {
+		t.Fatal("10.29 should not meet a minimum of 10.3")
+	}
+}
+
+func TestMeetsMinimumCentsMode(t *testing.T) {
+	useAmountMode(t, AmountModeCents)
+
+	if !(&Bid{Amount: 1030}).MeetsMinimum(1030) || (&Bid{Amount: 1029}).MeetsMinimum(1029.5) {
+		t.Fatal("cents mode should require the minimum rounded up to whole cents")
+	}
+}
@@ -0,0 +1,67 @@
+package bid_entity
+
+import (
+	"regexp"
+	"time"
+
+	"github.com/Guilherme-G-Cadilhe/Go-AuctionHouse_Concurrency-Server-Api/internal/internal_error"
+	"github.com/google/uuid"
+)
+
+// Limites do apelido exibido nos lances de convidados (sem cadastro)
+const (
+	MinHandleLength = 3
+	MaxHandleLength = 32
+)
+
+// handlePattern aceita letras, dígitos, "_", "-" e "." - nada de espaços ou HTML no apelido
+var handlePattern = regexp.MustCompile(`^[A-Za-z0-9_.-]+$`)
+
+// CreateGuestBid cria um lance de convidado: sem user_id, identificado apenas pelo apelido (handle)
+// Se o leilão aceita convidados é decidido pelo caso de uso - a entidade só valida o formato
+func CreateGuestBid(id, handle, auctionId string, amount float64, timestamp time.Time) (*Bid, *internal_error.InternalError) {
+	if id == "" {
+		id = uuid.New().String()
+	}
+
+	bid := &Bid{
+		Id:        id,
+		Handle:    handle,
+		AuctionId: auctionId,
+		Amount:    RoundAmount(amount), // Valor na menor unidade da moeda - é o que é gravado e comparado
+		Timestamp: timestamp,
+	}
+	if err := bid.Validate(); err != nil {
+		return nil, err
+	}
+
+	return bid, nil
+}
+
+// IsGuest informa se o lance é de um convidado (apelido em vez de usuário cadastrado)
+func (b *Bid) IsGuest() bool {
+	return b.Handle != ""
+}
+
+// BidderKey identifica quem deu o lance: o user_id ou, para convidados, o apelido
+// Usado pelas regras por participante (rate limit, cooldown) - o prefixo evita colisão com um user_id
+func (b *Bid) BidderKey() string {
+	if b.IsGuest() {
+		return "guest:" + b.Handle
+	}
+	return b.UserId
+}
+
+// validateHandle valida o apelido no lugar do user_id
+func (b *Bid) validateHandle() *internal_error.InternalError {
+	if b.UserId != "" {
+		return internal_error.NewBadRequestError("a bid cannot have both user_id and handle")
+	}
+	if len(b.Handle) < MinHandleLength || len(b.Handle) > MaxHandleLength {
+		return internal_error.NewBadRequestError("handle must have between 3 and 32 characters")
+	}
+	if !handlePattern.MatchString(b.Handle) {
+		return internal_error.NewBadRequestError("handle may only contain letters, digits, '_', '-' and '.'")
+	}
+	return nil
+}
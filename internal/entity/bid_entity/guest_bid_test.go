@@ -0,0 +1,67 @@
+package bid_entity
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+func TestCreateGuestBidValidatesHandle(t *testing.T) {
+	now := time.Date(2024, 1, 2, 15, 0, 0, 0, time.UTC)
+	auctionId := uuid.New().String()
+
+	tests := []struct {
+		handle  string
+		wantErr bool
+	}{
+		{"bob", false},
+		{"john.doe_42-x", false},
+		{strings.Repeat("a", MaxHandleLength), false},
+		{"ab", true},
+		{strings.Repeat("a", MaxHandleLength+1), true},
+		{"john doe", true},
+		{"<b>bob</b>", true},
+	}
+	for _, tt := range tests {
+		bid, err := CreateGuestBid("", tt.handle, auctionId, 10, now)
+		if tt.wantErr {
+			if err == nil {
+				t.Errorf("CreateGuestBid(%q) should be rejected", tt.handle)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("CreateGuestBid(%q) returned error %v", tt.handle, err)
+			continue
+		}
+		if !bid.IsGuest() || bid.UserId != "" || bid.BidderKey() != "guest:"+tt.handle {
+			t.Errorf("CreateGuestBid(%q) = %+v, want a guest bid keyed by the handle", tt.handle, bid)
+		}
+	}
+}
+
+func TestBidValidateRejectsHandleWithUserId(t *testing.T) {
+	bid := &Bid{
+		Id:        uuid.New().String(),
+		UserId:    uuid.New().String(),
+		Handle:    "bob",
+		AuctionId: uuid.New().String(),
+		Amount:    10,
+	}
+	if err := bid.Validate(); err == nil {
+		t.Fatal("a bid with both user_id and handle should be rejected")
+	}
+}
+
+// Sem apelido continua valendo a regra original: user_id precisa ser um UUID
+func TestBidValidateRegisteredUserStillRequiresUUID(t *testing.T) {
+	bid := &Bid{Id: uuid.New().String(), UserId: "not-a-uuid", AuctionId: uuid.New().String(), Amount: 10}
+	if err := bid.Validate(); err == nil {
+		t.Fatal("a registered bid with an invalid user_id should be rejected")
+	}
+	if key := (&Bid{UserId: "u1"}).BidderKey(); key != "u1" {
+		t.Fatalf("BidderKey = %q, want the user_id", key)
+	}
+}
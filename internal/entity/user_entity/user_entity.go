@@ -14,8 +14,9 @@ import (
 // Esta struct define APENAS os dados essenciais do usuário
 // Diferente do Node.js/Mongoose onde misturamos dados + métodos, aqui separamos
 type User struct {
-	Id   string // ID único do usuário (sem tags BSON aqui - entidade pura)
-	Name string // Nome do usuário
+	Id    string // ID único do usuário (sem tags BSON aqui - entidade pura)
+	Name  string // Nome do usuário
+	Email string // Opcional - destino das notificações (ex: fechamento de leilão)
 }
 
 // UserRepositoryInterface define o CONTRATO para acesso a dados de usuário
@@ -24,12 +25,19 @@ type User struct {
 type UserRepositoryInterface interface {
 	FindUserById(ctx context.Context, id string) (*User, *internal_error.InternalError)
 	CreateUser(ctx context.Context, user *User) *internal_error.InternalError
+	UpdateUser(ctx context.Context, user *User) *internal_error.InternalError
+	DeleteUser(ctx context.Context, id string) *internal_error.InternalError
+	// FindUsersByIds busca vários usuários de uma vez; ids inexistentes são ignorados
+	FindUsersByIds(ctx context.Context, ids []string) ([]User, *internal_error.InternalError)
+	// Ping verifica se o armazenamento está acessível (healthcheck)
+	Ping(ctx context.Context) *internal_error.InternalError
 }
 
-func CreateUser(name string) *User {
+func CreateUser(name, email string) *User {
 	return &User{
-		Id:   uuid.New().String(), // Gera UUID automaticamente
-		Name: name,
+		Id:    uuid.New().String(), // Gera UUID automaticamente
+		Name:  name,
+		Email: email,
 	}
 }
 
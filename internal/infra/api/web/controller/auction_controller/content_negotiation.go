@@ -0,0 +1,45 @@
+package auction_controller
+
+import (
+	"encoding/json"
+	"encoding/xml"
+
+	"github.com/Guilherme-G-Cadilhe/Go-AuctionHouse_Concurrency-Server-Api/configuration/rest_err"
+	"github.com/gin-gonic/gin"
+)
+
+// respondNegotiated escolhe o formato da resposta pelo header Accept
+// JSON continua sendo o padrão (Accept ausente, */* ou qualquer outro tipo)
+// XML é usado apenas quando o cliente pede application/xml ou text/xml
+// jsonBody e xmlBody são separados porque listas precisam de um elemento raiz no XML
+// O corpo é serializado aqui (e não via c.JSON/c.XML) para calcular o ETag antes de responder
+func respondNegotiated(c *gin.Context, code int, jsonBody, xmlBody any) {
+	var (
+		body        []byte
+		contentType string
+		err         error
+	)
+
+	switch c.NegotiateFormat(gin.MIMEJSON, gin.MIMEXML, gin.MIMEXML2) {
+	case gin.MIMEXML, gin.MIMEXML2:
+		body, err = xml.Marshal(xmlBody)
+		contentType = "application/xml; charset=utf-8"
+	default:
+		body, err = json.Marshal(jsonBody)
+		contentType = "application/json; charset=utf-8"
+	}
+
+	if err != nil {
+		errRest := rest_err.NewInternalServerError("error trying to serialize response")
+		c.JSON(errRest.Code, errRest)
+		return
+	}
+
+	// O mesmo recurso muda de representação conforme o Accept - caches precisam separar por ele
+	c.Header("Vary", "Accept")
+	if respondNotModified(c, body) {
+		return
+	}
+
+	c.Data(code, contentType, body)
+}
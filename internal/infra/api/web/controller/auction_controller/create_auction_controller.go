@@ -1,7 +1,6 @@
 package auction_controller
 
 import (
-	"context"
 	"net/http"
 
 	"github.com/Guilherme-G-Cadilhe/Go-AuctionHouse_Concurrency-Server-Api/configuration/rest_err"
@@ -28,7 +27,7 @@ func (au *AuctionController) CreateAuction(c *gin.Context) {
 		return
 	}
 
-	err := au.auctionUseCase.CreateAuction(context.Background(), auctionInputDTO)
+	err := au.auctionUseCase.CreateAuction(c.Request.Context(), auctionInputDTO)
 	if err != nil {
 		restErr := rest_err.ConvertErrors(err)
 		c.JSON(restErr.Code, restErr)
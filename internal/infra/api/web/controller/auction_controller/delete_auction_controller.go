@@ -0,0 +1,32 @@
+package auction_controller
+
+import (
+	"net/http"
+
+	"github.com/Guilherme-G-Cadilhe/Go-AuctionHouse_Concurrency-Server-Api/configuration/rest_err"
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+// DeleteAuction remove um leilão fechado junto com seus lances (dono ou administradores)
+// DELETE /auctions/:auctionId - retorna 204 (No Content) em caso de sucesso
+func (au *AuctionController) DeleteAuction(c *gin.Context) {
+	auctionId := c.Param("auctionId")
+
+	if err := uuid.Validate(auctionId); err != nil {
+		errRest := rest_err.NewBadRequestError("invalid fields", rest_err.Causes{
+			Field:   "auctionId",
+			Message: "Invalid UUID Value",
+		})
+		c.JSON(errRest.Code, errRest)
+		return
+	}
+
+	if err := au.auctionUseCase.DeleteAuction(c.Request.Context(), auctionId); err != nil {
+		errRest := rest_err.ConvertErrors(err)
+		c.JSON(errRest.Code, errRest)
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
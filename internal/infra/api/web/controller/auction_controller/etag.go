@@ -0,0 +1,49 @@
+package auction_controller
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"net/http"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+)
+
+// computeETag gera um ETag forte a partir do corpo serializado
+// Qualquer mudança no leilão (ex: status após o fechamento automático) muda o corpo e, portanto, o ETag
+func computeETag(body []byte) string {
+	sum := sha256.Sum256(body)
+	// 16 bytes do hash já bastam para distinguir versões e mantêm o header curto
+	return `"` + hex.EncodeToString(sum[:16]) + `"`
+}
+
+// respondNotModified define o header ETag e responde 304 se o cliente já tem esta versão
+// Retorna true quando a resposta foi enviada (o controller não deve escrever o corpo)
+// No Node.js o Express faz isso automaticamente (app.set("etag")); no Gin é explícito
+func respondNotModified(c *gin.Context, body []byte) bool {
+	etag := computeETag(body)
+	c.Header("ETag", etag)
+
+	if !etagMatches(c.GetHeader("If-None-Match"), etag) {
+		return false
+	}
+
+	c.Status(http.StatusNotModified)
+	return true
+}
+
+// etagMatches compara o If-None-Match com o ETag atual
+// Aceita lista separada por vírgula, "*" e ETags fracos (W/"..."), como manda a RFC 9110
+func etagMatches(ifNoneMatch, etag string) bool {
+	if ifNoneMatch == "" {
+		return false
+	}
+
+	for _, candidate := range strings.Split(ifNoneMatch, ",") {
+		candidate = strings.TrimSpace(candidate)
+		if candidate == "*" || strings.TrimPrefix(candidate, "W/") == etag {
+			return true
+		}
+	}
+	return false
+}
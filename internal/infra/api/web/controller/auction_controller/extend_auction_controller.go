@@ -0,0 +1,43 @@
+package auction_controller
+
+import (
+	"net/http"
+
+	"github.com/Guilherme-G-Cadilhe/Go-AuctionHouse_Concurrency-Server-Api/configuration/rest_err"
+	"github.com/Guilherme-G-Cadilhe/Go-AuctionHouse_Concurrency-Server-Api/internal/infra/api/web/validation"
+	"github.com/Guilherme-G-Cadilhe/Go-AuctionHouse_Concurrency-Server-Api/internal/usecase/auction_usecase"
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+// ExtendAuction estende manualmente a duração de um leilão ativo
+// POST /auctions/:auctionId/extend com JSON {"duration": "30m"}
+func (au *AuctionController) ExtendAuction(c *gin.Context) {
+	auctionId := c.Param("auctionId")
+
+	if err := uuid.Validate(auctionId); err != nil {
+		errRest := rest_err.NewBadRequestError("invalid fields", rest_err.Causes{
+			Field:   "auctionId",
+			Message: "Invalid UUID Value",
+		})
+
+		c.JSON(errRest.Code, errRest)
+		return
+	}
+
+	var extendInputDTO auction_usecase.AuctionExtendInputDTO
+	if err := c.ShouldBindJSON(&extendInputDTO); err != nil {
+		restErr := validation.ValidateErr(err)
+		c.JSON(restErr.Code, restErr)
+		return
+	}
+
+	err := au.auctionUseCase.ExtendAuction(c.Request.Context(), auctionId, extendInputDTO)
+	if err != nil {
+		restErr := rest_err.ConvertErrors(err)
+		c.JSON(restErr.Code, restErr)
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
@@ -0,0 +1,43 @@
+package auction_controller
+
+import (
+	"net/http"
+
+	"github.com/Guilherme-G-Cadilhe/Go-AuctionHouse_Concurrency-Server-Api/configuration/rest_err"
+	"github.com/Guilherme-G-Cadilhe/Go-AuctionHouse_Concurrency-Server-Api/internal/infra/api/web/validation"
+	"github.com/Guilherme-G-Cadilhe/Go-AuctionHouse_Concurrency-Server-Api/internal/usecase/auction_usecase"
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+// SetAuctionFeatured marca ou desmarca o destaque de um leilão (somente administradores)
+// PATCH /auctions/:auctionId/featured com JSON {"featured": true}
+func (au *AuctionController) SetAuctionFeatured(c *gin.Context) {
+	auctionId := c.Param("auctionId")
+
+	if err := uuid.Validate(auctionId); err != nil {
+		errRest := rest_err.NewBadRequestError("invalid fields", rest_err.Causes{
+			Field:   "auctionId",
+			Message: "Invalid UUID Value",
+		})
+
+		c.JSON(errRest.Code, errRest)
+		return
+	}
+
+	var featuredInputDTO auction_usecase.AuctionFeaturedInputDTO
+	if err := c.ShouldBindJSON(&featuredInputDTO); err != nil {
+		restErr := validation.ValidateErr(err)
+		c.JSON(restErr.Code, restErr)
+		return
+	}
+
+	err := au.auctionUseCase.SetAuctionFeatured(c.Request.Context(), auctionId, featuredInputDTO)
+	if err != nil {
+		restErr := rest_err.ConvertErrors(err)
+		c.JSON(restErr.Code, restErr)
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
@@ -0,0 +1,34 @@
+package auction_controller
+
+import (
+	"net/http"
+
+	"github.com/Guilherme-G-Cadilhe/Go-AuctionHouse_Concurrency-Server-Api/configuration/rest_err"
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+// FindAuctionActivity retorna o histórico cronológico (lances + mudanças de status) do leilão
+// GET /auctions/:auctionId/activity
+func (au *AuctionController) FindAuctionActivity(c *gin.Context) {
+	auctionId := c.Param("auctionId")
+
+	if err := uuid.Validate(auctionId); err != nil {
+		errRest := rest_err.NewBadRequestError("invalid fields", rest_err.Causes{
+			Field:   "auctionId",
+			Message: "Invalid UUID Value",
+		})
+
+		c.JSON(errRest.Code, errRest)
+		return
+	}
+
+	activity, err := au.auctionUseCase.FindAuctionActivity(c.Request.Context(), auctionId)
+	if err != nil {
+		errRest := rest_err.ConvertErrors(err)
+		c.JSON(errRest.Code, errRest)
+		return
+	}
+
+	c.JSON(http.StatusOK, activity)
+}
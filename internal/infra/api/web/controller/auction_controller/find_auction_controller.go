@@ -1,7 +1,6 @@
 package auction_controller
 
 import (
-	"context"
 	"fmt"
 	"net/http"
 	"strconv"
@@ -25,14 +24,14 @@ func (au *AuctionController) FindAuctionById(c *gin.Context) {
 		return
 	}
 
-	auction, err := au.auctionUseCase.FindAuctionById(context.Background(), auctionId)
+	auction, err := au.auctionUseCase.FindAuctionById(c.Request.Context(), auctionId)
 	if err != nil {
 		errRest := rest_err.ConvertErrors(err)
 		c.JSON(errRest.Code, errRest)
 		return
 	}
 
-	c.JSON(http.StatusOK, auction)
+	respondNegotiated(c, http.StatusOK, auction, auction)
 }
 
 func (au *AuctionController) FindAllAuctions(c *gin.Context) {
@@ -40,27 +39,99 @@ func (au *AuctionController) FindAllAuctions(c *gin.Context) {
 	category := c.Query("category")
 	productName := c.Query("productName")
 
-	statusNumber, errConv := strconv.Atoi(status)
-	if errConv != nil {
-		errRest := rest_err.NewBadRequestError("Erro trying to validate auction status param")
+	input := auction_usecase.AuctionListInputDTO{
+		Category:    category,
+		ProductName: productName,
+	}
+
+	// status é opcional: ausente lista todos; 0 (Active) e 1 (Completed) filtram
+	if status != "" {
+		statusNumber, errConv := strconv.Atoi(status)
+		if errConv != nil || (statusNumber != 0 && statusNumber != 1) {
+			errRest := rest_err.NewBadRequestError("Erro trying to validate auction status param", rest_err.Causes{
+				Field:   "status",
+				Message: "status must be 0 (active) or 1 (completed)",
+			})
+			c.JSON(errRest.Code, errRest)
+			return
+		}
+		auctionStatus := auction_usecase.AuctionStatus(statusNumber)
+		input.Status = &auctionStatus
+	}
+
+	// featured e featured_first são opcionais - ausentes mantêm a listagem original
+	var causes []rest_err.Causes
+	parseBool := func(field string, target *bool) {
+		value := c.Query(field)
+		if value == "" {
+			return
+		}
+		parsed, err := strconv.ParseBool(value)
+		if err != nil {
+			causes = append(causes, rest_err.Causes{Field: field, Message: "must be true or false"})
+			return
+		}
+		*target = parsed
+	}
+
+	parseBool("featured", &input.FeaturedOnly)
+	parseBool("featured_first", &input.FeaturedFirst)
+
+	// view escolhe o formato de cada item - ausente mantém o objeto completo
+	view := c.DefaultQuery("view", auctionViewFull)
+	if view != auctionViewFull && view != auctionViewSummary {
+		causes = append(causes, rest_err.Causes{Field: "view", Message: "view must be full or summary"})
+	}
+
+	if len(causes) > 0 {
+		errRest := rest_err.NewBadRequestError("invalid query params", causes...)
 		c.JSON(errRest.Code, errRest)
 		return
 	}
 
-	auctions, err := au.auctionUseCase.FindAllAuctions(context.Background(), auction_usecase.AuctionStatus(statusNumber), category, productName)
+	if view == auctionViewSummary {
+		au.findAuctionSummaries(c, input)
+		return
+	}
+
+	auctions, err := au.auctionUseCase.FindAllAuctions(c.Request.Context(), input)
 	if err != nil {
 		fmt.Println(err)
 		errRest := rest_err.ConvertErrors(err)
 		c.JSON(errRest.Code, errRest)
 		return
 	}
+	xmlBody := auction_usecase.AuctionListXMLDTO{Auctions: auctions}
 	//return empty array json if not found actions instead of null
 	if len(auctions) == 0 {
-		c.JSON(http.StatusOK, []any{})
+		respondNegotiated(c, http.StatusOK, []any{}, xmlBody)
+		return
+	}
+
+	respondNegotiated(c, http.StatusOK, auctions, xmlBody)
+}
+
+// Visões aceitas no param "view" do GET /auctions
+const (
+	auctionViewFull    = "full"
+	auctionViewSummary = "summary"
+)
+
+// findAuctionSummaries responde a listagem resumida - mesmos filtros, itens só com id, nome, status e preço
+func (au *AuctionController) findAuctionSummaries(c *gin.Context, input auction_usecase.AuctionListInputDTO) {
+	summaries, err := au.auctionUseCase.FindAuctionSummaries(c.Request.Context(), input)
+	if err != nil {
+		errRest := rest_err.ConvertErrors(err)
+		c.JSON(errRest.Code, errRest)
+		return
+	}
+	xmlBody := auction_usecase.AuctionSummaryListXMLDTO{Auctions: summaries}
+	if len(summaries) == 0 {
+		respondNegotiated(c, http.StatusOK, []any{}, xmlBody)
 		return
 	}
 
-	c.JSON(http.StatusOK, auctions)
+	respondNegotiated(c, http.StatusOK, summaries, xmlBody)
 }
 
 func (au *AuctionController) FindWinningBidByAuctionId(c *gin.Context) {
@@ -76,7 +147,7 @@ func (au *AuctionController) FindWinningBidByAuctionId(c *gin.Context) {
 		return
 	}
 
-	auction, err := au.auctionUseCase.FindWinningBidByAuctionId(context.Background(), auctionId)
+	auction, err := au.auctionUseCase.FindWinningBidByAuctionId(c.Request.Context(), auctionId)
 	if err != nil {
 		errRest := rest_err.ConvertErrors(err)
 		c.JSON(errRest.Code, errRest)
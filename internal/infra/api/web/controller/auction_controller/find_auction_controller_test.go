@@ -0,0 +1,94 @@
+package auction_controller
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/Guilherme-G-Cadilhe/Go-AuctionHouse_Concurrency-Server-Api/configuration/config"
+	"github.com/Guilherme-G-Cadilhe/Go-AuctionHouse_Concurrency-Server-Api/internal/clock"
+	"github.com/Guilherme-G-Cadilhe/Go-AuctionHouse_Concurrency-Server-Api/internal/entity/auction_entity"
+	"github.com/Guilherme-G-Cadilhe/Go-AuctionHouse_Concurrency-Server-Api/internal/infra/database/memory"
+	"github.com/Guilherme-G-Cadilhe/Go-AuctionHouse_Concurrency-Server-Api/internal/usecase/auction_usecase"
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+// newTestRouter expõe o GET /auctions sobre os repositórios em memória com um leilão de cada status
+func newTestRouter(t *testing.T) *gin.Engine {
+	t.Helper()
+	cfg := config.AuctionConfig{Interval: 5 * time.Minute, MinBidIncrement: 1, StartingPrice: 1, FirstBidPolicy: "any_positive"}
+	clk := clock.NewFake(time.Date(2024, 1, 2, 15, 0, 0, 0, time.UTC))
+	auctionRepository := memory.NewAuctionRepository(cfg, clk)
+	bidRepository := memory.NewBidRepository(auctionRepository, cfg, clk)
+	auctionRepository.SetWinningBidFinder(bidRepository.FindWinningBidByAuctionId)
+
+	for i := 0; i < 2; i++ {
+		auction := &auction_entity.Auction{
+			Id:          uuid.New().String(),
+			ProductName: "Phone X",
+			Category:    "Electronics",
+			Description: "a nice phone here ok",
+			Status:      auction_entity.Active,
+			Timestamp:   clk.Now(),
+		}
+		if err := auctionRepository.CreateAuction(context.Background(), auction); err != nil {
+			t.Fatalf("CreateAuction: %v", err)
+		}
+		if i == 0 {
+			clk.Advance(cfg.Interval) // O primeiro leilão fecha antes do segundo ser criado
+		}
+	}
+
+	gin.SetMode(gin.TestMode)
+	router := gin.New()
+	controller := NewAuctionController(auction_usecase.NewAuctionUseCase(auctionRepository, bidRepository, cfg, config.BidConfig{}, clk))
+	router.GET("/auctions", controller.FindAllAuctions)
+	return router
+}
+
+func TestFindAllAuctionsStatusParam(t *testing.T) {
+	router := newTestRouter(t)
+
+	tests := []struct {
+		query        string
+		wantCode     int
+		wantStatuses []int
+	}{
+		{"", http.StatusOK, []int{1, 0}},
+		{"?status=0", http.StatusOK, []int{0}},
+		{"?status=1", http.StatusOK, []int{1}},
+		{"?status=2", http.StatusBadRequest, nil},
+		{"?status=active", http.StatusBadRequest, nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.query, func(t *testing.T) {
+			recorder := httptest.NewRecorder()
+			router.ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, "/auctions"+tt.query, nil))
+			if recorder.Code != tt.wantCode {
+				t.Fatalf("code = %d, want %d (body %s)", recorder.Code, tt.wantCode, recorder.Body.String())
+			}
+			if tt.wantStatuses == nil {
+				return
+			}
+
+			var auctions []struct {
+				Status int `json:"status"`
+			}
+			if err := json.Unmarshal(recorder.Body.Bytes(), &auctions); err != nil {
+				t.Fatalf("decode body: %v", err)
+			}
+			if len(auctions) != len(tt.wantStatuses) {
+				t.Fatalf("got %d auctions, want %d", len(auctions), len(tt.wantStatuses))
+			}
+			for i, auction := range auctions {
+				if auction.Status != tt.wantStatuses[i] {
+					t.Fatalf("auction %d status = %d, want %d", i, auction.Status, tt.wantStatuses[i])
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,34 @@
+package auction_controller
+
+import (
+	"net/http"
+
+	"github.com/Guilherme-G-Cadilhe/Go-AuctionHouse_Concurrency-Server-Api/configuration/rest_err"
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+// FindAuctionPrice retorna apenas o preço atual e a quantidade de lances
+// GET /auctions/:auctionId/price
+func (au *AuctionController) FindAuctionPrice(c *gin.Context) {
+	auctionId := c.Param("auctionId")
+
+	if err := uuid.Validate(auctionId); err != nil {
+		errRest := rest_err.NewBadRequestError("invalid fields", rest_err.Causes{
+			Field:   "auctionId",
+			Message: "Invalid UUID Value",
+		})
+
+		c.JSON(errRest.Code, errRest)
+		return
+	}
+
+	price, err := au.auctionUseCase.FindAuctionPrice(c.Request.Context(), auctionId)
+	if err != nil {
+		errRest := rest_err.ConvertErrors(err)
+		c.JSON(errRest.Code, errRest)
+		return
+	}
+
+	c.JSON(http.StatusOK, price)
+}
@@ -0,0 +1,68 @@
+package auction_controller
+
+import (
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/Guilherme-G-Cadilhe/Go-AuctionHouse_Concurrency-Server-Api/configuration/rest_err"
+	"github.com/Guilherme-G-Cadilhe/Go-AuctionHouse_Concurrency-Server-Api/internal/usecase/auction_usecase"
+	"github.com/gin-gonic/gin"
+)
+
+// FindAuctionWinners lista leilões fechados com o lance vencedor, paginado
+// GET /auctions/winners?category=...&from=RFC3339&to=RFC3339&page=1&page_size=20
+func (au *AuctionController) FindAuctionWinners(c *gin.Context) {
+	input := auction_usecase.AuctionWinnersInputDTO{
+		Category: c.Query("category"),
+		Page:     1,
+		PageSize: auction_usecase.DefaultWinnersPageSize,
+	}
+
+	// Erros de conversão são acumulados para responder todos de uma vez
+	var causes []rest_err.Causes
+	parseTime := func(field string, target *time.Time) {
+		value := c.Query(field)
+		if value == "" {
+			return
+		}
+		parsed, err := time.Parse(time.RFC3339, value)
+		if err != nil {
+			causes = append(causes, rest_err.Causes{Field: field, Message: "must be an RFC3339 date (e.g. 2024-01-31T23:59:59Z)"})
+			return
+		}
+		*target = parsed
+	}
+	parseInt := func(field string, target *int) {
+		value := c.Query(field)
+		if value == "" {
+			return
+		}
+		parsed, err := strconv.Atoi(value)
+		if err != nil {
+			causes = append(causes, rest_err.Causes{Field: field, Message: "must be an integer"})
+			return
+		}
+		*target = parsed
+	}
+
+	parseTime("from", &input.From)
+	parseTime("to", &input.To)
+	parseInt("page", &input.Page)
+	parseInt("page_size", &input.PageSize)
+
+	if len(causes) > 0 {
+		errRest := rest_err.NewBadRequestError("invalid query params", causes...)
+		c.JSON(errRest.Code, errRest)
+		return
+	}
+
+	winners, err := au.auctionUseCase.FindAuctionWinners(c.Request.Context(), input)
+	if err != nil {
+		errRest := rest_err.ConvertErrors(err)
+		c.JSON(errRest.Code, errRest)
+		return
+	}
+
+	c.JSON(http.StatusOK, winners)
+}
@@ -0,0 +1,31 @@
+package auction_controller
+
+import (
+	"net/http"
+
+	"github.com/Guilherme-G-Cadilhe/Go-AuctionHouse_Concurrency-Server-Api/configuration/rest_err"
+	"github.com/Guilherme-G-Cadilhe/Go-AuctionHouse_Concurrency-Server-Api/internal/infra/api/web/validation"
+	"github.com/Guilherme-G-Cadilhe/Go-AuctionHouse_Concurrency-Server-Api/internal/usecase/auction_usecase"
+	"github.com/gin-gonic/gin"
+)
+
+// FindAuctionsByIds é o handler HTTP da busca em lote (watchlist)
+// POST /auctions/batch-get com JSON {"ids": ["<uuid>", ...]} - evita um GET /auctions/:auctionId por leilão
+func (au *AuctionController) FindAuctionsByIds(c *gin.Context) {
+	var batchInput auction_usecase.AuctionBatchInputDTO
+
+	// Limites de corpo e de itens (BATCH_MAX_BODY_BYTES / BATCH_MAX_ITEMS) antes de qualquer consulta
+	if errRest := validation.BindBatchJSON(c, &batchInput, "ids", func() int { return len(batchInput.Ids) }); errRest != nil {
+		c.JSON(errRest.Code, errRest)
+		return
+	}
+
+	auctions, err := au.auctionUseCase.FindAuctionsByIds(c.Request.Context(), batchInput)
+	if err != nil {
+		errRest := rest_err.ConvertErrors(err)
+		c.JSON(errRest.Code, errRest)
+		return
+	}
+
+	c.JSON(http.StatusOK, auctions)
+}
@@ -0,0 +1,21 @@
+package auction_controller
+
+import (
+	"net/http"
+
+	"github.com/Guilherme-G-Cadilhe/Go-AuctionHouse_Concurrency-Server-Api/configuration/rest_err"
+	"github.com/gin-gonic/gin"
+)
+
+// FindCategoryCounts retorna cada categoria com o número de leilões ativos
+// GET /auctions/categories/counts
+func (au *AuctionController) FindCategoryCounts(c *gin.Context) {
+	categoryCounts, err := au.auctionUseCase.FindCategoryCounts(c.Request.Context())
+	if err != nil {
+		errRest := rest_err.ConvertErrors(err)
+		c.JSON(errRest.Code, errRest)
+		return
+	}
+
+	c.JSON(http.StatusOK, categoryCounts)
+}
@@ -0,0 +1,13 @@
+package auction_controller
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+// FindConditions retorna as condições de produto aceitas na criação de leilões
+// GET /conditions
+func (au *AuctionController) FindConditions(c *gin.Context) {
+	c.JSON(http.StatusOK, au.auctionUseCase.FindConditions(c.Request.Context()))
+}
@@ -0,0 +1,63 @@
+package auction_controller
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/Guilherme-G-Cadilhe/Go-AuctionHouse_Concurrency-Server-Api/configuration/rest_err"
+	"github.com/Guilherme-G-Cadilhe/Go-AuctionHouse_Concurrency-Server-Api/internal/usecase/auction_usecase"
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+// FindUserLeadingAuctions lista os leilões ativos em que o usuário tem o maior lance, paginado
+// GET /user/:userId/winning?page=1&page_size=20
+func (au *AuctionController) FindUserLeadingAuctions(c *gin.Context) {
+	userId := c.Param("userId")
+
+	if err := uuid.Validate(userId); err != nil {
+		errRest := rest_err.NewBadRequestError("invalid fields", rest_err.Causes{
+			Field:   "userId",
+			Message: "Invalid UUID Value",
+		})
+		c.JSON(errRest.Code, errRest)
+		return
+	}
+
+	input := auction_usecase.UserLeadingInputDTO{
+		Page:     1,
+		PageSize: auction_usecase.DefaultWinnersPageSize,
+	}
+
+	var causes []rest_err.Causes
+	parseInt := func(field string, target *int) {
+		value := c.Query(field)
+		if value == "" {
+			return
+		}
+		parsed, err := strconv.Atoi(value)
+		if err != nil {
+			causes = append(causes, rest_err.Causes{Field: field, Message: "must be an integer"})
+			return
+		}
+		*target = parsed
+	}
+
+	parseInt("page", &input.Page)
+	parseInt("page_size", &input.PageSize)
+
+	if len(causes) > 0 {
+		errRest := rest_err.NewBadRequestError("invalid query params", causes...)
+		c.JSON(errRest.Code, errRest)
+		return
+	}
+
+	leading, err := au.auctionUseCase.FindUserLeadingAuctions(c.Request.Context(), userId, input)
+	if err != nil {
+		errRest := rest_err.ConvertErrors(err)
+		c.JSON(errRest.Code, errRest)
+		return
+	}
+
+	c.JSON(http.StatusOK, leading)
+}
@@ -0,0 +1,24 @@
+package auction_controller
+
+import (
+	"net/http"
+
+	"github.com/Guilherme-G-Cadilhe/Go-AuctionHouse_Concurrency-Server-Api/configuration/rest_err"
+	"github.com/Guilherme-G-Cadilhe/Go-AuctionHouse_Concurrency-Server-Api/internal/usecase/auction_usecase"
+	"github.com/gin-gonic/gin"
+)
+
+// RecomputeWinners recalcula e grava o vencedor dos leilões fechados (somente administradores)
+// POST /internal/auctions/recompute-winners?category=... - responde o resumo das alterações
+func (au *AuctionController) RecomputeWinners(c *gin.Context) {
+	input := auction_usecase.RecomputeWinnersInputDTO{Category: c.Query("category")}
+
+	summary, err := au.auctionUseCase.RecomputeWinners(c.Request.Context(), input)
+	if err != nil {
+		restErr := rest_err.ConvertErrors(err)
+		c.JSON(restErr.Code, restErr)
+		return
+	}
+
+	c.JSON(http.StatusOK, summary)
+}
@@ -0,0 +1,51 @@
+package auction_controller
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/Guilherme-G-Cadilhe/Go-AuctionHouse_Concurrency-Server-Api/configuration/rest_err"
+	"github.com/Guilherme-G-Cadilhe/Go-AuctionHouse_Concurrency-Server-Api/internal/internal_error"
+	"github.com/Guilherme-G-Cadilhe/Go-AuctionHouse_Concurrency-Server-Api/internal/usecase/auction_usecase"
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+// WatchAuction passa a observar o leilão - POST /auctions/:auctionId/watch
+func (au *AuctionController) WatchAuction(c *gin.Context) {
+	au.respondWatchers(c, au.auctionUseCase.WatchAuction)
+}
+
+// UnwatchAuction deixa de observar o leilão - DELETE /auctions/:auctionId/watch
+func (au *AuctionController) UnwatchAuction(c *gin.Context) {
+	au.respondWatchers(c, au.auctionUseCase.UnwatchAuction)
+}
+
+// FindAuctionWatchers retorna quantos usuários observam o leilão - GET /auctions/:auctionId/watch
+func (au *AuctionController) FindAuctionWatchers(c *gin.Context) {
+	au.respondWatchers(c, au.auctionUseCase.FindAuctionWatchers)
+}
+
+// respondWatchers valida o auctionId e responde a contagem - as três rotas só mudam o use case chamado
+func (au *AuctionController) respondWatchers(c *gin.Context, action func(ctx context.Context, auctionId string) (*auction_usecase.AuctionWatchersOutputDTO, *internal_error.InternalError)) {
+	auctionId := c.Param("auctionId")
+
+	if err := uuid.Validate(auctionId); err != nil {
+		errRest := rest_err.NewBadRequestError("invalid fields", rest_err.Causes{
+			Field:   "auctionId",
+			Message: "Invalid UUID Value",
+		})
+
+		c.JSON(errRest.Code, errRest)
+		return
+	}
+
+	watchers, err := action(c.Request.Context(), auctionId)
+	if err != nil {
+		errRest := rest_err.ConvertErrors(err)
+		c.JSON(errRest.Code, errRest)
+		return
+	}
+
+	c.JSON(http.StatusOK, watchers)
+}
@@ -0,0 +1,43 @@
+package auction_controller
+
+import (
+	"net/http"
+
+	"github.com/Guilherme-G-Cadilhe/Go-AuctionHouse_Concurrency-Server-Api/configuration/rest_err"
+	"github.com/Guilherme-G-Cadilhe/Go-AuctionHouse_Concurrency-Server-Api/internal/infra/api/web/validation"
+	"github.com/Guilherme-G-Cadilhe/Go-AuctionHouse_Concurrency-Server-Api/internal/usecase/auction_usecase"
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+// OverrideAuctionWinner define o vencedor de um leilão fechado (somente administradores)
+// POST /auctions/:auctionId/winner com JSON {"bid_id": "<uuid>"} - responde o vencedor atualizado
+func (au *AuctionController) OverrideAuctionWinner(c *gin.Context) {
+	auctionId := c.Param("auctionId")
+
+	if err := uuid.Validate(auctionId); err != nil {
+		errRest := rest_err.NewBadRequestError("invalid fields", rest_err.Causes{
+			Field:   "auctionId",
+			Message: "Invalid UUID Value",
+		})
+
+		c.JSON(errRest.Code, errRest)
+		return
+	}
+
+	var winnerInputDTO auction_usecase.AuctionWinnerInputDTO
+	if err := c.ShouldBindJSON(&winnerInputDTO); err != nil {
+		restErr := validation.ValidateErr(err)
+		c.JSON(restErr.Code, restErr)
+		return
+	}
+
+	winner, err := au.auctionUseCase.OverrideAuctionWinner(c.Request.Context(), auctionId, winnerInputDTO)
+	if err != nil {
+		restErr := rest_err.ConvertErrors(err)
+		c.JSON(restErr.Code, restErr)
+		return
+	}
+
+	c.JSON(http.StatusOK, winner)
+}
@@ -0,0 +1,35 @@
+package bid_controller
+
+import (
+	"net/http"
+
+	"github.com/Guilherme-G-Cadilhe/Go-AuctionHouse_Concurrency-Server-Api/configuration/rest_err"
+	"github.com/Guilherme-G-Cadilhe/Go-AuctionHouse_Concurrency-Server-Api/internal/entity/bid_entity"
+	"github.com/gin-gonic/gin"
+)
+
+// FindBidStatus retorna o destino de um lance já respondido com 201 (pending, accepted ou rejected)
+// GET /bid/status/:submissionId - o submissionId é o submission_id devolvido pelo POST /bid
+func (b *BidController) FindBidStatus(c *gin.Context) {
+	submissionId := c.Param("submissionId")
+
+	// Mesma regra do id no POST /bid - com BID_ID_REQUIRE_UUID_V4 um id v1 nunca foi aceito
+	if err := bid_entity.ValidateBidId(submissionId); err != nil {
+		errRest := rest_err.NewBadRequestError("invalid fields", rest_err.Causes{
+			Field:   "submissionId",
+			Message: err.Message,
+		})
+
+		c.JSON(errRest.Code, errRest)
+		return
+	}
+
+	status, err := b.bidUseCase.FindBidStatus(c.Request.Context(), submissionId)
+	if err != nil {
+		errRest := rest_err.ConvertErrors(err)
+		c.JSON(errRest.Code, errRest)
+		return
+	}
+
+	c.JSON(http.StatusOK, status)
+}
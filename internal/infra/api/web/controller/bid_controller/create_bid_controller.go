@@ -1,9 +1,9 @@
 package bid_controller
 
 import (
-	"context"
 	"fmt"
 	"net/http"
+	"strconv"
 
 	"github.com/Guilherme-G-Cadilhe/Go-AuctionHouse_Concurrency-Server-Api/configuration/rest_err"
 	"github.com/Guilherme-G-Cadilhe/Go-AuctionHouse_Concurrency-Server-Api/internal/infra/api/web/validation"
@@ -31,12 +31,15 @@ func (b *BidController) CreateBid(c *gin.Context) {
 		return
 	}
 
-	err := b.bidUseCase.CreateBid(context.Background(), bidInputDTO)
+	bid, err := b.bidUseCase.CreateBid(c.Request.Context(), bidInputDTO)
 	if err != nil {
 		restErr := rest_err.ConvertErrors(err)
+		if restErr.RetryAfter > 0 {
+			c.Header("Retry-After", strconv.Itoa(restErr.RetryAfter))
+		}
 		c.JSON(restErr.Code, restErr)
 		return
 	}
 
-	c.Status(http.StatusCreated)
+	c.JSON(http.StatusCreated, bid)
 }
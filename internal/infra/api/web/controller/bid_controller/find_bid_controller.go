@@ -1,10 +1,14 @@
 package bid_controller
 
 import (
-	"context"
+	"errors"
+	"math"
 	"net/http"
+	"strconv"
+	"time"
 
 	"github.com/Guilherme-G-Cadilhe/Go-AuctionHouse_Concurrency-Server-Api/configuration/rest_err"
+	"github.com/Guilherme-G-Cadilhe/Go-AuctionHouse_Concurrency-Server-Api/internal/usecase/bid_usecase"
 	"github.com/gin-gonic/gin"
 	"github.com/google/uuid"
 )
@@ -22,12 +26,89 @@ func (b *BidController) FindBidByAuctionId(c *gin.Context) {
 		return
 	}
 
-	bidOutputList, err := b.bidUseCase.FindBidByAuctionId(context.Background(), auctionId)
+	since, errSince := parseSince(c.Query("since"), time.Now())
+	if errSince != nil {
+		errRest := rest_err.NewBadRequestError("invalid fields", rest_err.Causes{
+			Field:   "since",
+			Message: "since must be RFC3339 (ex: 2024-01-02T15:04:05Z) or a relative duration (ex: 1h, 30m)",
+		})
+		c.JSON(errRest.Code, errRest)
+		return
+	}
+
+	// minAmount esconde lances abaixo do valor (mesma unidade do AMOUNT_MODE)
+	var minAmount float64
+	if value := c.Query("minAmount"); value != "" {
+		parsed, errParse := strconv.ParseFloat(value, 64)
+		if errParse != nil || math.IsNaN(parsed) || math.IsInf(parsed, 0) || parsed < 0 {
+			errRest := rest_err.NewBadRequestError("invalid fields", rest_err.Causes{
+				Field:   "minAmount",
+				Message: "minAmount must be a non-negative number",
+			})
+			c.JSON(errRest.Code, errRest)
+			return
+		}
+		minAmount = parsed
+	}
+
+	// Paginação por cursor: "after" é o nextCursor da página anterior; sem "limit" usa o tamanho padrão
+	input := bid_usecase.BidListInputDTO{
+		Since:     since,
+		MinAmount: minAmount,
+		After:     c.Query("after"),
+	}
+	if value := c.Query("limit"); value != "" {
+		parsed, errParse := strconv.Atoi(value)
+		// limit=0 não pode cair na lista sem paginação
+		if errParse != nil || parsed < 1 {
+			errRest := rest_err.NewBadRequestError("invalid fields", rest_err.Causes{
+				Field:   "limit",
+				Message: "limit must be a positive integer",
+			})
+			c.JSON(errRest.Code, errRest)
+			return
+		}
+		input.Limit = parsed
+	} else if input.After != "" {
+		input.Limit = bid_usecase.DefaultBidPageSize
+	}
+
+	// c.Request.Context() carrega o usuário autenticado - o dono do lance vê o próprio user_id
+	page, err := b.bidUseCase.FindBidByAuctionId(c.Request.Context(), auctionId, input)
 	if err != nil {
 		errRest := rest_err.ConvertErrors(err)
 		c.JSON(errRest.Code, errRest)
 		return
 	}
 
-	c.JSON(http.StatusOK, bidOutputList)
+	// Sem paginação a resposta continua sendo o array de lances (clientes existentes não mudam)
+	if !input.Paginated() {
+		c.JSON(http.StatusOK, page.Bids)
+		return
+	}
+	c.JSON(http.StatusOK, page)
+}
+
+// parseSince interpreta o query param "since"
+//   - vazio: sem filtro (time.Time{})
+//   - RFC3339: data absoluta (ex: 2024-01-02T15:04:05Z)
+//   - duração relativa: janela até agora (ex: "1h" = lances da última hora)
+func parseSince(value string, now time.Time) (time.Time, error) {
+	if value == "" {
+		return time.Time{}, nil
+	}
+
+	if since, err := time.Parse(time.RFC3339, value); err == nil {
+		return since, nil
+	}
+
+	duration, err := time.ParseDuration(value)
+	if err != nil {
+		return time.Time{}, err
+	}
+	if duration <= 0 {
+		return time.Time{}, errors.New("since duration must be positive")
+	}
+
+	return now.Add(-duration), nil
 }
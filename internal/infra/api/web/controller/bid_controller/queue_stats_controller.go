@@ -0,0 +1,13 @@
+package bid_controller
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+// QueueStats expõe a profundidade da fila de lances para diagnóstico operacional
+// GET /internal/queue
+func (b *BidController) QueueStats(c *gin.Context) {
+	c.JSON(http.StatusOK, b.bidUseCase.QueueStats())
+}
@@ -0,0 +1,30 @@
+package bid_controller
+
+import (
+	"net/http"
+
+	"github.com/Guilherme-G-Cadilhe/Go-AuctionHouse_Concurrency-Server-Api/configuration/rest_err"
+	"github.com/Guilherme-G-Cadilhe/Go-AuctionHouse_Concurrency-Server-Api/internal/infra/api/web/validation"
+	"github.com/Guilherme-G-Cadilhe/Go-AuctionHouse_Concurrency-Server-Api/internal/usecase/bid_usecase"
+	"github.com/gin-gonic/gin"
+)
+
+// QuoteBid responde se o lance seria aceito agora, sem gravá-lo
+// Mesmo corpo do POST /bid; a resposta é sempre 200 quando a cotação pôde ser feita
+func (b *BidController) QuoteBid(c *gin.Context) {
+	var bidInputDTO bid_usecase.BidInputDTO
+	if err := c.ShouldBindJSON(&bidInputDTO); err != nil {
+		restErr := validation.ValidateErr(err)
+		c.JSON(restErr.Code, restErr)
+		return
+	}
+
+	quote, err := b.bidUseCase.QuoteBid(c.Request.Context(), bidInputDTO)
+	if err != nil {
+		restErr := rest_err.ConvertErrors(err)
+		c.JSON(restErr.Code, restErr)
+		return
+	}
+
+	c.JSON(http.StatusOK, quote)
+}
@@ -0,0 +1,34 @@
+// Package diagnostics_controller expõe informações de suporte sobre o processo em execução
+package diagnostics_controller
+
+import (
+	"net/http"
+
+	"github.com/Guilherme-G-Cadilhe/Go-AuctionHouse_Concurrency-Server-Api/configuration/config"
+	"github.com/Guilherme-G-Cadilhe/Go-AuctionHouse_Concurrency-Server-Api/configuration/logger"
+	"github.com/Guilherme-G-Cadilhe/Go-AuctionHouse_Concurrency-Server-Api/internal/entity/bid_entity"
+	"github.com/gin-gonic/gin"
+)
+
+type ConfigController struct {
+	config *config.Config
+}
+
+func NewConfigController(cfg *config.Config) *ConfigController {
+	return &ConfigController{
+		config: cfg,
+	}
+}
+
+// Config retorna a configuração efetiva do processo, com segredos mascarados
+// Útil para comparar ambientes ("em staging funciona diferente") sem acessar o servidor
+// GET /internal/config (apenas ADMIN_USER_IDS)
+func (cc *ConfigController) Config(c *gin.Context) {
+	diagnostics := cc.config.Diagnostics()
+
+	// Valores já resolvidos pelos pacotes que os aplicam - vazio/inválido no ambiente vira o padrão efetivo
+	diagnostics.LogLevel = logger.LevelName()
+	diagnostics.Bid.AmountMode = string(bid_entity.GetAmountMode())
+
+	c.JSON(http.StatusOK, diagnostics)
+}
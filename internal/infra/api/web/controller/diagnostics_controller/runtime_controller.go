@@ -0,0 +1,24 @@
+package diagnostics_controller
+
+import (
+	"net/http"
+
+	"github.com/Guilherme-G-Cadilhe/Go-AuctionHouse_Concurrency-Server-Api/internal/infra/runtime_monitor"
+	"github.com/gin-gonic/gin"
+)
+
+type RuntimeController struct {
+	monitor *runtime_monitor.Monitor
+}
+
+func NewRuntimeController(monitor *runtime_monitor.Monitor) *RuntimeController {
+	return &RuntimeController{
+		monitor: monitor,
+	}
+}
+
+// Runtime retorna goroutines (atual e pico) e memória do processo
+// GET /internal/runtime
+func (rc *RuntimeController) Runtime(c *gin.Context) {
+	c.JSON(http.StatusOK, rc.monitor.Snapshot())
+}
@@ -0,0 +1,146 @@
+package health_controller
+
+import (
+	"context"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/Guilherme-G-Cadilhe/Go-AuctionHouse_Concurrency-Server-Api/internal/internal_error"
+	"github.com/gin-gonic/gin"
+)
+
+// DegradedChecker é implementado por componentes que podem sinalizar degradação
+// Ex: BidUseCase após falhas consecutivas no batch de lances
+type DegradedChecker interface {
+	IsDegraded() bool
+}
+
+// StallChecker é implementado por rotinas de fundo que precisam continuar rodando para o serviço atender
+// Ex: BidUseCase quando a goroutine do batch para de girar o select
+type StallChecker interface {
+	IsStalled() bool
+}
+
+// Pinger é implementado pelos repositórios (MongoDB ou memória)
+// O handler não conhece o client do banco - só pergunta a cada repositório se ele responde
+type Pinger interface {
+	Ping(ctx context.Context) *internal_error.InternalError
+}
+
+// Status de cada componente no corpo do /health
+const (
+	componentUp       = "UP"
+	componentDown     = "DOWN"
+	componentDegraded = "DEGRADED"
+)
+
+type ComponentStatus struct {
+	Status string `json:"status"`
+	Error  string `json:"error,omitempty"` // Motivo da falha do ping (ex: database timeout)
+}
+
+type HealthController struct {
+	timeout       time.Duration
+	pingers       map[string]Pinger
+	checkers      map[string]DegradedChecker
+	stallCheckers map[string]StallChecker // Rotinas de fundo verificadas pelo /readyz
+}
+
+// NewHealthController recebe os componentes pelo nome exibido no breakdown da resposta
+// timeout limita cada rodada de pings - o probe do orquestrador não pode ficar pendurado num banco lento
+func NewHealthController(timeout time.Duration, pingers map[string]Pinger, checkers map[string]DegradedChecker, stallCheckers map[string]StallChecker) *HealthController {
+	return &HealthController{
+		timeout:       timeout,
+		pingers:       pingers,
+		checkers:      checkers,
+		stallCheckers: stallCheckers,
+	}
+}
+
+// Liveness responde se o processo está de pé - sempre 200, sem tocar no banco
+// Um banco fora do ar não deve fazer o Kubernetes reiniciar o pod: isso é papel da readiness
+// GET /healthz
+func (h *HealthController) Liveness(c *gin.Context) {
+	c.JSON(http.StatusOK, gin.H{"status": componentUp})
+}
+
+// Readiness responde se o pod pode receber tráfego: bancos respondendo e rotinas de fundo girando
+// 503 tira o pod do balanceamento (sem reiniciá-lo) até os componentes voltarem
+// GET /readyz
+func (h *HealthController) Readiness(c *gin.Context) {
+	ctx, cancel := context.WithTimeout(c.Request.Context(), h.timeout)
+	defer cancel()
+
+	components := h.pingAll(ctx)
+	for name, checker := range h.stallCheckers {
+		status := ComponentStatus{Status: componentUp}
+		if checker.IsStalled() {
+			status = ComponentStatus{Status: componentDown, Error: "background routine stalled"}
+		}
+		components[name] = status
+	}
+
+	h.respond(c, components)
+}
+
+// Health retorna 200 quando tudo está saudável e 503 quando algum componente falha ou está degradado
+// O corpo traz o status de cada componente: {"status": "DEGRADED", "components": {"bids_db": {"status": "DOWN", ...}}}
+// GET /health
+func (h *HealthController) Health(c *gin.Context) {
+	ctx, cancel := context.WithTimeout(c.Request.Context(), h.timeout)
+	defer cancel()
+
+	components := h.pingAll(ctx)
+	for name, checker := range h.checkers {
+		status := ComponentStatus{Status: componentUp}
+		if checker.IsDegraded() {
+			status.Status = componentDegraded
+		}
+		components[name] = status
+	}
+
+	h.respond(c, components)
+}
+
+// pingAll pinga os repositórios e devolve o status de cada um
+func (h *HealthController) pingAll(ctx context.Context) map[string]ComponentStatus {
+	components := make(map[string]ComponentStatus, len(h.pingers)+len(h.checkers)+len(h.stallCheckers))
+
+	// Pings em paralelo: o pior caso do probe é um timeout, não a soma deles
+	var mutex sync.Mutex
+	var wg sync.WaitGroup
+	for name, pinger := range h.pingers {
+		wg.Add(1)
+		go func(name string, pinger Pinger) {
+			defer wg.Done()
+			status := ComponentStatus{Status: componentUp}
+			if err := pinger.Ping(ctx); err != nil {
+				status = ComponentStatus{Status: componentDown, Error: err.Error()}
+			}
+			mutex.Lock()
+			components[name] = status
+			mutex.Unlock()
+		}(name, pinger)
+	}
+	wg.Wait()
+	return components
+}
+
+// respond responde 200 com todos os componentes UP, ou 503 com o breakdown
+func (h *HealthController) respond(c *gin.Context, components map[string]ComponentStatus) {
+	for _, component := range components {
+		if component.Status != componentUp {
+			c.JSON(http.StatusServiceUnavailable, gin.H{
+				"status":     "DEGRADED",
+				"components": components,
+			})
+			return
+		}
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"status":     "OK",
+		"components": components,
+	})
+}
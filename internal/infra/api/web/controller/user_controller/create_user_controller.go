@@ -2,7 +2,6 @@
 package user_controller
 
 import (
-	"context"
 	"net/http"
 
 	"github.com/Guilherme-G-Cadilhe/Go-AuctionHouse_Concurrency-Server-Api/configuration/rest_err"
@@ -24,7 +23,7 @@ func (u *UserController) CreateUser(c *gin.Context) {
 	}
 
 	// Chama UseCase para criar usuário
-	user, err := u.userUseCase.CreateUser(context.Background(), userInput)
+	user, err := u.userUseCase.CreateUser(c.Request.Context(), userInput)
 	if err != nil {
 		errRest := rest_err.ConvertErrors(err)
 		c.JSON(errRest.Code, errRest)
@@ -0,0 +1,32 @@
+package user_controller
+
+import (
+	"net/http"
+
+	"github.com/Guilherme-G-Cadilhe/Go-AuctionHouse_Concurrency-Server-Api/configuration/rest_err"
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+// DeleteUser é o handler HTTP para remover um usuário
+// DELETE /user/:userId - retorna 204 (No Content) em caso de sucesso
+func (u *UserController) DeleteUser(c *gin.Context) {
+	userId := c.Param("userId")
+
+	if err := uuid.Validate(userId); err != nil {
+		errRest := rest_err.NewBadRequestError("invalid fields", rest_err.Causes{
+			Field:   "userId",
+			Message: "Invalid UUID Value",
+		})
+		c.JSON(errRest.Code, errRest)
+		return
+	}
+
+	if err := u.userUseCase.DeleteUser(c.Request.Context(), userId); err != nil {
+		errRest := rest_err.ConvertErrors(err)
+		c.JSON(errRest.Code, errRest)
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
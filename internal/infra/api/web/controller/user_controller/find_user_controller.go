@@ -3,7 +3,6 @@
 package user_controller
 
 import (
-	"context"
 	"net/http"
 
 	"github.com/Guilherme-G-Cadilhe/Go-AuctionHouse_Concurrency-Server-Api/configuration/rest_err"
@@ -56,9 +55,8 @@ func (u *UserController) FindUserById(c *gin.Context) {
 	}
 
 	// CHAMA O USE CASE para executar a lógica de negócio
-	// context.Background() cria um contexto vazio (sem timeout/cancelamento)
-	// Em produção, melhor usar contexto com timeout: c.Request.Context()
-	user, err := u.userUseCase.FindUserById(context.Background(), userId)
+	// c.Request.Context() é cancelado se o cliente desconectar e carrega o span do tracing
+	user, err := u.userUseCase.FindUserById(c.Request.Context(), userId)
 	if err != nil {
 		// ConvertErrors() converte erro interno para erro HTTP
 		// Abstrai detalhes internos e expõe apenas o necessário para o cliente
@@ -0,0 +1,31 @@
+package user_controller
+
+import (
+	"net/http"
+
+	"github.com/Guilherme-G-Cadilhe/Go-AuctionHouse_Concurrency-Server-Api/configuration/rest_err"
+	"github.com/Guilherme-G-Cadilhe/Go-AuctionHouse_Concurrency-Server-Api/internal/infra/api/web/validation"
+	"github.com/Guilherme-G-Cadilhe/Go-AuctionHouse_Concurrency-Server-Api/internal/usecase/user_usecase"
+	"github.com/gin-gonic/gin"
+)
+
+// FindUsersByIds é o handler HTTP da busca em lote
+// POST /users/batch com JSON {"ids": ["<uuid>", ...]} - evita um GET /user/:userId por participante
+func (u *UserController) FindUsersByIds(c *gin.Context) {
+	var batchInput user_usecase.UserBatchInputDTO
+
+	// Limites de corpo e de itens (BATCH_MAX_BODY_BYTES / BATCH_MAX_ITEMS) antes de qualquer consulta
+	if errRest := validation.BindBatchJSON(c, &batchInput, "ids", func() int { return len(batchInput.Ids) }); errRest != nil {
+		c.JSON(errRest.Code, errRest)
+		return
+	}
+
+	users, err := u.userUseCase.FindUsersByIds(c.Request.Context(), batchInput)
+	if err != nil {
+		errRest := rest_err.ConvertErrors(err)
+		c.JSON(errRest.Code, errRest)
+		return
+	}
+
+	c.JSON(http.StatusOK, users)
+}
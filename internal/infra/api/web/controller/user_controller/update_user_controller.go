@@ -0,0 +1,42 @@
+package user_controller
+
+import (
+	"net/http"
+
+	"github.com/Guilherme-G-Cadilhe/Go-AuctionHouse_Concurrency-Server-Api/configuration/rest_err"
+	"github.com/Guilherme-G-Cadilhe/Go-AuctionHouse_Concurrency-Server-Api/internal/infra/api/web/validation"
+	"github.com/Guilherme-G-Cadilhe/Go-AuctionHouse_Concurrency-Server-Api/internal/usecase/user_usecase"
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+// UpdateUser é o handler HTTP para atualizar o nome do usuário
+// PATCH /user/:userId com JSON {"name": "Maria"}
+func (u *UserController) UpdateUser(c *gin.Context) {
+	userId := c.Param("userId")
+
+	if err := uuid.Validate(userId); err != nil {
+		errRest := rest_err.NewBadRequestError("invalid fields", rest_err.Causes{
+			Field:   "userId",
+			Message: "Invalid UUID Value",
+		})
+		c.JSON(errRest.Code, errRest)
+		return
+	}
+
+	var userInput user_usecase.UserUpdateInputDTO
+	if err := c.ShouldBindJSON(&userInput); err != nil {
+		errRest := validation.ValidateErr(err)
+		c.JSON(errRest.Code, errRest)
+		return
+	}
+
+	user, err := u.userUseCase.UpdateUser(c.Request.Context(), userId, userInput)
+	if err != nil {
+		errRest := rest_err.ConvertErrors(err)
+		c.JSON(errRest.Code, errRest)
+		return
+	}
+
+	c.JSON(http.StatusOK, user)
+}
@@ -0,0 +1,47 @@
+package middleware
+
+import (
+	"time"
+
+	"github.com/Guilherme-G-Cadilhe/Go-AuctionHouse_Concurrency-Server-Api/configuration/logger"
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+// RequestIDHeader identifica a request no access log e na resposta
+// Se o cliente (ou um proxy) já enviar o header, o mesmo id é reaproveitado
+const RequestIDHeader = "X-Request-Id"
+
+// AccessLog grava uma linha JSON por request (substitui o logger em texto do gin.Default())
+// Deve ser o primeiro middleware: mede a latência total e o tamanho final enviado (já comprimido pelo Gzip)
+// No Express.js seria o equivalente ao morgan com formato JSON
+func AccessLog() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		start := time.Now()
+
+		requestId := c.GetHeader(RequestIDHeader)
+		if requestId == "" {
+			requestId = uuid.New().String()
+		}
+		c.Header(RequestIDHeader, requestId)
+
+		// Guarda o writer original - o Gzip troca c.Writer para os handlers seguintes
+		writer := c.Writer
+		c.Next()
+
+		// Size() é -1 quando nada foi escrito (ex: 304 sem corpo)
+		responseSize := max(writer.Size(), 0)
+
+		logger.Access("http request",
+			zap.String("method", c.Request.Method),
+			zap.String("path", c.Request.URL.Path),
+			zap.String("route", c.FullPath()),
+			zap.Int("status", writer.Status()),
+			// Milissegundos explícitos - zap.Duration sem EncodeDuration sairia em nanossegundos
+			zap.Float64("latency_ms", float64(time.Since(start).Microseconds())/1000),
+			zap.String("client_ip", c.ClientIP()),
+			zap.String("request_id", requestId),
+			zap.Int("response_size", responseSize))
+	}
+}
@@ -0,0 +1,36 @@
+// Package middleware contém os middlewares HTTP registrados no router do Gin
+// Middlewares executam antes dos handlers (similar ao app.use() do Express.js)
+package middleware
+
+import (
+	"github.com/Guilherme-G-Cadilhe/Go-AuctionHouse_Concurrency-Server-Api/configuration/rest_err"
+	"github.com/Guilherme-G-Cadilhe/Go-AuctionHouse_Concurrency-Server-Api/internal/auth_context"
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+// UserIDHeader é o header de onde o id do usuário autenticado é extraído
+const UserIDHeader = "X-User-Id"
+
+// AuthUser extrai o usuário autenticado do header e o grava no context da request
+// Requests sem o header seguem sem usuário - cabe ao use case exigir autenticação
+func AuthUser() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		userId := c.GetHeader(UserIDHeader)
+		if userId == "" {
+			c.Next()
+			return
+		}
+
+		if err := uuid.Validate(userId); err != nil {
+			errRest := rest_err.NewUnauthorizedError("invalid authenticated user id")
+			c.AbortWithStatusJSON(errRest.Code, errRest)
+			return
+		}
+
+		// Request.WithContext cria uma cópia da request com o novo context
+		// c.Request.Context() nos controllers passa a carregar o usuário
+		c.Request = c.Request.WithContext(auth_context.WithUserID(c.Request.Context(), userId))
+		c.Next()
+	}
+}
@@ -0,0 +1,30 @@
+package middleware
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/Guilherme-G-Cadilhe/Go-AuctionHouse_Concurrency-Server-Api/configuration/config"
+	"github.com/Guilherme-G-Cadilhe/Go-AuctionHouse_Concurrency-Server-Api/configuration/rest_err"
+	"github.com/Guilherme-G-Cadilhe/Go-AuctionHouse_Concurrency-Server-Api/internal/infra/api/web/validation"
+	"github.com/gin-gonic/gin"
+)
+
+// BatchLimit aplica os limites das rotas em lote antes do handler
+// - Corpo: Content-Length acima de BATCH_MAX_BODY_BYTES é recusado na hora (413); sem Content-Length
+// (chunked) o http.MaxBytesReader corta a leitura no limite e o validation.BindBatchJSON responde 413
+// - Itens: BATCH_MAX_ITEMS fica no context para o validation.BindBatchJSON checar após o bind
+// Registrado por rota, como o RequireJSON: router.POST("/users/batch", middleware.BatchLimit(cfg.HTTP), handler)
+func BatchLimit(cfg config.HTTPConfig) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		if c.Request.ContentLength > cfg.BatchMaxBodyBytes {
+			errRest := rest_err.NewPayloadTooLargeError(fmt.Sprintf("request body exceeds the limit of %d bytes", cfg.BatchMaxBodyBytes))
+			c.AbortWithStatusJSON(errRest.Code, errRest)
+			return
+		}
+
+		c.Request.Body = http.MaxBytesReader(c.Writer, c.Request.Body, cfg.BatchMaxBodyBytes)
+		validation.SetBatchMaxItems(c, cfg.BatchMaxItems)
+		c.Next()
+	}
+}
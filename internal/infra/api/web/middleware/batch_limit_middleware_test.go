@@ -0,0 +1,100 @@
+package middleware
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/Guilherme-G-Cadilhe/Go-AuctionHouse_Concurrency-Server-Api/configuration/config"
+	"github.com/Guilherme-G-Cadilhe/Go-AuctionHouse_Concurrency-Server-Api/internal/infra/api/web/validation"
+	"github.com/gin-gonic/gin"
+)
+
+// newBatchRouter registra uma rota em lote como as reais: BatchLimit na rota e BindBatchJSON no handler
+func newBatchRouter(cfg config.HTTPConfig) *gin.Engine {
+	gin.SetMode(gin.TestMode)
+	router := gin.New()
+	router.POST("/batch", BatchLimit(cfg), func(c *gin.Context) {
+		var input struct {
+			Ids []string `json:"ids"`
+		}
+		if errRest := validation.BindBatchJSON(c, &input, "ids", func() int { return len(input.Ids) }); errRest != nil {
+			c.JSON(errRest.Code, errRest)
+			return
+		}
+		c.Status(http.StatusOK)
+	})
+	return router
+}
+
+// idsBody monta {"ids":["1","2",...]} com n itens
+func idsBody(n int) string {
+	ids := make([]string, n)
+	for i := range ids {
+		ids[i] = fmt.Sprintf("%q", fmt.Sprint(i))
+	}
+	return `{"ids":[` + strings.Join(ids, ",") + `]}`
+}
+
+func TestBatchLimitItemsBoundary(t *testing.T) {
+	router := newBatchRouter(config.HTTPConfig{BatchMaxItems: 3, BatchMaxBodyBytes: 1 << 20})
+
+	tests := []struct {
+		items    int
+		wantCode int
+	}{
+		{3, http.StatusOK},
+		{4, http.StatusBadRequest},
+	}
+	for _, tt := range tests {
+		recorder := httptest.NewRecorder()
+		router.ServeHTTP(recorder, httptest.NewRequest(http.MethodPost, "/batch", strings.NewReader(idsBody(tt.items))))
+		if recorder.Code != tt.wantCode {
+			t.Fatalf("%d items: code %d, want %d (body %s)", tt.items, recorder.Code, tt.wantCode, recorder.Body.String())
+		}
+		if tt.wantCode == http.StatusBadRequest && !strings.Contains(recorder.Body.String(), "limit of 3 items") {
+			t.Fatalf("400 body should carry the limit: %s", recorder.Body.String())
+		}
+	}
+}
+
+func TestBatchLimitBodyBoundary(t *testing.T) {
+	body := idsBody(2)
+	limit := int64(len(body))
+	router := newBatchRouter(config.HTTPConfig{BatchMaxItems: 100, BatchMaxBodyBytes: limit})
+
+	tests := []struct {
+		name     string
+		body     string
+		chunked  bool
+		wantCode int
+	}{
+		{"exactly the limit", body, false, http.StatusOK},
+		{"one byte over", body + " ", false, http.StatusRequestEntityTooLarge},
+		{"chunked over the limit", idsBody(10), true, http.StatusRequestEntityTooLarge},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var reader io.Reader = strings.NewReader(tt.body)
+			if tt.chunked {
+				reader = io.MultiReader(reader) // Esconde o tamanho: sem Content-Length
+			}
+			request := httptest.NewRequest(http.MethodPost, "/batch", reader)
+			if tt.chunked {
+				request.ContentLength = -1
+			}
+
+			recorder := httptest.NewRecorder()
+			router.ServeHTTP(recorder, request)
+			if recorder.Code != tt.wantCode {
+				t.Fatalf("code %d, want %d (body %s)", recorder.Code, tt.wantCode, recorder.Body.String())
+			}
+			if tt.wantCode == http.StatusRequestEntityTooLarge && !strings.Contains(recorder.Body.String(), fmt.Sprintf("limit of %d bytes", limit)) {
+				t.Fatalf("413 body should carry the limit: %s", recorder.Body.String())
+			}
+		})
+	}
+}
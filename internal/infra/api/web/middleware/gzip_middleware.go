@@ -0,0 +1,132 @@
+package middleware
+
+import (
+	"bytes"
+	"compress/gzip"
+	"strings"
+
+	"github.com/Guilherme-G-Cadilhe/Go-AuctionHouse_Concurrency-Server-Api/configuration/config"
+	"github.com/gin-gonic/gin"
+)
+
+// Gzip comprime as respostas quando o cliente envia "Accept-Encoding: gzip"
+// Respostas menores que GzipMinSize (bytes) seguem sem compressão - o overhead não compensa
+// Respostas de streaming (SSE) nunca são comprimidas para não atrasar os eventos
+func Gzip(cfg config.HTTPConfig) gin.HandlerFunc {
+	minSize := cfg.GzipMinSize
+
+	return func(c *gin.Context) {
+		if !shouldCompress(c) {
+			c.Next()
+			return
+		}
+
+		writer := &gzipResponseWriter{
+			ResponseWriter: c.Writer,
+			minSize:        minSize,
+		}
+		// Substitui o writer do Gin - os handlers escrevem sem saber da compressão
+		c.Writer = writer
+		defer writer.finish()
+
+		c.Next()
+	}
+}
+
+func shouldCompress(c *gin.Context) bool {
+	if !strings.Contains(c.GetHeader("Accept-Encoding"), "gzip") {
+		return false
+	}
+	if strings.Contains(c.GetHeader("Accept"), "text/event-stream") {
+		return false
+	}
+	if strings.EqualFold(c.GetHeader("Connection"), "upgrade") {
+		return false
+	}
+	return true
+}
+
+// gzipResponseWriter acumula o corpo em buffer até atingir o tamanho mínimo
+// Só então decide pela compressão - os headers ainda não foram enviados ao cliente
+type gzipResponseWriter struct {
+	gin.ResponseWriter
+	minSize     int
+	buffer      bytes.Buffer
+	gzipWriter  *gzip.Writer
+	passthrough bool // true = resposta segue sem compressão (ex: streaming)
+}
+
+func (w *gzipResponseWriter) Write(data []byte) (int, error) {
+	if w.passthrough {
+		return w.ResponseWriter.Write(data)
+	}
+	if w.gzipWriter != nil {
+		return w.gzipWriter.Write(data)
+	}
+
+	// SSE identificado pelo Content-Type da resposta
+	if strings.HasPrefix(w.Header().Get("Content-Type"), "text/event-stream") {
+		w.startPassthrough()
+		return w.ResponseWriter.Write(data)
+	}
+
+	w.buffer.Write(data)
+	if w.buffer.Len() >= w.minSize {
+		if err := w.startGzip(); err != nil {
+			return 0, err
+		}
+	}
+	return len(data), nil
+}
+
+func (w *gzipResponseWriter) WriteString(s string) (int, error) {
+	return w.Write([]byte(s))
+}
+
+// Flush é usado por respostas de streaming
+// Sem compressão iniciada, a resposta passa a seguir sem compressão
+func (w *gzipResponseWriter) Flush() {
+	if w.gzipWriter != nil {
+		w.gzipWriter.Flush()
+	} else {
+		w.startPassthrough()
+	}
+	w.ResponseWriter.Flush()
+}
+
+func (w *gzipResponseWriter) startGzip() error {
+	header := w.Header()
+	header.Set("Content-Encoding", "gzip")
+	header.Add("Vary", "Accept-Encoding")
+	// O tamanho original não vale para o corpo comprimido
+	header.Del("Content-Length")
+	// Um ETag forte identifica bytes exatos - o corpo comprimido passa a ter um ETag fraco
+	if etag := header.Get("ETag"); strings.HasPrefix(etag, `"`) {
+		header.Set("ETag", "W/"+etag)
+	}
+
+	w.gzipWriter = gzip.NewWriter(w.ResponseWriter)
+	_, err := w.gzipWriter.Write(w.buffer.Bytes())
+	w.buffer.Reset()
+	return err
+}
+
+func (w *gzipResponseWriter) startPassthrough() {
+	w.passthrough = true
+	if w.buffer.Len() > 0 {
+		w.ResponseWriter.Write(w.buffer.Bytes())
+		w.buffer.Reset()
+	}
+}
+
+// finish é chamado após o handler: fecha o gzip ou envia o corpo pequeno sem compressão
+func (w *gzipResponseWriter) finish() {
+	if w.gzipWriter != nil {
+		w.gzipWriter.Close()
+		return
+	}
+	if w.buffer.Len() > 0 {
+		w.ResponseWriter.Write(w.buffer.Bytes())
+		w.buffer.Reset()
+	}
+}
@@ -0,0 +1,78 @@
+package middleware
+
+import (
+	"crypto/subtle"
+	"net/netip"
+	"strings"
+
+	"github.com/Guilherme-G-Cadilhe/Go-AuctionHouse_Concurrency-Server-Api/configuration/config"
+	"github.com/Guilherme-G-Cadilhe/Go-AuctionHouse_Concurrency-Server-Api/configuration/logger"
+	"github.com/Guilherme-G-Cadilhe/Go-AuctionHouse_Concurrency-Server-Api/configuration/rest_err"
+	"github.com/gin-gonic/gin"
+	"go.uber.org/zap"
+)
+
+// InternalTokenHeader é o header com o token compartilhado das rotas /internal/*
+const InternalTokenHeader = "X-Internal-Token"
+
+// InternalAccess restringe o grupo /internal/* à rede interna ou a quem conhece o token
+// Passa quem enviar INTERNAL_API_TOKEN no header OU conectar de um IP de INTERNAL_ALLOWED_IPS; os demais recebem 403
+// Registrado apenas no grupo: router.Group("/internal", middleware.InternalAccess(cfg.HTTP))
+// Sem token e sem allowlist (padrão) nada é validado
+func InternalAccess(cfg config.HTTPConfig) gin.HandlerFunc {
+	allowedPrefixes := parseAllowedIPs(cfg.InternalAllowedIPs)
+	if cfg.InternalToken == "" && len(allowedPrefixes) == 0 {
+		return func(c *gin.Context) { c.Next() }
+	}
+
+	return func(c *gin.Context) {
+		if cfg.InternalToken != "" {
+			// ConstantTimeCompare: o tempo de resposta não revela quantos caracteres do token estão certos
+			token := c.GetHeader(InternalTokenHeader)
+			if subtle.ConstantTimeCompare([]byte(token), []byte(cfg.InternalToken)) == 1 {
+				c.Next()
+				return
+			}
+		}
+
+		// RemoteIP é o IP da conexão - ClientIP confiaria no X-Forwarded-For enviado pelo próprio cliente
+		if remoteIP, err := netip.ParseAddr(c.RemoteIP()); err == nil {
+			remoteIP = remoteIP.Unmap() // "::ffff:10.0.0.1" (IPv4 em socket IPv6) vira 10.0.0.1
+			for _, prefix := range allowedPrefixes {
+				if prefix.Contains(remoteIP) {
+					c.Next()
+					return
+				}
+			}
+		}
+
+		errRest := rest_err.NewForbiddenError("internal endpoints are restricted")
+		c.AbortWithStatusJSON(errRest.Code, errRest)
+	}
+}
+
+// parseAllowedIPs aceita IPs ("10.0.0.5") e faixas CIDR ("10.0.0.0/8")
+// Entradas inválidas são logadas e ignoradas - não abrem nem derrubam a aplicação
+func parseAllowedIPs(values []string) []netip.Prefix {
+	var prefixes []netip.Prefix
+	for _, value := range values {
+		if strings.Contains(value, "/") {
+			prefix, err := netip.ParsePrefix(value)
+			if err != nil {
+				logger.Warn("ignoring invalid INTERNAL_ALLOWED_IPS entry", zap.String("value", value))
+				continue
+			}
+			prefixes = append(prefixes, prefix.Masked())
+			continue
+		}
+
+		addr, err := netip.ParseAddr(value)
+		if err != nil {
+			logger.Warn("ignoring invalid INTERNAL_ALLOWED_IPS entry", zap.String("value", value))
+			continue
+		}
+		addr = addr.Unmap()
+		prefixes = append(prefixes, netip.PrefixFrom(addr, addr.BitLen()))
+	}
+	return prefixes
+}
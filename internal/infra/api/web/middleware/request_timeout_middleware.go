@@ -0,0 +1,28 @@
+package middleware
+
+import (
+	"context"
+
+	"github.com/Guilherme-G-Cadilhe/Go-AuctionHouse_Concurrency-Server-Api/configuration/config"
+	"github.com/gin-gonic/gin"
+)
+
+// RequestTimeout dá um prazo (REQUEST_TIMEOUT) ao context de cada request
+// Os controllers repassam c.Request.Context() até o MongoDB: ao vencer o prazo o driver aborta a
+// operação (inclusive a leitura do cursor) e o ClassifyMongoError a transforma em 504
+// Cliente que desconecta cancela o mesmo context antes do prazo (499)
+// Com REQUEST_TIMEOUT=0 vale só o cancelamento pela desconexão, sem prazo
+func RequestTimeout(cfg config.HTTPConfig) gin.HandlerFunc {
+	if cfg.RequestTimeout <= 0 {
+		return func(c *gin.Context) { c.Next() }
+	}
+
+	return func(c *gin.Context) {
+		ctx, cancel := context.WithTimeout(c.Request.Context(), cfg.RequestTimeout)
+		// cancel libera o timer do context assim que o handler termina
+		defer cancel()
+
+		c.Request = c.Request.WithContext(ctx)
+		c.Next()
+	}
+}
@@ -0,0 +1,73 @@
+package middleware
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/Guilherme-G-Cadilhe/Go-AuctionHouse_Concurrency-Server-Api/configuration/config"
+	"github.com/Guilherme-G-Cadilhe/Go-AuctionHouse_Concurrency-Server-Api/configuration/database/mongodb"
+	"github.com/Guilherme-G-Cadilhe/Go-AuctionHouse_Concurrency-Server-Api/configuration/rest_err"
+	"github.com/gin-gonic/gin"
+)
+
+// newSlowRouter simula uma consulta travada: o handler só responde quando o context da request termina,
+// com o erro classificado como no repositório MongoDB
+func newSlowRouter(cfg config.HTTPConfig) *gin.Engine {
+	gin.SetMode(gin.TestMode)
+	router := gin.New()
+	router.GET("/slow", RequestTimeout(cfg), func(c *gin.Context) {
+		select {
+		case <-c.Request.Context().Done():
+			errRest := rest_err.ConvertErrors(mongodb.ClassifyMongoError(c.Request.Context().Err(), "", "error trying to find auctions"))
+			c.JSON(errRest.Code, errRest)
+		case <-time.After(time.Second):
+			c.Status(http.StatusOK)
+		}
+	})
+	return router
+}
+
+func TestRequestTimeoutReturnsGatewayTimeout(t *testing.T) {
+	router := newSlowRouter(config.HTTPConfig{RequestTimeout: 10 * time.Millisecond})
+
+	recorder := httptest.NewRecorder()
+	router.ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, "/slow", nil))
+	if recorder.Code != http.StatusGatewayTimeout {
+		t.Fatalf("code %d, want 504 (body %s)", recorder.Code, recorder.Body.String())
+	}
+}
+
+// Cliente que desconecta antes do prazo: o mesmo context é cancelado e a resposta vira 499
+func TestRequestTimeoutClientCanceled(t *testing.T) {
+	router := newSlowRouter(config.HTTPConfig{RequestTimeout: time.Minute})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	recorder := httptest.NewRecorder()
+	router.ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, "/slow", nil).WithContext(ctx))
+	if recorder.Code != 499 {
+		t.Fatalf("code %d, want 499 (body %s)", recorder.Code, recorder.Body.String())
+	}
+}
+
+// REQUEST_TIMEOUT=0 não impõe prazo ao context
+func TestRequestTimeoutDisabled(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	router := gin.New()
+	router.GET("/deadline", RequestTimeout(config.HTTPConfig{}), func(c *gin.Context) {
+		if _, ok := c.Request.Context().Deadline(); ok {
+			c.Status(http.StatusInternalServerError)
+			return
+		}
+		c.Status(http.StatusOK)
+	})
+
+	recorder := httptest.NewRecorder()
+	router.ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, "/deadline", nil))
+	if recorder.Code != http.StatusOK {
+		t.Fatalf("request context got a deadline with REQUEST_TIMEOUT=0")
+	}
+}
@@ -0,0 +1,34 @@
+package middleware
+
+import (
+	"github.com/Guilherme-G-Cadilhe/Go-AuctionHouse_Concurrency-Server-Api/configuration/rest_err"
+	"github.com/Guilherme-G-Cadilhe/Go-AuctionHouse_Concurrency-Server-Api/internal/auth_context"
+	"github.com/gin-gonic/gin"
+)
+
+// RequireAdmin libera a rota apenas para os usuários de ADMIN_USER_IDS
+// Depende do AuthUser já ter gravado o usuário no context
+// Sem usuário: 401; usuário que não é admin: 403. Lista vazia bloqueia todos
+func RequireAdmin(adminUserIds []string) gin.HandlerFunc {
+	admins := make(map[string]struct{}, len(adminUserIds))
+	for _, userId := range adminUserIds {
+		admins[userId] = struct{}{}
+	}
+
+	return func(c *gin.Context) {
+		userId, ok := auth_context.UserIDFromContext(c.Request.Context())
+		if !ok {
+			errRest := rest_err.NewUnauthorizedError("authenticated user is required for this operation")
+			c.AbortWithStatusJSON(errRest.Code, errRest)
+			return
+		}
+
+		if _, isAdmin := admins[userId]; !isAdmin {
+			errRest := rest_err.NewForbiddenError("admin access is required for this operation")
+			c.AbortWithStatusJSON(errRest.Code, errRest)
+			return
+		}
+
+		c.Next()
+	}
+}
@@ -0,0 +1,30 @@
+package middleware
+
+import (
+	"mime"
+
+	"github.com/Guilherme-G-Cadilhe/Go-AuctionHouse_Concurrency-Server-Api/configuration/config"
+	"github.com/Guilherme-G-Cadilhe/Go-AuctionHouse_Concurrency-Server-Api/configuration/rest_err"
+	"github.com/gin-gonic/gin"
+)
+
+// RequireJSON rejeita com 415 requests cujo Content-Type não é application/json
+// Sem ele, form data ou um corpo sem Content-Type chegam ao ShouldBindJSON e viram um 400 genérico
+// Registrado por rota, como o StrictQuery: router.POST("/bid", middleware.RequireJSON(cfg.HTTP), handler)
+// Com REQUIRE_JSON_BODY=false (padrão) nada é validado
+func RequireJSON(cfg config.HTTPConfig) gin.HandlerFunc {
+	if !cfg.RequireJSONBody {
+		return func(c *gin.Context) { c.Next() }
+	}
+
+	return func(c *gin.Context) {
+		// ParseMediaType aceita parâmetros: "application/json; charset=utf-8"
+		mediaType, _, err := mime.ParseMediaType(c.GetHeader("Content-Type"))
+		if err != nil || mediaType != "application/json" {
+			errRest := rest_err.NewUnsupportedMediaTypeError("Content-Type must be application/json")
+			c.AbortWithStatusJSON(errRest.Code, errRest)
+			return
+		}
+		c.Next()
+	}
+}
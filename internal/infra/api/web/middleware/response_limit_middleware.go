@@ -0,0 +1,71 @@
+package middleware
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/Guilherme-G-Cadilhe/Go-AuctionHouse_Concurrency-Server-Api/configuration/config"
+	"github.com/Guilherme-G-Cadilhe/Go-AuctionHouse_Concurrency-Server-Api/configuration/rest_err"
+	"github.com/gin-gonic/gin"
+)
+
+// MaxResponseSize limita o tamanho da resposta serializada das listagens (LIST_MAX_RESPONSE_BYTES)
+// O corpo é medido depois de codificado (JSON ou XML, antes do gzip) - é o tamanho real, não uma estimativa
+// Acima do limite a listagem vira 400 com a dica de como reduzi-la; o resultado nunca é truncado em silêncio
+// Registrado por rota, como o BatchLimit: router.GET("/auctions", middleware.MaxResponseSize(cfg.HTTP, hint), handler)
+func MaxResponseSize(cfg config.HTTPConfig, hint string) gin.HandlerFunc {
+	limit := cfg.ListMaxResponseBytes
+
+	return func(c *gin.Context) {
+		if limit <= 0 {
+			c.Next()
+			return
+		}
+
+		writer := &limitedResponseWriter{ResponseWriter: c.Writer, limit: limit}
+		// Os handlers escrevem no buffer; nada chega ao cliente até a resposta ser medida
+		c.Writer = writer
+		c.Next()
+		c.Writer = writer.ResponseWriter
+
+		if writer.exceeded {
+			// Headers da listagem (Content-Type XML, ETag) não valem para o corpo de erro
+			header := c.Writer.Header()
+			header.Del("Content-Type")
+			header.Del("ETag")
+
+			errRest := rest_err.NewBadRequestError(
+				fmt.Sprintf("response exceeds the limit of %d bytes, %s", limit, hint))
+			c.JSON(errRest.Code, errRest)
+			return
+		}
+		if writer.buffer.Len() > 0 {
+			c.Writer.Write(writer.buffer.Bytes())
+		}
+	}
+}
+
+// limitedResponseWriter acumula o corpo até o limite
+// Passou do limite: o buffer é descartado - o corpo não será enviado de qualquer forma
+type limitedResponseWriter struct {
+	gin.ResponseWriter
+	limit    int64
+	buffer   bytes.Buffer
+	exceeded bool
+}
+
+func (w *limitedResponseWriter) Write(data []byte) (int, error) {
+	if w.exceeded {
+		return len(data), nil
+	}
+	if int64(w.buffer.Len()+len(data)) > w.limit {
+		w.exceeded = true
+		w.buffer.Reset()
+		return len(data), nil
+	}
+	return w.buffer.Write(data)
+}
+
+func (w *limitedResponseWriter) WriteString(s string) (int, error) {
+	return w.Write([]byte(s))
+}
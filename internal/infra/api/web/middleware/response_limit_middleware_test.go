@@ -0,0 +1,80 @@
+package middleware
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/Guilherme-G-Cadilhe/Go-AuctionHouse_Concurrency-Server-Api/configuration/config"
+	"github.com/gin-gonic/gin"
+)
+
+const listHint = "request a smaller page with limit"
+
+// newListRouter registra uma listagem como as reais: ?n= define quantos itens o handler devolve
+func newListRouter(cfg config.HTTPConfig) *gin.Engine {
+	gin.SetMode(gin.TestMode)
+	router := gin.New()
+	router.GET("/list", MaxResponseSize(cfg, listHint), func(c *gin.Context) {
+		n, _ := strconv.Atoi(c.Query("n"))
+		c.JSON(http.StatusOK, strings.Repeat("x", n))
+	})
+	return router
+}
+
+// listBody é o corpo que o handler de teste escreve para n itens: uma string JSON com n "x"
+func listBody(n int) string {
+	return `"` + strings.Repeat("x", n) + `"`
+}
+
+func TestMaxResponseSizeBoundary(t *testing.T) {
+	limit := int64(len(listBody(100)))
+	router := newListRouter(config.HTTPConfig{ListMaxResponseBytes: limit})
+
+	tests := []struct {
+		name     string
+		items    int
+		wantCode int
+	}{
+		{"small page", 10, http.StatusOK},
+		{"exactly the limit", 100, http.StatusOK},
+		{"one byte over", 101, http.StatusBadRequest},
+		{"oversized result set", 10000, http.StatusBadRequest},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			recorder := httptest.NewRecorder()
+			router.ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, "/list?n="+strconv.Itoa(tt.items), nil))
+			if recorder.Code != tt.wantCode {
+				t.Fatalf("code %d, want %d", recorder.Code, tt.wantCode)
+			}
+
+			body := recorder.Body.String()
+			if tt.wantCode == http.StatusOK {
+				if body != listBody(tt.items) {
+					t.Fatalf("body of %d bytes changed by the middleware", len(body))
+				}
+				return
+			}
+			// O 400 substitui a listagem inteira, com o limite e a dica - nada do corpo original vaza
+			if strings.Contains(body, "xxx") {
+				t.Fatalf("400 body leaked the listing: %.80s", body)
+			}
+			if !strings.Contains(body, "limit of "+strconv.FormatInt(limit, 10)+" bytes") || !strings.Contains(body, listHint) {
+				t.Fatalf("400 body should carry the limit and the hint: %s", body)
+			}
+		})
+	}
+}
+
+func TestMaxResponseSizeDisabled(t *testing.T) {
+	router := newListRouter(config.HTTPConfig{})
+
+	recorder := httptest.NewRecorder()
+	router.ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, "/list?n=10000", nil))
+	if recorder.Code != http.StatusOK || recorder.Body.Len() != len(listBody(10000)) {
+		t.Fatalf("code %d with %d bytes, want the full listing", recorder.Code, recorder.Body.Len())
+	}
+}
@@ -0,0 +1,24 @@
+package middleware
+
+import (
+	"github.com/Guilherme-G-Cadilhe/Go-AuctionHouse_Concurrency-Server-Api/configuration/config"
+	"github.com/Guilherme-G-Cadilhe/Go-AuctionHouse_Concurrency-Server-Api/internal/infra/api/web/validation"
+	"github.com/gin-gonic/gin"
+)
+
+// StrictQuery valida os query params da rota contra a lista de params conhecidos
+// Registrado por rota: router.GET("/auctions", middleware.StrictQuery(cfg.HTTP, "status"), handler)
+// Com STRICT_QUERY_PARAMS=false (padrão) nada é validado - params desconhecidos continuam ignorados
+func StrictQuery(cfg config.HTTPConfig, allowed ...string) gin.HandlerFunc {
+	if !cfg.StrictQueryParams {
+		return func(c *gin.Context) { c.Next() }
+	}
+
+	return func(c *gin.Context) {
+		if errRest := validation.ValidateQueryParams(c.Request.URL.Query(), allowed...); errRest != nil {
+			c.AbortWithStatusJSON(errRest.Code, errRest)
+			return
+		}
+		c.Next()
+	}
+}
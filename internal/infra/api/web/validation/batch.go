@@ -0,0 +1,40 @@
+package validation
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/Guilherme-G-Cadilhe/Go-AuctionHouse_Concurrency-Server-Api/configuration/rest_err"
+	"github.com/gin-gonic/gin"
+)
+
+// batchMaxItemsKey é a chave do context do Gin preenchida pelo middleware.BatchLimit
+const batchMaxItemsKey = "batch_max_items"
+
+// SetBatchMaxItems guarda o limite de itens da rota em lote para o BindBatchJSON
+func SetBatchMaxItems(c *gin.Context, maxItems int) {
+	c.Set(batchMaxItemsKey, maxItems)
+}
+
+// BindBatchJSON é o bind único dos handlers em lote: decodifica o corpo e checa os limites antes de processar
+// field é o campo com a lista e count retorna quantos itens o input decodificado tem (ex: len(input.Ids))
+// Corpo acima do limite = 413; mais itens que o limite = 400 - os dois com o limite na mensagem
+// Sem o middleware.BatchLimit na rota, só o bind é feito
+func BindBatchJSON(c *gin.Context, input any, field string, count func() int) *rest_err.RestErr {
+	if err := c.ShouldBindJSON(input); err != nil {
+		var maxBytesErr *http.MaxBytesError
+		if errors.As(err, &maxBytesErr) {
+			return rest_err.NewPayloadTooLargeError(fmt.Sprintf("request body exceeds the limit of %d bytes", maxBytesErr.Limit))
+		}
+		return rest_err.NewBadRequestError("Invalid JSON body")
+	}
+
+	if maxItems := c.GetInt(batchMaxItemsKey); maxItems > 0 && count() > maxItems {
+		return rest_err.NewBadRequestError(fmt.Sprintf("batch exceeds the limit of %d items", maxItems), rest_err.Causes{
+			Field:   field,
+			Message: fmt.Sprintf("must contain at most %d items", maxItems),
+		})
+	}
+	return nil
+}
@@ -0,0 +1,40 @@
+package validation
+
+import (
+	"net/url"
+	"sort"
+	"strings"
+
+	"github.com/Guilherme-G-Cadilhe/Go-AuctionHouse_Concurrency-Server-Api/configuration/rest_err"
+)
+
+// ValidateQueryParams rejeita query params fora do conjunto permitido do endpoint
+// Evita resultados silenciosamente errados por erro de digitação (ex: "productname" em vez de "productName")
+// A comparação é case-sensitive, igual ao c.Query() do Gin
+func ValidateQueryParams(query url.Values, allowed ...string) *rest_err.RestErr {
+	allowedSet := make(map[string]struct{}, len(allowed))
+	for _, key := range allowed {
+		allowedSet[key] = struct{}{}
+	}
+
+	var unknown []string
+	for key := range query {
+		if _, ok := allowedSet[key]; !ok {
+			unknown = append(unknown, key)
+		}
+	}
+	if len(unknown) == 0 {
+		return nil
+	}
+
+	// Iteração de map não tem ordem - ordena para a resposta ser determinística
+	sort.Strings(unknown)
+	causes := make([]rest_err.Causes, len(unknown))
+	for i, key := range unknown {
+		causes[i] = rest_err.Causes{
+			Field:   key,
+			Message: "unknown query parameter",
+		}
+	}
+	return rest_err.NewBadRequestError("unknown query parameters: "+strings.Join(unknown, ", "), causes...)
+}
@@ -8,6 +8,7 @@ import (
 	"errors"
 
 	"github.com/Guilherme-G-Cadilhe/Go-AuctionHouse_Concurrency-Server-Api/configuration/rest_err"
+	"github.com/Guilherme-G-Cadilhe/Go-AuctionHouse_Concurrency-Server-Api/internal/internal_error"
 	"github.com/gin-gonic/gin/binding"
 	"github.com/go-playground/locales/en"
 	ut "github.com/go-playground/universal-translator"
@@ -50,6 +51,7 @@ func ValidateErr(validation_err error) *rest_err.RestErr {
 	// Variáveis para diferentes tipos de erro
 	var jsonErr *json.UnmarshalTypeError          // Erro de tipo de JSON (string onde esperava int)
 	var jsonValidation validator.ValidationErrors // Erros de validação de regras
+	var domainErr *internal_error.InternalError   // Erro devolvido por um UnmarshalJSON customizado (ex: BidAmount)
 
 	// errors.As() verifica se o erro é de um tipo específico e faz casting
 	// É mais seguro que type assertion direta
@@ -83,7 +85,11 @@ func ValidateErr(validation_err error) *rest_err.RestErr {
 		// O "..." expande o slice como argumentos variádicos
 		return rest_err.NewBadRequestError("Validation error", errorCauses...)
 
-		// CASO 3: Qualquer outro tipo de erro
+		// CASO 3: Regra de domínio aplicada durante a decodificação - mantém a mensagem específica
+	} else if errors.As(validation_err, &domainErr) {
+		return rest_err.ConvertErrors(domainErr)
+
+		// CASO 4: Qualquer outro tipo de erro
 	} else {
 		return rest_err.NewBadRequestError("error trying to convert fields")
 	}
@@ -0,0 +1,53 @@
+package auction
+
+import (
+	"context"
+
+	"github.com/Guilherme-G-Cadilhe/Go-AuctionHouse_Concurrency-Server-Api/configuration/database/mongodb"
+	"github.com/Guilherme-G-Cadilhe/Go-AuctionHouse_Concurrency-Server-Api/configuration/logger"
+	"github.com/Guilherme-G-Cadilhe/Go-AuctionHouse_Concurrency-Server-Api/internal/entity/auction_entity"
+	"github.com/Guilherme-G-Cadilhe/Go-AuctionHouse_Concurrency-Server-Api/internal/internal_error"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+// categoryCountMongo recebe o resultado do $group (o _id do grupo é a categoria)
+type categoryCountMongo struct {
+	Category string `bson:"_id"`
+	Count    int64  `bson:"count"`
+}
+
+// AggregateCategoryCounts conta os leilões por categoria com o status informado
+// A agregação roda no MongoDB - evita trazer todos os leilões para contar em memória
+func (ar *AuctionRepository) AggregateCategoryCounts(ctx context.Context, status auction_entity.AuctionStatus) ([]auction_entity.CategoryCount, *internal_error.InternalError) {
+	// PIPELINE de agregação: filtra -> agrupa -> ordena
+	// Equivale a: SELECT category, COUNT(*) FROM auctions WHERE status = ? GROUP BY category ORDER BY count DESC
+	pipeline := mongo.Pipeline{
+		{{Key: "$match", Value: bson.M{"status": status}}},
+		{{Key: "$group", Value: bson.M{"_id": "$category", "count": bson.M{"$sum": 1}}}},
+		{{Key: "$sort", Value: bson.D{{Key: "count", Value: -1}, {Key: "_id", Value: 1}}}},
+	}
+
+	cursor, err := ar.Collection.Aggregate(ctx, pipeline)
+	if err != nil {
+		logger.Error("error trying to aggregate auction categories", err)
+		return nil, mongodb.ClassifyMongoError(err, "", "error trying to aggregate auction categories")
+	}
+	defer cursor.Close(ctx)
+
+	var counts []categoryCountMongo
+	if err := cursor.All(ctx, &counts); err != nil {
+		logger.Error("error trying to decode auction category counts", err)
+		return nil, mongodb.ClassifyMongoError(err, "", "error trying to aggregate auction categories")
+	}
+
+	categoryCounts := make([]auction_entity.CategoryCount, len(counts))
+	for i, count := range counts {
+		categoryCounts[i] = auction_entity.CategoryCount{
+			Category: count.Category,
+			Count:    count.Count,
+		}
+	}
+
+	return categoryCounts, nil
+}
@@ -0,0 +1,81 @@
+package auction
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/Guilherme-G-Cadilhe/Go-AuctionHouse_Concurrency-Server-Api/configuration/database/mongodb"
+	"github.com/Guilherme-G-Cadilhe/Go-AuctionHouse_Concurrency-Server-Api/configuration/logger"
+	"github.com/Guilherme-G-Cadilhe/Go-AuctionHouse_Concurrency-Server-Api/internal/entity/auction_entity"
+	"github.com/Guilherme-G-Cadilhe/Go-AuctionHouse_Concurrency-Server-Api/internal/internal_error"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// AuctionEventEntityMongo representa uma transição de status na coleção "auction_events"
+type AuctionEventEntityMongo struct {
+	Id        string                          `bson:"_id"`
+	AuctionId string                          `bson:"auction_id"`
+	Type      auction_entity.AuctionEventType `bson:"type"`
+	Timestamp int64                           `bson:"timestamp"`
+	EndTime   int64                           `bson:"end_time,omitempty"` // Só em eventos "extended"
+}
+
+// CreateAuctionEvent grava uma transição de status do leilão
+func (ar *AuctionRepository) CreateAuctionEvent(ctx context.Context, event *auction_entity.AuctionEvent) *internal_error.InternalError {
+	if _, err := ar.EventsCollection.InsertOne(ctx, newAuctionEventEntityMongo(event)); err != nil {
+		logger.Error(fmt.Sprintf("error trying to create %s event for auction %s", event.Type, event.AuctionId), err)
+		return internal_error.NewInternalServerError("error trying to create auction event")
+	}
+
+	return nil
+}
+
+// newAuctionEventEntityMongo converte o evento no documento da coleção "auction_events"
+func newAuctionEventEntityMongo(event *auction_entity.AuctionEvent) *AuctionEventEntityMongo {
+	eventEntityMongo := &AuctionEventEntityMongo{
+		Id:        event.Id,
+		AuctionId: event.AuctionId,
+		Type:      event.Type,
+		Timestamp: event.Timestamp.Unix(),
+	}
+	if !event.EndTime.IsZero() {
+		eventEntityMongo.EndTime = event.EndTime.Unix()
+	}
+	return eventEntityMongo
+}
+
+// FindAuctionEventsByAuctionId busca as transições de status de um leilão em ordem cronológica
+func (ar *AuctionRepository) FindAuctionEventsByAuctionId(ctx context.Context, auctionId string) ([]auction_entity.AuctionEvent, *internal_error.InternalError) {
+	filter := bson.M{"auction_id": auctionId}
+	opts := options.Find().SetSort(bson.D{{Key: "timestamp", Value: 1}})
+
+	cursor, err := ar.EventsCollection.Find(ctx, filter, opts)
+	if err != nil {
+		logger.Error(fmt.Sprintf("error trying to find events by auction id %s", auctionId), err)
+		return nil, mongodb.ClassifyMongoError(err, "", fmt.Sprintf("error trying to find events by auction id %s", auctionId))
+	}
+	defer cursor.Close(ctx)
+
+	var events []AuctionEventEntityMongo
+	if err := cursor.All(ctx, &events); err != nil {
+		logger.Error(fmt.Sprintf("error trying to decode events by auction id %s", auctionId), err)
+		return nil, mongodb.ClassifyMongoError(err, "", fmt.Sprintf("error trying to find events by auction id %s", auctionId))
+	}
+
+	eventEntities := make([]auction_entity.AuctionEvent, len(events))
+	for i, event := range events {
+		eventEntities[i] = auction_entity.AuctionEvent{
+			Id:        event.Id,
+			AuctionId: event.AuctionId,
+			Type:      event.Type,
+			Timestamp: time.Unix(event.Timestamp, 0),
+		}
+		if event.EndTime != 0 {
+			eventEntities[i].EndTime = time.Unix(event.EndTime, 0)
+		}
+	}
+
+	return eventEntities, nil
+}
@@ -0,0 +1,164 @@
+package auction
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/Guilherme-G-Cadilhe/Go-AuctionHouse_Concurrency-Server-Api/configuration/logger"
+	"github.com/Guilherme-G-Cadilhe/Go-AuctionHouse_Concurrency-Server-Api/internal/clock"
+	"github.com/Guilherme-G-Cadilhe/Go-AuctionHouse_Concurrency-Server-Api/internal/entity/auction_entity"
+	"github.com/Guilherme-G-Cadilhe/Go-AuctionHouse_Concurrency-Server-Api/internal/entity/bid_entity"
+	"github.com/Guilherme-G-Cadilhe/Go-AuctionHouse_Concurrency-Server-Api/internal/internal_error"
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+// scheduleAuctionClose agenda (ou reagenda) o fechamento automático do leilão
+// clock.AfterFunc (time.AfterFunc no relógio real) executa a função quando o tempo expira
+// Se já existir um timer para o leilão ele é parado e substituído
+// O timer dispara no CloseDeadline (fim + AUCTION_CLOSE_SKEW), o mesmo instante em que os lances passam a ser rejeitados
+func (ar *AuctionRepository) scheduleAuctionClose(auctionId string, endTime time.Time) {
+	ar.closeTimersMutex.Lock()
+	defer ar.closeTimersMutex.Unlock()
+
+	if timer, ok := ar.closeTimers[auctionId]; ok {
+		timer.Stop()
+	}
+
+	// Deadline no passado gera duração negativa - dispara imediatamente
+	// O callback só roda depois do Unlock deste método, então "timer" já está atribuído
+	deadline := auction_entity.CloseDeadline(endTime, ar.closeSkew)
+	var timer clock.Timer
+	timer = ar.clock.AfterFunc(deadline.Sub(ar.clock.Now()), func() {
+		// Stop() não impede um callback que já começou a rodar (ex: extensão no exato instante do fim)
+		// Um timer substituído por um reagendamento não pode fechar o leilão com o fim antigo
+		ar.closeTimersMutex.Lock()
+		current, ok := ar.closeTimers[auctionId]
+		if !ok || current != timer {
+			ar.closeTimersMutex.Unlock()
+			return
+		}
+		delete(ar.closeTimers, auctionId)
+		ar.closeTimersMutex.Unlock()
+
+		// context.Background() - o fechamento não pertence a nenhuma request
+		ar.closeAuction(context.Background(), auctionId)
+	})
+	ar.closeTimers[auctionId] = timer
+}
+
+// closeAuction marca o leilão como Completed e registra o evento de fechamento
+// É IDEMPOTENTE: o filtro por status Active faz o UpdateOne ser a transição Active -> Completed
+// Se dois fechamentos concorrerem, o MongoDB aplica o update em apenas um deles;
+// o outro não encontra documento (MatchedCount == 0) e não repete os efeitos colaterais
+// (evento, vencedor gravado, e-mails)
+// Retorna true apenas para quem fez a transição
+func (ar *AuctionRepository) closeAuction(ctx context.Context, auctionId string) bool {
+	filter := bson.M{"_id": auctionId, "status": auction_entity.Active}
+	update := bson.M{"$set": bson.M{"status": auction_entity.Completed, "updated_at": ar.clock.Now().Unix()}}
+
+	result, err := ar.Collection.UpdateOne(ctx, filter, update)
+	if err != nil {
+		logger.Error("error trying to update auction to close", err)
+		return false
+	}
+
+	if result.MatchedCount == 0 {
+		// Já fechado (ou inexistente) - outro fechamento venceu a corrida
+		logger.Debug(fmt.Sprintf("auction %s already closed, skipping close side effects", auctionId))
+		return false
+	}
+
+	// Leilão holandês que chegou ao fim sem ser arrematado - o preço para de cair
+	ar.stopPriceDrop(auctionId)
+
+	// Gravado antes dos listeners - a notificação já encontra o vencedor no documento
+	ar.persistCloseOutcome(ctx, auctionId)
+	ar.notifyAuctionClosed(ctx, auctionId)
+	return true
+}
+
+// notifyAuctionClosed registra o evento de fechamento e dispara os listeners
+// Compartilhado pelo fechamento automático e pelo arremate de leilões holandeses
+func (ar *AuctionRepository) notifyAuctionClosed(ctx context.Context, auctionId string) {
+	ar.CreateAuctionEvent(ctx, auction_entity.NewAuctionEvent(auctionId, auction_entity.AuctionClosedEvent, ar.clock.Now()))
+
+	// Cada listener roda em sua própria goroutine - lentidão (ex: SMTP) não atrasa outros fechamentos
+	for _, listener := range ar.closeListeners {
+		go listener(ctx, auctionId)
+	}
+}
+
+// stopAuctionTimers cancela o fechamento e a queda de preço agendados de um leilão já fechado
+func (ar *AuctionRepository) stopAuctionTimers(auctionId string) {
+	ar.closeTimersMutex.Lock()
+	if timer, ok := ar.closeTimers[auctionId]; ok {
+		timer.Stop()
+		delete(ar.closeTimers, auctionId)
+	}
+	ar.closeTimersMutex.Unlock()
+
+	ar.stopPriceDrop(auctionId)
+}
+
+// persistCloseOutcome calcula o lance vencedor e grava o resultado no documento do leilão
+// Roda depois do status virar Completed: lances após o fim já são rejeitados, então o vencedor não muda mais
+// O motivo do fechamento é sempre gravado; o vencedor só com AUCTION_PERSIST_WINNER=true
+// Falhas apenas são logadas - a consulta do vencedor volta a ser calculada sob demanda
+func (ar *AuctionRepository) persistCloseOutcome(ctx context.Context, auctionId string) {
+	if ar.winningBidFinder == nil {
+		return
+	}
+
+	winningBid, err := ar.winningBidFinder(ctx, auctionId)
+	reason := auction_entity.CloseReasonFor(winningBid, err)
+	if reason == "" {
+		// Busca falhou por outro motivo que não "sem lances" - nada confiável a gravar
+		return
+	}
+
+	set := bson.M{"close_reason": reason, "updated_at": ar.clock.Now().Unix()}
+	if reason == auction_entity.CloseReasonSold && ar.persistWinner {
+		set["winning_bid_id"] = winningBid.Id
+		set["winning_amount"] = winningBid.Amount
+	}
+
+	filter := bson.M{"_id": auctionId, "status": auction_entity.Completed}
+	update := bson.M{"$set": set}
+	if _, errUpdate := ar.Collection.UpdateOne(ctx, filter, update); errUpdate != nil {
+		logger.Error(fmt.Sprintf("error trying to persist winning bid of auction %s", auctionId), errUpdate)
+	}
+}
+
+// SetWinningBidFinder registra a função que calcula o lance vencedor no fechamento
+// Assim como OnAuctionClosed, deve ser chamado na inicialização
+func (ar *AuctionRepository) SetWinningBidFinder(finder func(ctx context.Context, auctionId string) (*bid_entity.Bid, *internal_error.InternalError)) {
+	ar.winningBidFinder = finder
+}
+
+// OnAuctionClosed registra uma função chamada sempre que um leilão é fechado automaticamente
+// Deve ser chamado na inicialização, antes de RestoreAuctionCloseSchedules (o slice não é protegido por mutex)
+func (ar *AuctionRepository) OnAuctionClosed(listener func(ctx context.Context, auctionId string)) {
+	ar.closeListeners = append(ar.closeListeners, listener)
+}
+
+// RestoreAuctionCloseSchedules reagenda o fechamento de todos os leilões ativos
+// Chamado na inicialização - timers vivem em memória e se perdem em um restart
+// Leilões holandeses também voltam a ter a queda de preço agendada
+// Leilões cujo deadline (fim + AUCTION_CLOSE_SKEW) já passou são fechados imediatamente
+func (ar *AuctionRepository) RestoreAuctionCloseSchedules(ctx context.Context) *internal_error.InternalError {
+	active := auction_entity.Active
+	auctions, err := ar.FindAllAuctions(ctx, auction_entity.AuctionListFilter{Status: &active})
+	if err != nil {
+		return err
+	}
+
+	for _, auction := range auctions {
+		ar.scheduleAuctionClose(auction.Id, auction.EndTime)
+		if auction.IsDutch() {
+			ar.schedulePriceDrop(auction)
+		}
+	}
+
+	return nil
+}
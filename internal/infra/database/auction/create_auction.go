@@ -4,13 +4,15 @@ package auction
 
 import (
 	"context"
-	"os"
+	"sync"
 	"time"
 
-	"github.com/Guilherme-G-Cadilhe/Go-AuctionHouse_Concurrency-Server-Api/configuration/logger"
+	"github.com/Guilherme-G-Cadilhe/Go-AuctionHouse_Concurrency-Server-Api/configuration/config"
+	"github.com/Guilherme-G-Cadilhe/Go-AuctionHouse_Concurrency-Server-Api/configuration/database/mongodb"
+	"github.com/Guilherme-G-Cadilhe/Go-AuctionHouse_Concurrency-Server-Api/internal/clock"
 	"github.com/Guilherme-G-Cadilhe/Go-AuctionHouse_Concurrency-Server-Api/internal/entity/auction_entity"
+	"github.com/Guilherme-G-Cadilhe/Go-AuctionHouse_Concurrency-Server-Api/internal/entity/bid_entity"
 	"github.com/Guilherme-G-Cadilhe/Go-AuctionHouse_Concurrency-Server-Api/internal/internal_error"
-	"go.mongodb.org/mongo-driver/bson"
 	"go.mongodb.org/mongo-driver/mongo"
 )
 
@@ -18,81 +20,193 @@ import (
 // Separação entre entidade de domínio (Auction) e modelo de persistência (AuctionEntityMongo)
 // Note as diferenças: Timestamp vira int64, tipos mantidos como referência à entidade
 type AuctionEntityMongo struct {
-	Id          string                          `bson:"_id"` // MongoDB usa "_id" por padrão
-	ProductName string                          `bson:"product_name"`
-	Category    string                          `bson:"category"`
-	Description string                          `bson:"description"`
-	Condition   auction_entity.ProductCondition // Mantém referência ao tipo da entidade
-	Status      auction_entity.AuctionStatus    // Mantém referência ao tipo da entidade
-	Timestamp   int64                           // MongoDB: timestamp como Unix epoch (int64)
+	Id             string                          `bson:"_id"` // MongoDB usa "_id" por padrão
+	ProductName    string                          `bson:"product_name"`
+	Category       string                          `bson:"category"`
+	Description    string                          `bson:"description"`
+	Condition      auction_entity.ProductCondition // Mantém referência ao tipo da entidade
+	Status         auction_entity.AuctionStatus    // Mantém referência ao tipo da entidade
+	OwnerId        string                          `bson:"owner_id,omitempty"`
+	Sealed         bool                            `bson:"sealed"`
+	Featured       bool                            `bson:"featured,omitempty"`         // Ausente = sem destaque (documentos antigos)
+	AllowGuestBids bool                            `bson:"allow_guest_bids,omitempty"` // Ausente = só usuários cadastrados
+	Timestamp      int64                           // MongoDB: timestamp como Unix epoch (int64) - é o CreatedAt
+	UpdatedAt      int64                           `bson:"updated_at,omitempty"` // Última modificação; ausente em documentos antigos
+	EndTime        int64                           `bson:"end_time"`             // Fim efetivo do leilão (pode ser estendido)
+
+	ExtensionCount int `bson:"extension_count,omitempty"` // Ausente = nenhuma extensão
+
+	// Lance vencedor gravado no fechamento - evita ordenar todos os lances a cada consulta
+	WinningBidId  string  `bson:"winning_bid_id,omitempty"`
+	WinningAmount float64 `bson:"winning_amount,omitempty"`
+
+	// Motivo do fechamento - gravado mesmo com AUCTION_PERSIST_WINNER=false; ausente em documentos antigos
+	CloseReason auction_entity.CloseReason `bson:"close_reason,omitempty"`
+
+	// Leilão holandês - campos ausentes em leilões comuns (type 0 = English)
+	Type              auction_entity.AuctionType `bson:"type,omitempty"`
+	StartPrice        float64                    `bson:"start_price,omitempty"`
+	FloorPrice        float64                    `bson:"floor_price,omitempty"`
+	PriceDecrement    float64                    `bson:"price_decrement,omitempty"`
+	DecrementInterval time.Duration              `bson:"decrement_interval,omitempty"` // Nanossegundos (int64)
+	CurrentPrice      float64                    `bson:"current_price,omitempty"`      // Atualizado pela rotina de queda de preço
+
+	// Tabela de incrementos por faixa - ausente em leilões sem tabela
+	IncrementSchedule []incrementTierMongo `bson:"increment_schedule,omitempty"`
+}
+
+// incrementTierMongo é uma faixa da tabela de incrementos (auction_entity.IncrementTier)
+type incrementTierMongo struct {
+	Threshold float64 `bson:"threshold"`
+	Increment float64 `bson:"increment"`
+}
+
+// newIncrementScheduleMongo converte a tabela da entidade; nil mantém o campo fora do documento
+func newIncrementScheduleMongo(schedule auction_entity.IncrementSchedule) []incrementTierMongo {
+	if len(schedule) == 0 {
+		return nil
+	}
+	tiers := make([]incrementTierMongo, 0, len(schedule))
+	for _, tier := range schedule {
+		tiers = append(tiers, incrementTierMongo{Threshold: tier.Threshold, Increment: tier.Increment})
+	}
+	return tiers
 }
 
 // AuctionRepository é a implementação concreta da AuctionRepositoryInterface
 // Esta struct "implementa" implicitamente a interface definida na camada de domínio
 type AuctionRepository struct {
-	Collection *mongo.Collection // Referência para coleção "auctions" do MongoDB
+	Collection         *mongo.Collection // Referência para coleção "auctions" do MongoDB
+	EventsCollection   *mongo.Collection // Coleção "auction_events" com as transições de status
+	WatchersCollection *mongo.Collection // Coleção "auction_watchers" com os observadores de cada leilão
+	auctionInterval    time.Duration     // Duração padrão de um leilão (AUCTION_INTERVAL)
+	closeSkew          time.Duration     // Tolerância após o fim efetivo (AUCTION_CLOSE_SKEW)
+
+	// Timers de fechamento automático por leilão
+	// Guardar o timer permite reagendar o fechamento (ex: extensão do leilão)
+	closeTimers      map[string]clock.Timer
+	priceDropTimers  map[string]clock.Timer // Próxima queda de preço dos leilões holandeses (mesmo mutex)
+	closeTimersMutex *sync.Mutex
+	clock            clock.Clock // Relógio dos timers de fechamento (fake em testes)
+
+	// Funções chamadas após cada fechamento (ex: notificação por e-mail)
+	// Registradas na inicialização via OnAuctionClosed, antes de qualquer fechamento
+	closeListeners []func(ctx context.Context, auctionId string)
+
+	// Busca do lance vencedor usada no fechamento (registrada via SetWinningBidFinder)
+	// É uma função e não o BidRepository porque o pacote bid já importa este pacote (evita ciclo)
+	persistWinner    bool
+	winningBidFinder func(ctx context.Context, auctionId string) (*bid_entity.Bid, *internal_error.InternalError)
+
+	// Fila da criação em lote (CREATE_AUCTION_BATCH) - nil quando desligada
+	createRequests chan auctionCreateRequest
 }
 
 // NewAuctionRepository é a função FACTORY para criar instâncias do repository
 // Padrão de injeção de dependência manual em Go
-func NewAuctionRepository(database *mongo.Database) *AuctionRepository {
-	return &AuctionRepository{
-		Collection: database.Collection("auctions"), // Define coleção "auctions"
+func NewAuctionRepository(database *mongo.Database, cfg config.AuctionConfig, clk clock.Clock) *AuctionRepository {
+	ar := &AuctionRepository{
+		Collection:         database.Collection("auctions"), // Define coleção "auctions"
+		EventsCollection:   database.Collection("auction_events"),
+		WatchersCollection: database.Collection("auction_watchers"),
+		auctionInterval:    cfg.Interval,
+		closeSkew:          cfg.CloseSkew,
+		persistWinner:      cfg.PersistWinner,
+		closeTimers:        make(map[string]clock.Timer),
+		priceDropTimers:    make(map[string]clock.Timer),
+		closeTimersMutex:   &sync.Mutex{},
+		clock:              clk,
+	}
+
+	if cfg.CreateBatch {
+		ar.createRequests = make(chan auctionCreateRequest, cfg.CreateBatchMaxSize)
+		go ar.runCreateBatches(cfg.CreateBatchWindow, cfg.CreateBatchMaxSize)
 	}
+	return ar
 }
 
 // CreateAuction implementa o método da interface AuctionRepositoryInterface
 // METHOD RECEIVER "(ar *AuctionRepository)" vincula à struct AuctionRepository
 func (ar *AuctionRepository) CreateAuction(ctx context.Context, auction *auction_entity.Auction) *internal_error.InternalError {
-	// CONVERSÃO: Entidade de domínio -> Modelo de persistência
-	// Este mapeamento é necessário porque:
-	// 1. Entidade não deve saber sobre MongoDB
-	// 2. MongoDB pode precisar de formato específico (timestamps, etc.)
-	auctionEntityMongo := &AuctionEntityMongo{
-		Id:          auction.Id,
-		ProductName: auction.ProductName,
-		Category:    auction.Category,
-		Description: auction.Description,
-		Condition:   auction.Condition,
-		Status:      auction.Status,
-		// .Unix() converte time.Time para int64 (Unix timestamp)
-		// MongoDB armazena melhor como número que como objeto complexo
-		Timestamp: auction.Timestamp.Unix(),
+	// O fim efetivo é calculado na criação e persistido
+	// Assim o fechamento sobrevive a restarts e pode ser estendido
+	if auction.EndTime.IsZero() {
+		auction.EndTime = auction.Timestamp.Add(ar.auctionInterval)
+	}
+	if auction.UpdatedAt.IsZero() {
+		auction.UpdatedAt = auction.Timestamp
+	}
+
+	auctionEntityMongo := newAuctionEntityMongo(auction)
+
+	// CREATE_AUCTION_BATCH: o insert espera a janela e sai junto com os leilões criados ao mesmo tempo
+	// O InsertMany também grava os eventos e agenda os fechamentos - ver create_auction_batch.go
+	if ar.createRequests != nil {
+		return ar.enqueueAuctionCreate(ctx, auction, auctionEntityMongo)
 	}
 
 	// ar.Collection.InsertOne() insere documento no MongoDB
 	// ctx para timeout/cancelamento, auctionEntityMongo é o documento
 	// "_" ignora o resultado da inserção (só nos importa com erros)
+	stopTracking := mongodb.TrackQuery("CreateAuction", auctionEntityMongo.Id)
 	_, err := ar.Collection.InsertOne(ctx, auctionEntityMongo)
+	stopTracking()
 	if err != nil {
 		// Retorna erro genérico - não expõe detalhes internos do MongoDB
 		return internal_error.NewInternalServerError("error trying to create auction")
 	}
 
-	go func() {
-		select {
-		case <-time.After(getAuctionInterval()):
-			update := bson.M{"$set": bson.M{"status": auction_entity.Completed}}
-			filter := bson.M{"_id": auctionEntityMongo.Id}
-			_, err := ar.Collection.UpdateOne(ctx, filter, update)
-			if err != nil {
-				logger.Error("error trying to update auction to close", err)
-				return
-			}
-
-		}
-	}()
+	// Registra o evento de criação para o histórico do leilão
+	// Falha aqui não desfaz o leilão - o histórico é secundário
+	ar.CreateAuctionEvent(ctx, auction_entity.NewAuctionEvent(auction.Id, auction_entity.AuctionCreatedEvent, ar.clock.Now()))
 
+	ar.armAuctionTimers(*auction)
 	return nil // Sucesso - sem erro
 }
 
-func getAuctionInterval() time.Duration {
-	interval := os.Getenv("AUCTION_INTERVAL")
-	duration, err := time.ParseDuration(interval)
-	if err != nil {
-		return 5 * time.Minute
+// newAuctionEntityMongo converte o leilão recém-criado no documento gravado
+func newAuctionEntityMongo(auction *auction_entity.Auction) *AuctionEntityMongo {
+	// CONVERSÃO: Entidade de domínio -> Modelo de persistência
+	// Este mapeamento é necessário porque:
+	// 1. Entidade não deve saber sobre MongoDB
+	// 2. MongoDB pode precisar de formato específico (timestamps, etc.)
+	return &AuctionEntityMongo{
+		Id:             auction.Id,
+		ProductName:    auction.ProductName,
+		Category:       auction.Category,
+		Description:    auction.Description,
+		Condition:      auction.Condition,
+		Status:         auction.Status,
+		OwnerId:        auction.OwnerId,
+		Sealed:         auction.Sealed,
+		Featured:       auction.Featured,
+		AllowGuestBids: auction.AllowGuestBids,
+		// .Unix() converte time.Time para int64 (Unix timestamp)
+		// MongoDB armazena melhor como número que como objeto complexo
+		Timestamp: auction.Timestamp.Unix(),
+		UpdatedAt: auction.UpdatedAt.Unix(),
+		EndTime:   auction.EndTime.Unix(),
+
+		Type:              auction.Type,
+		StartPrice:        auction.Dutch.StartPrice,
+		FloorPrice:        auction.Dutch.FloorPrice,
+		PriceDecrement:    auction.Dutch.PriceDecrement,
+		DecrementInterval: auction.Dutch.DecrementInterval,
+		CurrentPrice:      auction.CurrentPrice,
+
+		IncrementSchedule: newIncrementScheduleMongo(auction.IncrementSchedule),
+	}
+}
+
+// armAuctionTimers agenda os timers de um leilão recém-gravado
+func (ar *AuctionRepository) armAuctionTimers(auction auction_entity.Auction) {
+	// Agenda o fechamento automático no fim efetivo do leilão
+	ar.scheduleAuctionClose(auction.Id, auction.EndTime)
+
+	// Leilão holandês: agenda a primeira queda de preço
+	if auction.IsDutch() {
+		ar.schedulePriceDrop(auction)
 	}
-	return duration
 }
 
 /*
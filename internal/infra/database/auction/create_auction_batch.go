@@ -0,0 +1,132 @@
+package auction
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/Guilherme-G-Cadilhe/Go-AuctionHouse_Concurrency-Server-Api/configuration/database/mongodb"
+	"github.com/Guilherme-G-Cadilhe/Go-AuctionHouse_Concurrency-Server-Api/configuration/logger"
+	"github.com/Guilherme-G-Cadilhe/Go-AuctionHouse_Concurrency-Server-Api/internal/entity/auction_entity"
+	"github.com/Guilherme-G-Cadilhe/Go-AuctionHouse_Concurrency-Server-Api/internal/internal_error"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+	"go.uber.org/zap"
+)
+
+// auctionCreateRequest é um leilão esperando o próximo InsertMany da criação em lote
+// result recebe o resultado do insert DESTE leilão (buffer 1: o flush nunca bloqueia)
+type auctionCreateRequest struct {
+	auction  auction_entity.Auction
+	document *AuctionEntityMongo
+	result   chan *internal_error.InternalError
+}
+
+// enqueueAuctionCreate entrega o leilão à goroutine de lote e espera o resultado
+// Diferente dos lances, a criação continua síncrona: o 201 só sai depois do leilão gravado
+// Depois de enfileirado o leilão sempre é gravado (ou falha) - por isso a espera não observa o ctx
+func (ar *AuctionRepository) enqueueAuctionCreate(ctx context.Context, auction *auction_entity.Auction, document *AuctionEntityMongo) *internal_error.InternalError {
+	request := auctionCreateRequest{
+		auction:  *auction,
+		document: document,
+		result:   make(chan *internal_error.InternalError, 1),
+	}
+
+	select {
+	case ar.createRequests <- request:
+	case <-ctx.Done():
+		return mongodb.ClassifyMongoError(ctx.Err(), "", "error trying to create auction")
+	}
+	return <-request.result
+}
+
+// runCreateBatches é a goroutine da criação em lote - mesmo padrão do batch de lances:
+// grava quando a janela do primeiro leilão expira ou quando o lote atinge o tamanho máximo
+// Vive enquanto o processo: no shutdown os handlers em andamento esperam o flush antes de responder
+func (ar *AuctionRepository) runCreateBatches(window time.Duration, maxSize int) {
+	for first := range ar.createRequests {
+		batch := []auctionCreateRequest{first}
+		timer := ar.clock.NewTimer(window)
+
+	collect:
+		for len(batch) < maxSize {
+			select {
+			case request := <-ar.createRequests:
+				batch = append(batch, request)
+			case <-timer.C():
+				break collect
+			}
+		}
+		timer.Stop()
+
+		ar.flushAuctionCreates(context.Background(), batch)
+	}
+}
+
+// flushAuctionCreates grava o lote com um InsertMany não ordenado
+// Não ordenado: um documento com erro (ex: _id duplicado) não impede os demais
+// Cada leilão recebe o próprio resultado; só os gravados ganham evento e timers
+func (ar *AuctionRepository) flushAuctionCreates(ctx context.Context, batch []auctionCreateRequest) {
+	documents := make([]interface{}, len(batch))
+	for i, request := range batch {
+		documents[i] = request.document
+	}
+
+	stopTracking := mongodb.TrackQuery("CreateAuctionBatch", len(batch))
+	_, err := ar.Collection.InsertMany(ctx, documents, options.InsertMany().SetOrdered(false))
+	stopTracking()
+
+	failed := failedInserts(err, len(batch))
+	if err != nil {
+		logger.Error("error trying to create auction batch", err,
+			zap.Int("batch_size", len(batch)),
+			zap.Int("failed", len(failed)))
+	}
+
+	// Eventos de criação também saem em um único InsertMany - falha só afeta o histórico
+	events := make([]interface{}, 0, len(batch))
+	for i, request := range batch {
+		if _, ok := failed[i]; ok {
+			continue
+		}
+		events = append(events, newAuctionEventEntityMongo(auction_entity.NewAuctionEvent(request.auction.Id, auction_entity.AuctionCreatedEvent, ar.clock.Now())))
+	}
+	if len(events) > 0 {
+		if _, errEvents := ar.EventsCollection.InsertMany(ctx, events, options.InsertMany().SetOrdered(false)); errEvents != nil {
+			logger.Error(fmt.Sprintf("error trying to create %d auction created events", len(events)), errEvents)
+		}
+	}
+
+	for i, request := range batch {
+		if _, ok := failed[i]; ok {
+			request.result <- internal_error.NewInternalServerError("error trying to create auction")
+			continue
+		}
+		ar.armAuctionTimers(request.auction)
+		request.result <- nil
+	}
+}
+
+// failedInserts devolve as posições do lote que não foram gravadas
+// BulkWriteException lista os documentos com erro; os demais foram gravados
+// Qualquer outro erro (rede, write concern) deixa o resultado incerto - o lote inteiro é tratado como falha
+func failedInserts(err error, size int) map[int]struct{} {
+	failed := make(map[int]struct{})
+	if err == nil {
+		return failed
+	}
+
+	var bulkErr mongo.BulkWriteException
+	if errors.As(err, &bulkErr) && bulkErr.WriteConcernError == nil {
+		for _, writeErr := range bulkErr.WriteErrors {
+			failed[writeErr.Index] = struct{}{}
+		}
+		return failed
+	}
+
+	for i := 0; i < size; i++ {
+		failed[i] = struct{}{}
+	}
+	return failed
+}
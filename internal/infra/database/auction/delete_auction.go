@@ -0,0 +1,129 @@
+package auction
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/Guilherme-G-Cadilhe/Go-AuctionHouse_Concurrency-Server-Api/configuration/database/mongodb"
+	"github.com/Guilherme-G-Cadilhe/Go-AuctionHouse_Concurrency-Server-Api/configuration/logger"
+	"github.com/Guilherme-G-Cadilhe/Go-AuctionHouse_Concurrency-Server-Api/internal/entity/auction_entity"
+	"github.com/Guilherme-G-Cadilhe/Go-AuctionHouse_Concurrency-Server-Api/internal/internal_error"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.uber.org/zap"
+)
+
+// transactionsUnsupportedCode é o IllegalOperation devolvido por um MongoDB standalone ao abrir uma transação
+// Transações exigem replica set ou mongos - o docker-compose sobe um nó standalone
+const transactionsUnsupportedCode = 20
+
+// errAuctionNotClosed interrompe a transação quando o leilão não está (mais) fechado
+// errAuctionNotFound quando ele nem existe mais (ex: remoção concorrente) - 404 em vez de 409
+var (
+	errAuctionNotClosed = errors.New("auction is not closed")
+	errAuctionNotFound  = errors.New("auction not found")
+)
+
+// DeleteAuction remove um leilão fechado junto com seus lances, histórico e observadores
+// Em replica set tudo acontece numa transação: nunca sobram lances órfãos de um leilão removido
+// Em standalone (sem transações) os dependentes são removidos antes do leilão - uma falha no meio
+// deixa o leilão no banco e a remoção pode ser repetida
+// O filtro por status Completed impede remover um leilão ativo (409), mesmo após a checagem do use case
+func (ar *AuctionRepository) DeleteAuction(ctx context.Context, auctionId string) *internal_error.InternalError {
+	defer mongodb.TrackQuery("DeleteAuction", auctionId)()
+
+	err := ar.deleteAuctionInTransaction(ctx, auctionId)
+	if isTransactionsUnsupported(err) {
+		logger.Warn("MongoDB transactions unavailable, deleting auction without a transaction",
+			zap.String("auction_id", auctionId))
+		err = ar.deleteAuctionSequentially(ctx, auctionId)
+	}
+
+	switch {
+	case err == nil:
+		// Leilão fechado não deveria ter timers, mas um fechamento atrasado não pode disparar para um leilão removido
+		ar.stopAuctionTimers(auctionId)
+		return nil
+	case errors.Is(err, errAuctionNotFound):
+		return internal_error.NewNotFoundError(fmt.Sprintf("auction %s not found", auctionId))
+	case errors.Is(err, errAuctionNotClosed):
+		return internal_error.NewConflictError(fmt.Sprintf("auction %s is not closed", auctionId))
+	default:
+		logger.Error(fmt.Sprintf("error trying to delete auction %s", auctionId), err)
+		return mongodb.ClassifyMongoError(err, "", fmt.Sprintf("error trying to delete auction %s", auctionId))
+	}
+}
+
+// deleteAuctionInTransaction remove o leilão e os dependentes atomicamente
+// WithTransaction refaz a função em erros transitórios (ex: conflito de escrita) - ela precisa ser idempotente
+func (ar *AuctionRepository) deleteAuctionInTransaction(ctx context.Context, auctionId string) error {
+	session, err := ar.Collection.Database().Client().StartSession()
+	if err != nil {
+		return err
+	}
+	defer session.EndSession(ctx)
+
+	_, err = session.WithTransaction(ctx, func(sessionCtx mongo.SessionContext) (interface{}, error) {
+		result, err := ar.Collection.DeleteOne(sessionCtx, bson.M{"_id": auctionId, "status": auction_entity.Completed})
+		if err != nil {
+			return nil, err
+		}
+		if result.DeletedCount == 0 {
+			return nil, ar.notDeletedReason(sessionCtx, auctionId) // Aborta a transação - nada é removido
+		}
+		return nil, ar.deleteAuctionDependents(sessionCtx, auctionId)
+	})
+	return err
+}
+
+// deleteAuctionSequentially é o fallback sem transação: dependentes primeiro, leilão por último
+// Assim uma falha no meio nunca deixa lances apontando para um leilão que não existe mais
+func (ar *AuctionRepository) deleteAuctionSequentially(ctx context.Context, auctionId string) error {
+	// Mesma garantia do filtro da transação, checada antes de remover qualquer dependente
+	count, err := ar.Collection.CountDocuments(ctx, bson.M{"_id": auctionId, "status": auction_entity.Completed})
+	if err != nil {
+		return err
+	}
+	if count == 0 {
+		return ar.notDeletedReason(ctx, auctionId)
+	}
+
+	if err := ar.deleteAuctionDependents(ctx, auctionId); err != nil {
+		return err
+	}
+	_, err = ar.Collection.DeleteOne(ctx, bson.M{"_id": auctionId, "status": auction_entity.Completed})
+	return err
+}
+
+// deleteAuctionDependents remove lances, eventos e observadores do leilão
+// A coleção "bids" é acessada pelo nome: o pacote bid já importa este pacote (evita ciclo)
+func (ar *AuctionRepository) deleteAuctionDependents(ctx context.Context, auctionId string) error {
+	if _, err := ar.Collection.Database().Collection("bids").DeleteMany(ctx, bson.M{"auction_id": auctionId}); err != nil {
+		return err
+	}
+	if _, err := ar.EventsCollection.DeleteMany(ctx, bson.M{"auction_id": auctionId}); err != nil {
+		return err
+	}
+	_, err := ar.WatchersCollection.DeleteOne(ctx, bson.M{"_id": auctionId})
+	return err
+}
+
+// isTransactionsUnsupported indica que o servidor recusou a transação por não ser replica set
+func isTransactionsUnsupported(err error) bool {
+	var serverErr mongo.ServerError
+	return errors.As(err, &serverErr) && serverErr.HasErrorCode(transactionsUnsupportedCode)
+}
+
+// notDeletedReason explica por que o filtro _id + Completed não achou o leilão
+func (ar *AuctionRepository) notDeletedReason(ctx context.Context, auctionId string) error {
+	count, err := ar.Collection.CountDocuments(ctx, bson.M{"_id": auctionId})
+	switch {
+	case err != nil:
+		return err
+	case count == 0:
+		return errAuctionNotFound
+	default:
+		return errAuctionNotClosed
+	}
+}
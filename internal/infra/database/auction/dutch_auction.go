@@ -0,0 +1,111 @@
+package auction
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Guilherme-G-Cadilhe/Go-AuctionHouse_Concurrency-Server-Api/configuration/database/mongodb"
+	"github.com/Guilherme-G-Cadilhe/Go-AuctionHouse_Concurrency-Server-Api/configuration/logger"
+	"github.com/Guilherme-G-Cadilhe/Go-AuctionHouse_Concurrency-Server-Api/internal/clock"
+	"github.com/Guilherme-G-Cadilhe/Go-AuctionHouse_Concurrency-Server-Api/internal/entity/auction_entity"
+	"github.com/Guilherme-G-Cadilhe/Go-AuctionHouse_Concurrency-Server-Api/internal/entity/bid_entity"
+	"github.com/Guilherme-G-Cadilhe/Go-AuctionHouse_Concurrency-Server-Api/internal/internal_error"
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+// schedulePriceDrop agenda a próxima queda de preço de um leilão holandês
+// Cada disparo grava o preço atual no documento e agenda a queda seguinte, até chegar ao piso
+// Mesmo padrão do scheduleAuctionClose: um timer por leilão, substituído a cada reagendamento
+func (ar *AuctionRepository) schedulePriceDrop(auction auction_entity.Auction) {
+	nextDropAt, ok := auction.NextPriceDropAt(ar.clock.Now())
+
+	ar.closeTimersMutex.Lock()
+	defer ar.closeTimersMutex.Unlock()
+
+	if timer, exists := ar.priceDropTimers[auction.Id]; exists {
+		timer.Stop()
+		delete(ar.priceDropTimers, auction.Id)
+	}
+	if !ok {
+		return // Preço já está no piso - nada mais a agendar
+	}
+
+	var timer clock.Timer
+	timer = ar.clock.AfterFunc(nextDropAt.Sub(ar.clock.Now()), func() {
+		ar.closeTimersMutex.Lock()
+		current, exists := ar.priceDropTimers[auction.Id]
+		if !exists || current != timer {
+			ar.closeTimersMutex.Unlock()
+			return
+		}
+		delete(ar.priceDropTimers, auction.Id)
+		ar.closeTimersMutex.Unlock()
+
+		ar.applyPriceDrop(context.Background(), auction.Id)
+	})
+	ar.priceDropTimers[auction.Id] = timer
+}
+
+// applyPriceDrop grava o preço da tabela no instante atual e agenda a próxima queda
+// O filtro por status Active impede que um leilão já arrematado tenha o preço alterado
+func (ar *AuctionRepository) applyPriceDrop(ctx context.Context, auctionId string) {
+	auction, err := ar.FindAuctionById(ctx, auctionId)
+	if err != nil || auction.Status != auction_entity.Active || !auction.IsDutch() {
+		return
+	}
+
+	price := auction.DutchPriceAt(ar.clock.Now())
+	filter := bson.M{"_id": auctionId, "status": auction_entity.Active}
+	update := bson.M{"$set": bson.M{"current_price": price, "updated_at": ar.clock.Now().Unix()}}
+
+	stopTracking := mongodb.TrackQuery("ApplyPriceDrop", filter)
+	_, errUpdate := ar.Collection.UpdateOne(ctx, filter, update)
+	stopTracking()
+	if errUpdate != nil {
+		// O lance continua validado pela tabela (DutchPriceAt) - só o campo gravado fica atrasado
+		logger.Error(fmt.Sprintf("error trying to update current price of auction %s", auctionId), errUpdate)
+	}
+
+	ar.schedulePriceDrop(*auction)
+}
+
+// stopPriceDrop cancela a queda de preço agendada - chamado quando o leilão fecha
+func (ar *AuctionRepository) stopPriceDrop(auctionId string) {
+	ar.closeTimersMutex.Lock()
+	defer ar.closeTimersMutex.Unlock()
+
+	if timer, ok := ar.priceDropTimers[auctionId]; ok {
+		timer.Stop()
+		delete(ar.priceDropTimers, auctionId)
+	}
+}
+
+// ClaimDutchAuction fecha o leilão holandês com o lance informado como vencedor
+// O filtro por status Active faz do UpdateOne a disputa: só o primeiro lance aceito fecha o leilão
+// Retorna false quando outro lance (ou o fechamento automático) chegou antes
+func (ar *AuctionRepository) ClaimDutchAuction(ctx context.Context, auctionId string, bid bid_entity.Bid) (bool, *internal_error.InternalError) {
+	filter := bson.M{"_id": auctionId, "status": auction_entity.Active, "type": auction_entity.Dutch}
+	update := bson.M{"$set": bson.M{
+		"status":         auction_entity.Completed,
+		"winning_bid_id": bid.Id,
+		"winning_amount": bid.Amount,
+		"close_reason":   auction_entity.CloseReasonSold,
+		"updated_at":     ar.clock.Now().Unix(),
+	}}
+
+	stopTracking := mongodb.TrackQuery("ClaimDutchAuction", filter)
+	result, err := ar.Collection.UpdateOne(ctx, filter, update)
+	stopTracking()
+	if err != nil {
+		logger.Error(fmt.Sprintf("error trying to claim dutch auction %s", auctionId), err)
+		return false, mongodb.ClassifyMongoError(err, "", fmt.Sprintf("error trying to claim dutch auction %s", auctionId))
+	}
+	return result.MatchedCount == 1, nil
+}
+
+// FinishDutchAuctionClose roda os efeitos colaterais do fechamento de um leilão arrematado
+// Chamado depois que o lance vencedor foi gravado - os listeners já encontram o lance na coleção
+func (ar *AuctionRepository) FinishDutchAuctionClose(ctx context.Context, auctionId string) {
+	ar.stopAuctionTimers(auctionId)
+	ar.notifyAuctionClosed(ctx, auctionId)
+}
@@ -0,0 +1,44 @@
+package auction
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/Guilherme-G-Cadilhe/Go-AuctionHouse_Concurrency-Server-Api/configuration/logger"
+	"github.com/Guilherme-G-Cadilhe/Go-AuctionHouse_Concurrency-Server-Api/internal/entity/auction_entity"
+	"github.com/Guilherme-G-Cadilhe/Go-AuctionHouse_Concurrency-Server-Api/internal/internal_error"
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+// UpdateAuctionEndTime persiste o novo fim efetivo e reagenda o fechamento automático
+// Só atualiza leilões ainda ativos - retorna conflict se o leilão já fechou
+// Toda extensão é registrada como evento "extended" (relatório GET /auctions/:auctionId/extensions)
+// O limite de extensões faz parte do filtro: duas extensões simultâneas não passam juntas do limite
+func (ar *AuctionRepository) UpdateAuctionEndTime(ctx context.Context, auctionId string, endTime time.Time, maxExtensions int) *internal_error.InternalError {
+	filter := bson.M{"_id": auctionId, "status": auction_entity.Active}
+	if maxExtensions > 0 {
+		// $not/$gte também casa documentos sem o campo (leilões nunca estendidos); $lt não casaria
+		filter["extension_count"] = bson.M{"$not": bson.M{"$gte": maxExtensions}}
+	}
+	update := bson.M{
+		"$set": bson.M{"end_time": endTime.Unix(), "updated_at": ar.clock.Now().Unix()},
+		"$inc": bson.M{"extension_count": 1},
+	}
+
+	result, err := ar.Collection.UpdateOne(ctx, filter, update)
+	if err != nil {
+		logger.Error(fmt.Sprintf("error trying to update end time of auction %s", auctionId), err)
+		return internal_error.NewInternalServerError(fmt.Sprintf("error trying to update end time of auction %s", auctionId))
+	}
+
+	if result.MatchedCount == 0 {
+		return internal_error.NewConflictError(fmt.Sprintf("auction %s is not active or reached the maximum number of extensions", auctionId))
+	}
+
+	// Falha no registro não desfaz a extensão - o relatório é secundário
+	ar.CreateAuctionEvent(ctx, auction_entity.NewAuctionExtendedEvent(auctionId, endTime, ar.clock.Now()))
+
+	ar.scheduleAuctionClose(auctionId, endTime)
+	return nil
+}
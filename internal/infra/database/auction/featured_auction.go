@@ -0,0 +1,30 @@
+package auction
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Guilherme-G-Cadilhe/Go-AuctionHouse_Concurrency-Server-Api/configuration/database/mongodb"
+	"github.com/Guilherme-G-Cadilhe/Go-AuctionHouse_Concurrency-Server-Api/configuration/logger"
+	"github.com/Guilherme-G-Cadilhe/Go-AuctionHouse_Concurrency-Server-Api/internal/internal_error"
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+// SetAuctionFeatured marca ou desmarca o destaque de um leilão, em qualquer status
+// Leilão inexistente retorna not_found (MatchedCount == 0)
+func (ar *AuctionRepository) SetAuctionFeatured(ctx context.Context, auctionId string, featured bool) *internal_error.InternalError {
+	filter := bson.M{"_id": auctionId}
+	update := bson.M{"$set": bson.M{"featured": featured, "updated_at": ar.clock.Now().Unix()}}
+
+	defer mongodb.TrackQuery("SetAuctionFeatured", auctionId)()
+	result, err := ar.Collection.UpdateOne(ctx, filter, update)
+	if err != nil {
+		logger.Error(fmt.Sprintf("error trying to update featured flag of auction %s", auctionId), err)
+		return internal_error.NewInternalServerError(fmt.Sprintf("error trying to update featured flag of auction %s", auctionId))
+	}
+
+	if result.MatchedCount == 0 {
+		return internal_error.NewNotFoundError(fmt.Sprintf("auction %s not found", auctionId))
+	}
+	return nil
+}
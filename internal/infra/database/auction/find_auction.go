@@ -5,45 +5,115 @@ import (
 	"fmt"
 	"time"
 
+	"github.com/Guilherme-G-Cadilhe/Go-AuctionHouse_Concurrency-Server-Api/configuration/database/mongodb"
 	"github.com/Guilherme-G-Cadilhe/Go-AuctionHouse_Concurrency-Server-Api/configuration/logger"
 	"github.com/Guilherme-G-Cadilhe/Go-AuctionHouse_Concurrency-Server-Api/internal/entity/auction_entity"
 	"github.com/Guilherme-G-Cadilhe/Go-AuctionHouse_Concurrency-Server-Api/internal/internal_error"
 	"go.mongodb.org/mongo-driver/bson"
 	"go.mongodb.org/mongo-driver/bson/primitive" // Para regex e outras operações BSON
+	"go.mongodb.org/mongo-driver/mongo/options"
 )
 
+// endTime retorna o fim efetivo do leilão
+// Documentos antigos (sem end_time) usam o cálculo original: criação + AUCTION_INTERVAL
+func (am *AuctionEntityMongo) endTime(auctionInterval time.Duration) time.Time {
+	if am.EndTime == 0 {
+		return time.Unix(am.Timestamp, 0).Add(auctionInterval)
+	}
+	return time.Unix(am.EndTime, 0)
+}
+
+// toEntity converte o modelo MongoDB de volta para a entidade de domínio
+func (am *AuctionEntityMongo) toEntity(auctionInterval time.Duration) auction_entity.Auction {
+	return auction_entity.Auction{
+		Id:             am.Id,
+		ProductName:    am.ProductName,
+		Category:       am.Category,
+		Description:    am.Description,
+		Condition:      am.Condition,
+		Status:         am.Status,
+		OwnerId:        am.OwnerId,
+		Sealed:         am.Sealed,
+		Featured:       am.Featured,
+		AllowGuestBids: am.AllowGuestBids,
+		// time.Unix() converte int64 Unix timestamp de volta para time.Time
+		Timestamp:      time.Unix(am.Timestamp, 0),
+		UpdatedAt:      am.updatedAt(),
+		EndTime:        am.endTime(auctionInterval),
+		ExtensionCount: am.ExtensionCount,
+		WinningBidId:   am.WinningBidId,
+		WinningAmount:  am.WinningAmount,
+		CloseReason:    am.CloseReason,
+		Type:           am.Type,
+		Dutch: auction_entity.DutchSchedule{
+			StartPrice:        am.StartPrice,
+			FloorPrice:        am.FloorPrice,
+			PriceDecrement:    am.PriceDecrement,
+			DecrementInterval: am.DecrementInterval,
+		},
+		CurrentPrice:      am.CurrentPrice,
+		IncrementSchedule: am.incrementSchedule(),
+	}
+}
+
+// incrementSchedule converte a tabela de incrementos gravada (nil = leilão sem tabela)
+func (am *AuctionEntityMongo) incrementSchedule() auction_entity.IncrementSchedule {
+	if len(am.IncrementSchedule) == 0 {
+		return nil
+	}
+	schedule := make(auction_entity.IncrementSchedule, 0, len(am.IncrementSchedule))
+	for _, tier := range am.IncrementSchedule {
+		schedule = append(schedule, auction_entity.IncrementTier{Threshold: tier.Threshold, Increment: tier.Increment})
+	}
+	return schedule
+}
+
+// updatedAt usa a criação quando o documento é anterior ao campo updated_at - sem migração
+func (am *AuctionEntityMongo) updatedAt() time.Time {
+	if am.UpdatedAt == 0 {
+		return time.Unix(am.Timestamp, 0)
+	}
+	return time.Unix(am.UpdatedAt, 0)
+}
+
 // FindAuctionById busca um leilão específico por ID
 func (ar *AuctionRepository) FindAuctionById(ctx context.Context, id string) (*auction_entity.Auction, *internal_error.InternalError) {
 	// Cria instância vazia para receber os dados do MongoDB
 	auctionEntityMongo := &AuctionEntityMongo{}
 
 	// Busca documento por "_id" e decodifica para a struct
+	defer mongodb.TrackQuery("FindAuctionById", id)()
 	err := ar.Collection.FindOne(ctx, bson.M{"_id": id}).Decode(auctionEntityMongo)
 	if err != nil {
 		logger.Error(fmt.Sprintf("error trying to find auction by id %s", id), err)
-		return nil, internal_error.NewNotFoundError(fmt.Sprintf("error trying to find auction by id %s", id))
+		return nil, mongodb.ClassifyMongoError(err,
+			fmt.Sprintf("error trying to find auction by id %s", id),
+			fmt.Sprintf("error trying to find auction by id %s", id))
 	}
 
 	// CONVERSÃO: Modelo de persistência -> Entidade de domínio
-	auction := &auction_entity.Auction{
-		Id:          auctionEntityMongo.Id,
-		ProductName: auctionEntityMongo.ProductName,
-		Category:    auctionEntityMongo.Category,
-		Description: auctionEntityMongo.Description,
-		Condition:   auctionEntityMongo.Condition,
-		Status:      auctionEntityMongo.Status,
-		// time.Unix() converte int64 Unix timestamp de volta para time.Time
-		Timestamp: time.Unix(auctionEntityMongo.Timestamp, 0),
-	}
+	auction := auctionEntityMongo.toEntity(ar.auctionInterval)
+
+	return &auction, nil
+}
 
-	return auction, nil
+// auctionSummaryProjection são os campos lidos pela listagem resumida
+// Além de nome e status, o preço atual do holandês depende do tipo, da tabela de preços e da criação
+var auctionSummaryProjection = bson.D{
+	{Key: "product_name", Value: 1},
+	{Key: "status", Value: 1},
+	{Key: "timestamp", Value: 1},
+	{Key: "type", Value: 1},
+	{Key: "start_price", Value: 1},
+	{Key: "floor_price", Value: 1},
+	{Key: "price_decrement", Value: 1},
+	{Key: "decrement_interval", Value: 1},
 }
 
 // FindAllAuctions busca múltiplos leilões com filtros opcionais
 func (ar *AuctionRepository) FindAllAuctions(
 	ctx context.Context,
-	status auction_entity.AuctionStatus,
-	category, productName string) ([]auction_entity.Auction, *internal_error.InternalError) {
+	listFilter auction_entity.AuctionListFilter) ([]auction_entity.Auction, *internal_error.InternalError) {
 
 	// bson.M{} é um Map vazio que será populado com filtros
 	// É equivalente a um objeto JavaScript: {}
@@ -51,35 +121,58 @@ func (ar *AuctionRepository) FindAllAuctions(
 
 	// FILTROS CONDICIONAIS - só adiciona se valor não for vazio/zero
 
-	// Se status não for zero (Active = 0), adiciona filtro por status
-	// Em Go, zero values: int = 0, string = "", bool = false, etc.
-	if status != 0 {
-		filter["status"] = status
+	// Status é ponteiro: Active é o zero value (0), então só nil significa "sem filtro"
+	// Em Go, zero values: int = 0, string = "", bool = false, ponteiro = nil
+	if listFilter.Status != nil {
+		filter["status"] = *listFilter.Status
 	}
 
 	// Se categoria não estiver vazia, adiciona filtro exato
-	if category != "" {
-		filter["category"] = category
+	if listFilter.Category != "" {
+		filter["category"] = listFilter.Category
 	}
 
 	// Se productName não estiver vazio, adiciona filtro com REGEX (case-insensitive)
-	if productName != "" {
+	if listFilter.ProductName != "" {
 		filter["product_name"] = primitive.Regex{
-			Pattern: productName, // Padrão de busca
-			Options: "i",         // "i" = case insensitive (MongoDB)
+			Pattern: listFilter.ProductName, // Padrão de busca
+			Options: "i",                    // "i" = case insensitive (MongoDB)
 		}
 	}
 
+	// Documentos sem o campo (omitempty) não são destaque - o filtro exato já os exclui
+	if listFilter.FeaturedOnly {
+		filter["featured"] = true
+	}
+
+	// Sem FeaturedFirst a ordem continua a natural do MongoDB (comportamento histórico)
+	// Com FeaturedFirst: destaque primeiro e, dentro de cada grupo, ordem de criação
+	// "_id" desempata leilões criados no mesmo segundo (timestamp é Unix em segundos)
+	findOptions := options.Find()
+	if listFilter.FeaturedFirst {
+		findOptions.SetSort(bson.D{{Key: "featured", Value: -1}, {Key: "timestamp", Value: 1}, {Key: "_id", Value: 1}})
+	}
+	// Limit é aplicado pelo servidor: o MongoDB para de ler ao atingir o limite
+	if listFilter.Limit > 0 {
+		findOptions.SetLimit(int64(listFilter.Limit))
+	}
+	// Projeção: o MongoDB só devolve os campos pedidos (como o select do SQL); "_id" vem sempre
+	// A ordenação acima roda no servidor e não depende dos campos projetados
+	if listFilter.SummaryOnly {
+		findOptions.SetProjection(auctionSummaryProjection)
+	}
+
 	// Slice vazio para receber os documentos do MongoDB
 	// var slice []Type cria slice vazio (similar ao [] no JavaScript)
 	var auctions []AuctionEntityMongo
 
 	// Find() retorna um CURSOR (não os dados diretamente)
 	// Cursor é como um iterator - permite processar grandes volumes de dados
-	cursor, err := ar.Collection.Find(ctx, filter)
+	defer mongodb.TrackQuery("FindAllAuctions", filter)()
+	cursor, err := ar.Collection.Find(ctx, filter, findOptions)
 	if err != nil {
 		logger.Error("error trying to find auctions", err)
-		return nil, internal_error.NewInternalServerError("error trying to find auctions")
+		return nil, mongodb.ClassifyMongoError(err, "", "error trying to find auctions")
 	}
 
 	// defer garante que cursor.Close() seja executado ao final da função
@@ -88,9 +181,10 @@ func (ar *AuctionRepository) FindAllAuctions(
 
 	// cursor.All() lê TODOS os documentos do cursor de uma vez
 	// &auctions passa o endereço do slice para ser preenchido
+	// O ctx vale para cada getMore: request cancelada ou REQUEST_TIMEOUT vencido interrompe a leitura (499/504)
 	if err = cursor.All(ctx, &auctions); err != nil {
 		logger.Error("error trying to decode auctions", err)
-		return nil, internal_error.NewInternalServerError("error trying to decode auctions")
+		return nil, mongodb.ClassifyMongoError(err, "", "error trying to decode auctions")
 	}
 
 	// CONVERSÃO: Slice de modelos MongoDB -> Slice de entidades de domínio
@@ -100,20 +194,38 @@ func (ar *AuctionRepository) FindAllAuctions(
 	// "_" ignora o índice, auction é o valor atual
 	for _, auction := range auctions {
 		// append() adiciona elemento ao slice (como push() no JavaScript)
-		auctionsEntities = append(auctionsEntities, auction_entity.Auction{
-			Id:          auction.Id,
-			ProductName: auction.ProductName,
-			Category:    auction.Category,
-			Description: auction.Description,
-			Condition:   auction.Condition,
-			Status:      auction.Status,
-			Timestamp:   time.Unix(auction.Timestamp, 0), // Unix -> time.Time
-		})
+		auctionsEntities = append(auctionsEntities, auction.toEntity(ar.auctionInterval))
 	}
 
 	return auctionsEntities, nil
 }
 
+// FindAuctionsByIds busca todos os leilões cujo "_id" está na lista em UMA query ($in)
+// Ids sem leilão simplesmente não aparecem no resultado (não é erro)
+func (ar *AuctionRepository) FindAuctionsByIds(ctx context.Context, ids []string) ([]auction_entity.Auction, *internal_error.InternalError) {
+	filter := bson.M{"_id": bson.M{"$in": ids}}
+
+	defer mongodb.TrackQuery("FindAuctionsByIds", filter)()
+	cursor, err := ar.Collection.Find(ctx, filter)
+	if err != nil {
+		logger.Error("error trying to find auctions by ids", err)
+		return nil, mongodb.ClassifyMongoError(err, "", "error trying to find auctions by ids")
+	}
+	defer cursor.Close(ctx)
+
+	var auctions []AuctionEntityMongo
+	if err := cursor.All(ctx, &auctions); err != nil {
+		logger.Error("error trying to decode auctions by ids", err)
+		return nil, mongodb.ClassifyMongoError(err, "", "error trying to find auctions by ids")
+	}
+
+	auctionEntities := make([]auction_entity.Auction, len(auctions))
+	for i, auction := range auctions {
+		auctionEntities[i] = auction.toEntity(ar.auctionInterval)
+	}
+	return auctionEntities, nil
+}
+
 /*
 CONCEITOS IMPORTANTES:
 
@@ -0,0 +1,149 @@
+package auction
+
+import (
+	"context"
+	"time"
+
+	"github.com/Guilherme-G-Cadilhe/Go-AuctionHouse_Concurrency-Server-Api/configuration/database/mongodb"
+	"github.com/Guilherme-G-Cadilhe/Go-AuctionHouse_Concurrency-Server-Api/configuration/logger"
+	"github.com/Guilherme-G-Cadilhe/Go-AuctionHouse_Concurrency-Server-Api/internal/entity/auction_entity"
+	"github.com/Guilherme-G-Cadilhe/Go-AuctionHouse_Concurrency-Server-Api/internal/entity/bid_entity"
+	"github.com/Guilherme-G-Cadilhe/Go-AuctionHouse_Concurrency-Server-Api/internal/internal_error"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+// winningBidMongo é o lance trazido pelo $lookup (mesmos campos de bid.BidEntityMongo)
+// O pacote bid importa este pacote, então o modelo é redeclarado aqui para evitar ciclo
+type winningBidMongo struct {
+	Id          string  `bson:"_id"`
+	UserId      string  `bson:"user_id"`
+	Handle      string  `bson:"handle,omitempty"`
+	AuctionId   string  `bson:"auction_id"`
+	Amount      float64 `bson:"amount,omitempty"`
+	AmountCents int64   `bson:"amount_cents,omitempty"`
+	Timestamp   int64   `bson:"timestamp"`
+	Sequence    int64   `bson:"sequence,omitempty"`
+}
+
+// auctionWinnerMongo é o leilão com o array do $lookup (0 ou 1 lance)
+type auctionWinnerMongo struct {
+	AuctionEntityMongo `bson:",inline"`
+	WinningBids        []winningBidMongo `bson:"winning_bid"`
+}
+
+// auctionWinnersFacet recebe as duas saídas do $facet: total e página
+type auctionWinnersFacet struct {
+	Total []struct {
+		Count int64 `bson:"count"`
+	} `bson:"total"`
+	Items []auctionWinnerMongo `bson:"items"`
+}
+
+// FindAuctionWinners monta o relatório de vencedores em UMA agregação (sem N+1 queries):
+// $match filtra os leilões -> $sort -> $facet { total: $count, items: $skip/$limit + $lookup do maior lance }
+// O $lookup roda só para os leilões da página, não para todos os filtrados
+func (ar *AuctionRepository) FindAuctionWinners(ctx context.Context, filter auction_entity.AuctionWinnersFilter) ([]auction_entity.AuctionWinner, int64, *internal_error.InternalError) {
+	match := bson.M{"status": auction_entity.Completed}
+	if filter.Category != "" {
+		match["category"] = filter.Category
+	}
+	endTimeRange := bson.M{}
+	if !filter.EndedFrom.IsZero() {
+		endTimeRange["$gte"] = filter.EndedFrom.Unix()
+	}
+	if !filter.EndedTo.IsZero() {
+		endTimeRange["$lte"] = filter.EndedTo.Unix()
+	}
+	if len(endTimeRange) > 0 {
+		match["end_time"] = endTimeRange
+	}
+
+	pipeline := mongo.Pipeline{
+		{{Key: "$match", Value: match}},
+		// Mais recentes primeiro; _id desempata para a paginação ser estável
+		{{Key: "$sort", Value: bson.D{{Key: "end_time", Value: -1}, {Key: "_id", Value: 1}}}},
+		{{Key: "$facet", Value: bson.M{
+			"total": bson.A{bson.M{"$count": "count"}},
+			"items": bson.A{
+				bson.M{"$skip": filter.Offset},
+				bson.M{"$limit": filter.Limit},
+				bson.M{"$lookup": winningBidLookup()},
+			},
+		}}},
+	}
+
+	defer mongodb.TrackQuery("FindAuctionWinners", match)()
+	cursor, err := ar.Collection.Aggregate(ctx, pipeline)
+	if err != nil {
+		logger.Error("error trying to aggregate auction winners", err)
+		return nil, 0, mongodb.ClassifyMongoError(err, "", "error trying to find auction winners")
+	}
+	defer cursor.Close(ctx)
+
+	// $facet sempre devolve exatamente um documento
+	var facets []auctionWinnersFacet
+	if err := cursor.All(ctx, &facets); err != nil {
+		logger.Error("error trying to decode auction winners", err)
+		return nil, 0, mongodb.ClassifyMongoError(err, "", "error trying to find auction winners")
+	}
+	if len(facets) == 0 {
+		return []auction_entity.AuctionWinner{}, 0, nil
+	}
+
+	var total int64
+	if len(facets[0].Total) > 0 {
+		total = facets[0].Total[0].Count
+	}
+
+	winners := make([]auction_entity.AuctionWinner, len(facets[0].Items))
+	for i, item := range facets[0].Items {
+		winners[i] = auction_entity.AuctionWinner{
+			Auction: item.toEntity(ar.auctionInterval),
+		}
+		if len(item.WinningBids) > 0 {
+			winners[i].WinningBid = item.WinningBids[0].toEntity()
+		}
+	}
+
+	return winners, total, nil
+}
+
+// winningBidLookup traz o maior lance de cada leilão no array "winning_bid" (0 ou 1 lance)
+// Mesmo critério do FindWinningBidByAuctionId: maior valor no campo do AMOUNT_MODE atual,
+// empate resolvido pela ordem de submissão (sequence) e, em lances antigos, pelo timestamp
+func winningBidLookup() bson.M {
+	amountField := "amount"
+	if bid_entity.GetAmountMode() == bid_entity.AmountModeCents {
+		amountField = "amount_cents"
+	}
+
+	return bson.M{
+		"from": "bids",
+		"let":  bson.M{"auctionId": "$_id"},
+		"pipeline": bson.A{
+			bson.M{"$match": bson.M{"$expr": bson.M{"$eq": bson.A{"$auction_id", "$$auctionId"}}}},
+			bson.M{"$sort": bson.D{{Key: amountField, Value: -1}, {Key: "sequence", Value: 1}, {Key: "timestamp", Value: 1}}},
+			bson.M{"$limit": 1},
+		},
+		"as": "winning_bid",
+	}
+}
+
+// toEntity respeita o AMOUNT_MODE, como bid.BidEntityMongo.toEntity
+func (wb *winningBidMongo) toEntity() *bid_entity.Bid {
+	amount := wb.Amount
+	if bid_entity.GetAmountMode() == bid_entity.AmountModeCents {
+		amount = float64(wb.AmountCents)
+	}
+
+	return &bid_entity.Bid{
+		Id:        wb.Id,
+		UserId:    wb.UserId,
+		Handle:    wb.Handle,
+		AuctionId: wb.AuctionId,
+		Amount:    amount,
+		Timestamp: time.Unix(wb.Timestamp, 0),
+		Sequence:  wb.Sequence,
+	}
+}
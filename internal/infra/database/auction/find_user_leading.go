@@ -0,0 +1,69 @@
+package auction
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Guilherme-G-Cadilhe/Go-AuctionHouse_Concurrency-Server-Api/configuration/database/mongodb"
+	"github.com/Guilherme-G-Cadilhe/Go-AuctionHouse_Concurrency-Server-Api/configuration/logger"
+	"github.com/Guilherme-G-Cadilhe/Go-AuctionHouse_Concurrency-Server-Api/internal/entity/auction_entity"
+	"github.com/Guilherme-G-Cadilhe/Go-AuctionHouse_Concurrency-Server-Api/internal/internal_error"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+// FindAuctionsLeadByUser lista os leilões ativos em que o usuário tem o maior lance agora
+// 1. distinct nos lances do usuário: só os leilões em que ele deu lance entram na agregação
+// 2. $match ativos e não sealed -> $lookup do maior lance -> $match do autor -> ordena e pagina
+// Sealed-bid fica de fora: saber que lidera revelaria que os outros lances são menores
+func (ar *AuctionRepository) FindAuctionsLeadByUser(ctx context.Context, filter auction_entity.UserLeadingFilter) ([]auction_entity.AuctionWinner, *internal_error.InternalError) {
+	bidFilter := bson.M{"user_id": filter.UserId}
+	stopTracking := mongodb.TrackQuery("DistinctAuctionIdsByUserId", bidFilter)
+	auctionIds, err := ar.Collection.Database().Collection("bids").Distinct(ctx, "auction_id", bidFilter)
+	stopTracking()
+	if err != nil {
+		logger.Error(fmt.Sprintf("error trying to find auctions bid by user %s", filter.UserId), err)
+		return nil, mongodb.ClassifyMongoError(err, "", "error trying to find auctions lead by user")
+	}
+	if len(auctionIds) == 0 {
+		return []auction_entity.AuctionWinner{}, nil
+	}
+
+	match := bson.M{
+		"_id":    bson.M{"$in": auctionIds},
+		"status": auction_entity.Active,
+		"sealed": bson.M{"$ne": true},
+	}
+	pipeline := mongo.Pipeline{
+		{{Key: "$match", Value: match}},
+		{{Key: "$lookup", Value: winningBidLookup()}},
+		{{Key: "$match", Value: bson.M{"winning_bid.0.user_id": filter.UserId}}},
+		// Os que terminam primeiro no topo - são os que pedem atenção no dashboard
+		{{Key: "$sort", Value: bson.D{{Key: "end_time", Value: 1}, {Key: "_id", Value: 1}}}},
+		{{Key: "$skip", Value: filter.Offset}},
+		{{Key: "$limit", Value: filter.Limit}},
+	}
+
+	defer mongodb.TrackQuery("FindAuctionsLeadByUser", match)()
+	cursor, err := ar.Collection.Aggregate(ctx, pipeline)
+	if err != nil {
+		logger.Error(fmt.Sprintf("error trying to aggregate auctions lead by user %s", filter.UserId), err)
+		return nil, mongodb.ClassifyMongoError(err, "", "error trying to find auctions lead by user")
+	}
+	defer cursor.Close(ctx)
+
+	var items []auctionWinnerMongo
+	if err := cursor.All(ctx, &items); err != nil {
+		logger.Error(fmt.Sprintf("error trying to decode auctions lead by user %s", filter.UserId), err)
+		return nil, mongodb.ClassifyMongoError(err, "", "error trying to find auctions lead by user")
+	}
+
+	leading := make([]auction_entity.AuctionWinner, len(items))
+	for i, item := range items {
+		leading[i] = auction_entity.AuctionWinner{
+			Auction:    item.toEntity(ar.auctionInterval),
+			WinningBid: item.WinningBids[0].toEntity(),
+		}
+	}
+	return leading, nil
+}
@@ -0,0 +1,13 @@
+package auction
+
+import (
+	"context"
+
+	"github.com/Guilherme-G-Cadilhe/Go-AuctionHouse_Concurrency-Server-Api/configuration/database/mongodb"
+	"github.com/Guilherme-G-Cadilhe/Go-AuctionHouse_Concurrency-Server-Api/internal/internal_error"
+)
+
+// Ping verifica se o MongoDB da coleção "auctions" está acessível (usado pelo /health)
+func (ar *AuctionRepository) Ping(ctx context.Context) *internal_error.InternalError {
+	return mongodb.Ping(ctx, ar.Collection)
+}
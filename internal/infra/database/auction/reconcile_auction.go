@@ -0,0 +1,57 @@
+package auction
+
+import (
+	"context"
+
+	"github.com/Guilherme-G-Cadilhe/Go-AuctionHouse_Concurrency-Server-Api/configuration/database/mongodb"
+	"github.com/Guilherme-G-Cadilhe/Go-AuctionHouse_Concurrency-Server-Api/configuration/logger"
+	"github.com/Guilherme-G-Cadilhe/Go-AuctionHouse_Concurrency-Server-Api/internal/entity/auction_entity"
+	"github.com/Guilherme-G-Cadilhe/Go-AuctionHouse_Concurrency-Server-Api/internal/internal_error"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo/options"
+	"go.uber.org/zap"
+)
+
+// ReconcileOverdueAuctions fecha leilões ainda Active cujo deadline (fim + AUCTION_CLOSE_SKEW) já passou
+// É a rede de segurança do fechamento por timer: um timer perdido (crash entre o fim e o fechamento,
+// falha no UpdateOne do callback) deixaria o leilão aberto para sempre
+// Usa o mesmo closeAuction do timer - a transição condicional Active -> Completed garante que
+// timer e reconciliação concorrendo fecham (e notificam) o leilão uma única vez
+// Retorna quantos leilões esta execução fechou
+func (ar *AuctionRepository) ReconcileOverdueAuctions(ctx context.Context) (int, *internal_error.InternalError) {
+	// end_time é gravado em segundos - "<=" segue o IsOpenAt: no instante do deadline o leilão já está fechado
+	cutoff := ar.clock.Now().Add(-ar.closeSkew).Unix()
+	filter := bson.M{"status": auction_entity.Active, "end_time": bson.M{"$lte": cutoff}}
+	// Só os ids trafegam - o fechamento relê o que precisa
+	findOptions := options.Find().SetProjection(bson.M{"_id": 1})
+
+	stopTracking := mongodb.TrackQuery("ReconcileOverdueAuctions", filter)
+	cursor, err := ar.Collection.Find(ctx, filter, findOptions)
+	stopTracking()
+	if err != nil {
+		logger.Error("error trying to find overdue auctions", err)
+		return 0, mongodb.ClassifyMongoError(err, "", "error trying to find overdue auctions")
+	}
+	defer cursor.Close(ctx)
+
+	var overdue []struct {
+		Id string `bson:"_id"`
+	}
+	if err := cursor.All(ctx, &overdue); err != nil {
+		logger.Error("error trying to decode overdue auctions", err)
+		return 0, mongodb.ClassifyMongoError(err, "", "error trying to find overdue auctions")
+	}
+
+	reconciled := 0
+	for _, auction := range overdue {
+		if !ar.closeAuction(ctx, auction.Id) {
+			continue // Outro fechamento venceu a corrida (ou o UpdateOne falhou e já foi logado)
+		}
+		// O timer perdido (se ainda existir) não tem mais o que fazer
+		ar.stopAuctionTimers(auction.Id)
+		reconciled++
+		logger.Info("auction reconciled: overdue active auction closed",
+			zap.String("auction_id", auction.Id))
+	}
+	return reconciled, nil
+}
@@ -0,0 +1,41 @@
+package auction
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/Guilherme-G-Cadilhe/Go-AuctionHouse_Concurrency-Server-Api/configuration/database/mongodb"
+	"github.com/Guilherme-G-Cadilhe/Go-AuctionHouse_Concurrency-Server-Api/configuration/logger"
+	"github.com/Guilherme-G-Cadilhe/Go-AuctionHouse_Concurrency-Server-Api/internal/entity/auction_entity"
+	"github.com/Guilherme-G-Cadilhe/Go-AuctionHouse_Concurrency-Server-Api/internal/internal_error"
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+// ReopenAuction volta um leilão Completed para Active com um novo fim efetivo
+// O filtro por status Completed evita reabrir um leilão ativo (ou reabrir duas vezes em paralelo)
+func (ar *AuctionRepository) ReopenAuction(ctx context.Context, auctionId string, endTime time.Time) *internal_error.InternalError {
+	filter := bson.M{"_id": auctionId, "status": auction_entity.Completed}
+	// O vencedor e o motivo gravados no fechamento deixam de valer - novos lances podem superá-lo
+	// A reabertura é uma nova rodada: o limite de extensões recomeça
+	update := bson.M{
+		"$set":   bson.M{"status": auction_entity.Active, "end_time": endTime.Unix(), "updated_at": ar.clock.Now().Unix()},
+		"$unset": bson.M{"winning_bid_id": "", "winning_amount": "", "close_reason": "", "extension_count": ""},
+	}
+
+	stopTracking := mongodb.TrackQuery("ReopenAuction", filter)
+	result, err := ar.Collection.UpdateOne(ctx, filter, update)
+	stopTracking()
+	if err != nil {
+		logger.Error(fmt.Sprintf("error trying to reopen auction %s", auctionId), err)
+		return internal_error.NewInternalServerError(fmt.Sprintf("error trying to reopen auction %s", auctionId))
+	}
+
+	if result.MatchedCount == 0 {
+		return internal_error.NewConflictError(fmt.Sprintf("auction %s is not closed", auctionId))
+	}
+
+	ar.CreateAuctionEvent(ctx, auction_entity.NewAuctionEvent(auctionId, auction_entity.AuctionReopenedEvent, ar.clock.Now()))
+	ar.scheduleAuctionClose(auctionId, endTime)
+	return nil
+}
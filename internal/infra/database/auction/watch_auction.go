@@ -0,0 +1,79 @@
+package auction
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/Guilherme-G-Cadilhe/Go-AuctionHouse_Concurrency-Server-Api/configuration/database/mongodb"
+	"github.com/Guilherme-G-Cadilhe/Go-AuctionHouse_Concurrency-Server-Api/configuration/logger"
+	"github.com/Guilherme-G-Cadilhe/Go-AuctionHouse_Concurrency-Server-Api/internal/internal_error"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// Coleção "auction_watchers": um documento por leilão {_id: auctionId, user_ids: [...]}
+// Fica fora do documento do leilão para as listagens não carregarem a lista de observadores
+// $addToSet/$pull são atômicos e idempotentes: observar duas vezes não conta em dobro
+
+// watcherCountProjection devolve só o tamanho da lista - os ids não trafegam do MongoDB
+var watcherCountProjection = bson.M{"count": bson.M{"$size": bson.M{"$ifNull": bson.A{"$user_ids", bson.A{}}}}}
+
+type watcherCountMongo struct {
+	Count int64 `bson:"count"`
+}
+
+// AddAuctionWatcher inclui o usuário nos observadores (upsert cria o documento do leilão no primeiro)
+func (ar *AuctionRepository) AddAuctionWatcher(ctx context.Context, auctionId, userId string) (int64, *internal_error.InternalError) {
+	filter := bson.M{"_id": auctionId}
+	update := bson.M{"$addToSet": bson.M{"user_ids": userId}}
+	opts := options.FindOneAndUpdate().
+		SetUpsert(true).
+		SetReturnDocument(options.After).
+		SetProjection(watcherCountProjection)
+
+	defer mongodb.TrackQuery("AddAuctionWatcher", auctionId)()
+	var result watcherCountMongo
+	if err := ar.WatchersCollection.FindOneAndUpdate(ctx, filter, update, opts).Decode(&result); err != nil {
+		logger.Error(fmt.Sprintf("error trying to add watcher to auction %s", auctionId), err)
+		return 0, internal_error.NewInternalServerError(fmt.Sprintf("error trying to add watcher to auction %s", auctionId))
+	}
+	return result.Count, nil
+}
+
+// RemoveAuctionWatcher retira o usuário dos observadores; leilão sem documento = 0 observadores
+func (ar *AuctionRepository) RemoveAuctionWatcher(ctx context.Context, auctionId, userId string) (int64, *internal_error.InternalError) {
+	filter := bson.M{"_id": auctionId}
+	update := bson.M{"$pull": bson.M{"user_ids": userId}}
+	opts := options.FindOneAndUpdate().
+		SetReturnDocument(options.After).
+		SetProjection(watcherCountProjection)
+
+	defer mongodb.TrackQuery("RemoveAuctionWatcher", auctionId)()
+	var result watcherCountMongo
+	if err := ar.WatchersCollection.FindOneAndUpdate(ctx, filter, update, opts).Decode(&result); err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return 0, nil
+		}
+		logger.Error(fmt.Sprintf("error trying to remove watcher from auction %s", auctionId), err)
+		return 0, internal_error.NewInternalServerError(fmt.Sprintf("error trying to remove watcher from auction %s", auctionId))
+	}
+	return result.Count, nil
+}
+
+// CountAuctionWatchers conta os observadores do leilão
+func (ar *AuctionRepository) CountAuctionWatchers(ctx context.Context, auctionId string) (int64, *internal_error.InternalError) {
+	opts := options.FindOne().SetProjection(watcherCountProjection)
+
+	defer mongodb.TrackQuery("CountAuctionWatchers", auctionId)()
+	var result watcherCountMongo
+	if err := ar.WatchersCollection.FindOne(ctx, bson.M{"_id": auctionId}, opts).Decode(&result); err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return 0, nil
+		}
+		logger.Error(fmt.Sprintf("error trying to count watchers of auction %s", auctionId), err)
+		return 0, internal_error.NewInternalServerError(fmt.Sprintf("error trying to count watchers of auction %s", auctionId))
+	}
+	return result.Count, nil
+}
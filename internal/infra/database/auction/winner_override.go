@@ -0,0 +1,78 @@
+package auction
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Guilherme-G-Cadilhe/Go-AuctionHouse_Concurrency-Server-Api/configuration/database/mongodb"
+	"github.com/Guilherme-G-Cadilhe/Go-AuctionHouse_Concurrency-Server-Api/configuration/logger"
+	"github.com/Guilherme-G-Cadilhe/Go-AuctionHouse_Concurrency-Server-Api/internal/entity/auction_entity"
+	"github.com/Guilherme-G-Cadilhe/Go-AuctionHouse_Concurrency-Server-Api/internal/entity/bid_entity"
+	"github.com/Guilherme-G-Cadilhe/Go-AuctionHouse_Concurrency-Server-Api/internal/internal_error"
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+// SetAuctionWinner grava o lance como vencedor de um leilão fechado (override de administrador)
+// Usa os mesmos campos do vencedor gravado no fechamento - FindWinningBidByAuctionId passa a ler este lance
+// O filtro por status Completed impede o override em leilão ativo, onde o fechamento o sobrescreveria
+func (ar *AuctionRepository) SetAuctionWinner(ctx context.Context, auctionId string, bid bid_entity.Bid) *internal_error.InternalError {
+	filter := bson.M{"_id": auctionId, "status": auction_entity.Completed}
+	update := bson.M{"$set": bson.M{
+		"winning_bid_id": bid.Id,
+		"winning_amount": bid.Amount,
+		"close_reason":   auction_entity.CloseReasonSold,
+		"updated_at":     ar.clock.Now().Unix(),
+	}}
+
+	stopTracking := mongodb.TrackQuery("SetAuctionWinner", filter)
+	result, err := ar.Collection.UpdateOne(ctx, filter, update)
+	stopTracking()
+	if err != nil {
+		logger.Error(fmt.Sprintf("error trying to set winner of auction %s", auctionId), err)
+		return internal_error.NewInternalServerError(fmt.Sprintf("error trying to set winner of auction %s", auctionId))
+	}
+
+	if result.MatchedCount == 0 {
+		return internal_error.NewConflictError(fmt.Sprintf("auction %s is not closed", auctionId))
+	}
+
+	ar.CreateAuctionEvent(ctx, auction_entity.NewAuctionEvent(auctionId, auction_entity.AuctionWinnerOverriddenEvent, ar.clock.Now()))
+	return nil
+}
+
+// UpdateAuctionWinner grava o vencedor recalculado pela reconciliação
+// Com lance: mesmos campos do fechamento (close_reason sold); sem lance: remove o vencedor e grava no_bids
+// O evento winner_recomputed deixa a correção visível no histórico, como o winner_overridden
+func (ar *AuctionRepository) UpdateAuctionWinner(ctx context.Context, auctionId string, winningBid *bid_entity.Bid) *internal_error.InternalError {
+	filter := bson.M{"_id": auctionId, "status": auction_entity.Completed}
+	update := bson.M{
+		"$set": bson.M{
+			"close_reason": auction_entity.CloseReasonNoBids,
+			"updated_at":   ar.clock.Now().Unix(),
+		},
+		"$unset": bson.M{"winning_bid_id": "", "winning_amount": ""},
+	}
+	if winningBid != nil {
+		update = bson.M{"$set": bson.M{
+			"winning_bid_id": winningBid.Id,
+			"winning_amount": winningBid.Amount,
+			"close_reason":   auction_entity.CloseReasonSold,
+			"updated_at":     ar.clock.Now().Unix(),
+		}}
+	}
+
+	stopTracking := mongodb.TrackQuery("UpdateAuctionWinner", filter)
+	result, err := ar.Collection.UpdateOne(ctx, filter, update)
+	stopTracking()
+	if err != nil {
+		logger.Error(fmt.Sprintf("error trying to update winner of auction %s", auctionId), err)
+		return internal_error.NewInternalServerError(fmt.Sprintf("error trying to update winner of auction %s", auctionId))
+	}
+
+	if result.MatchedCount == 0 {
+		return internal_error.NewConflictError(fmt.Sprintf("auction %s is not closed", auctionId))
+	}
+
+	ar.CreateAuctionEvent(ctx, auction_entity.NewAuctionEvent(auctionId, auction_entity.AuctionWinnerRecomputedEvent, ar.clock.Now()))
+	return nil
+}
@@ -0,0 +1,27 @@
+package bid
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Guilherme-G-Cadilhe/Go-AuctionHouse_Concurrency-Server-Api/configuration/database/mongodb"
+	"github.com/Guilherme-G-Cadilhe/Go-AuctionHouse_Concurrency-Server-Api/configuration/logger"
+	"github.com/Guilherme-G-Cadilhe/Go-AuctionHouse_Concurrency-Server-Api/internal/entity/bid_entity"
+	"github.com/Guilherme-G-Cadilhe/Go-AuctionHouse_Concurrency-Server-Api/internal/internal_error"
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+// AnonymizeBidsByUserId troca o user_id de todos os lances do usuário pelo tombstone "deleted"
+// Valores e horários dos lances continuam intactos - vencedores e histórico não mudam
+func (bd *BidRepository) AnonymizeBidsByUserId(ctx context.Context, userId string) *internal_error.InternalError {
+	filter := bson.M{"user_id": userId}
+	update := bson.M{"$set": bson.M{"user_id": bid_entity.DeletedUserId}}
+
+	defer mongodb.TrackQuery("AnonymizeBidsByUserId", filter)()
+	if _, err := bd.Collection.UpdateMany(ctx, filter, update); err != nil {
+		logger.Error(fmt.Sprintf("error trying to anonymize bids of user %s", userId), err)
+		return internal_error.NewInternalServerError(fmt.Sprintf("error trying to anonymize bids of user %s", userId))
+	}
+
+	return nil
+}
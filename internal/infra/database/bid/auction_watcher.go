@@ -0,0 +1,113 @@
+package bid
+
+import (
+	"context"
+	"time"
+
+	"github.com/Guilherme-G-Cadilhe/Go-AuctionHouse_Concurrency-Server-Api/configuration/logger"
+	"github.com/Guilherme-G-Cadilhe/Go-AuctionHouse_Concurrency-Server-Api/internal/entity/auction_entity"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+	"go.uber.org/zap"
+)
+
+// auctionChangeEvent é o subconjunto de um evento do change stream que o cache usa
+// No Node.js seria o objeto recebido em collection.watch().on("change", ...)
+type auctionChangeEvent struct {
+	OperationType string `bson:"operationType"`
+	DocumentKey   struct {
+		Id string `bson:"_id"`
+	} `bson:"documentKey"`
+	// FullDocument vem preenchido em insert/replace e, com UpdateLookup, em update
+	// Ponteiro: nil quando o documento já foi removido
+	FullDocument *struct {
+		Status  auction_entity.AuctionStatus `bson:"status"`
+		EndTime int64                        `bson:"end_time"`
+	} `bson:"fullDocument"`
+}
+
+// WatchAuctionChanges mantém os caches de status/fim em sincronia com a coleção "auctions"
+// Abre um change stream (exige replica set) e processa os eventos em uma goroutine
+// Sem suporte a change streams (ex: MongoDB standalone) apenas loga e retorna:
+// o cache continua funcionando como antes, com InvalidateAuctionCache nos fluxos de extensão/reabertura
+func (bd *BidRepository) WatchAuctionChanges(ctx context.Context) {
+	// Só interessam mudanças em leilões existentes - inserts não estão no cache
+	pipeline := mongo.Pipeline{
+		{{Key: "$match", Value: bson.M{
+			"operationType": bson.M{"$in": bson.A{"update", "replace", "delete"}},
+		}}},
+	}
+	opts := options.ChangeStream().SetFullDocument(options.UpdateLookup)
+
+	stream, err := bd.AuctionRepository.Collection.Watch(ctx, pipeline, opts)
+	if err != nil {
+		logger.Info("auction change stream unavailable, using manual cache invalidation", zap.String("reason", err.Error()))
+		return
+	}
+
+	logger.Info("auction change stream started")
+	go bd.consumeAuctionChanges(ctx, stream)
+}
+
+// consumeAuctionChanges aplica cada evento do stream no cache até o contexto acabar ou o stream falhar
+func (bd *BidRepository) consumeAuctionChanges(ctx context.Context, stream *mongo.ChangeStream) {
+	defer stream.Close(context.Background())
+
+	// stream.Next bloqueia até chegar um evento (como um for await...of no Node.js)
+	for stream.Next(ctx) {
+		var event auctionChangeEvent
+		if err := stream.Decode(&event); err != nil {
+			logger.Error("error trying to decode auction change event", err)
+			continue
+		}
+		bd.applyAuctionChange(event)
+	}
+
+	if ctx.Err() != nil {
+		return // Encerramento normal
+	}
+
+	// Eventos podem ter sido perdidos - descarta o cache inteiro para não aceitar lances com dados velhos
+	// A partir daqui vale o comportamento sem change stream (busca no banco a cada cache miss)
+	logger.Error("auction change stream stopped, clearing auction cache", stream.Err())
+	bd.clearAuctionCache()
+}
+
+// applyAuctionChange atualiza (ou remove) a entrada do leilão no cache
+// Só leilões já cacheados são atualizados - o cache continua restrito a leilões que recebem lances
+func (bd *BidRepository) applyAuctionChange(event auctionChangeEvent) {
+	auctionId := event.DocumentKey.Id
+
+	if event.FullDocument == nil || event.FullDocument.EndTime == 0 {
+		// Documento removido ou sem end_time persistido: a próxima validação busca no banco
+		bd.InvalidateAuctionCache(auctionId)
+		return
+	}
+
+	bd.auctionStatusMapMutex.Lock()
+	_, cached := bd.auctionStatusMap[auctionId]
+	if cached {
+		bd.auctionStatusMap[auctionId] = event.FullDocument.Status
+	}
+	bd.auctionStatusMapMutex.Unlock()
+
+	if !cached {
+		return
+	}
+
+	bd.auctionEndTimeMutex.Lock()
+	bd.auctionEndTimeMap[auctionId] = time.Unix(event.FullDocument.EndTime, 0)
+	bd.auctionEndTimeMutex.Unlock()
+}
+
+// clearAuctionCache esvazia os dois caches de leilão
+func (bd *BidRepository) clearAuctionCache() {
+	bd.auctionStatusMapMutex.Lock()
+	bd.auctionStatusMap = make(map[string]auction_entity.AuctionStatus)
+	bd.auctionStatusMapMutex.Unlock()
+
+	bd.auctionEndTimeMutex.Lock()
+	bd.auctionEndTimeMap = make(map[string]time.Time)
+	bd.auctionEndTimeMutex.Unlock()
+}
@@ -0,0 +1,107 @@
+package bid
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Guilherme-G-Cadilhe/Go-AuctionHouse_Concurrency-Server-Api/configuration/database/mongodb"
+	"github.com/Guilherme-G-Cadilhe/Go-AuctionHouse_Concurrency-Server-Api/configuration/logger"
+	"github.com/Guilherme-G-Cadilhe/Go-AuctionHouse_Concurrency-Server-Api/internal/entity/bid_entity"
+	"github.com/Guilherme-G-Cadilhe/Go-AuctionHouse_Concurrency-Server-Api/internal/internal_error"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.uber.org/zap"
+)
+
+// auctionBidCap é o estado em cache do limite de um leilão: lances gravados e maior valor
+type auctionBidCap struct {
+	count     int64
+	topAmount float64
+	hasTopBid bool
+}
+
+// CountBidsByAuctionId conta os lances gravados do leilão direto no banco (sem cache)
+func (bd *BidRepository) CountBidsByAuctionId(ctx context.Context, auctionId string) (int64, *internal_error.InternalError) {
+	filter := bson.M{"auction_id": auctionId}
+
+	defer mongodb.TrackQuery("CountBidsByAuctionId", filter)()
+	count, err := bd.Collection.CountDocuments(ctx, filter)
+	if err != nil {
+		logger.Error(fmt.Sprintf("error trying to count bids by auction id %s", auctionId), err)
+		return 0, mongodb.ClassifyMongoError(err, "", fmt.Sprintf("error trying to count bids by auction id %s", auctionId))
+	}
+	return count, nil
+}
+
+// reserveBidSlot aplica o MAX_BIDS_PER_AUCTION antes do insert
+// Abaixo do limite o lance sempre entra; no limite só entra o lance que supera o vencedor atual
+// O mutex serializa a reserva: as goroutines do batch não podem passar do limite juntas
+// A contagem vem do banco uma vez por leilão e depois é mantida em memória
+func (bd *BidRepository) reserveBidSlot(ctx context.Context, bid bid_entity.Bid) bool {
+	if bd.maxBidsPerAuction <= 0 {
+		return true // 0 = ilimitado
+	}
+
+	bd.bidCapMutex.Lock()
+	defer bd.bidCapMutex.Unlock()
+
+	bidCap, ok := bd.bidCapMap[bid.AuctionId]
+	if !ok {
+		loaded, err := bd.loadBidCap(ctx, bid.AuctionId)
+		if err != nil {
+			// Sem a contagem não dá para garantir o limite - o lance é rejeitado como falha de leitura
+			logger.Error(fmt.Sprintf("error trying to load bid cap of auction %s", bid.AuctionId), err)
+			bd.rejectBid(bid, bid_entity.RejectStorageFailure)
+			return false
+		}
+		bidCap = loaded
+		bd.bidCapMap[bid.AuctionId] = bidCap
+	}
+
+	if bidCap.count >= int64(bd.maxBidsPerAuction) && bidCap.hasTopBid && bid_entity.CompareAmounts(bid.Amount, bidCap.topAmount) <= 0 {
+		// Log próprio - diferente dos lances descartados por leilão fechado
+		logger.Info("bid rejected: auction reached MAX_BIDS_PER_AUCTION",
+			zap.String("auction_id", bid.AuctionId),
+			zap.String("bid_id", bid.Id),
+			zap.Int64("stored_bids", bidCap.count),
+			zap.Float64("amount", bid.Amount),
+			zap.Float64("winning_amount", bidCap.topAmount))
+		bd.rejectBid(bid, bid_entity.RejectBidCapReached)
+		return false
+	}
+
+	bidCap.count++
+	if !bidCap.hasTopBid || bid_entity.CompareAmounts(bid.Amount, bidCap.topAmount) > 0 {
+		bidCap.topAmount = bid.Amount
+		bidCap.hasTopBid = true
+	}
+	return true
+}
+
+// loadBidCap lê a contagem e o lance vencedor atuais do leilão
+func (bd *BidRepository) loadBidCap(ctx context.Context, auctionId string) (*auctionBidCap, *internal_error.InternalError) {
+	count, err := bd.CountBidsByAuctionId(ctx, auctionId)
+	if err != nil {
+		return nil, err
+	}
+
+	bidCap := &auctionBidCap{count: count}
+	if count == 0 {
+		return bidCap, nil
+	}
+
+	winningBid, err := bd.FindWinningBidByAuctionId(ctx, auctionId)
+	if err != nil {
+		return nil, err
+	}
+	bidCap.topAmount = winningBid.Amount
+	bidCap.hasTopBid = true
+	return bidCap, nil
+}
+
+// forgetBidCap descarta o estado do leilão - a próxima reserva recarrega do banco
+// Usado quando uma vaga reservada não virou lance gravado (insert falhou ou era duplicado)
+func (bd *BidRepository) forgetBidCap(auctionId string) {
+	bd.bidCapMutex.Lock()
+	delete(bd.bidCapMap, auctionId)
+	bd.bidCapMutex.Unlock()
+}
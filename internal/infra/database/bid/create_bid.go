@@ -3,30 +3,97 @@ package bid
 import (
 	"context"
 	"fmt"
-	"os"
 	"sync"
+	"sync/atomic"
 	"time"
 
+	"github.com/Guilherme-G-Cadilhe/Go-AuctionHouse_Concurrency-Server-Api/configuration/config"
+	"github.com/Guilherme-G-Cadilhe/Go-AuctionHouse_Concurrency-Server-Api/configuration/database/mongodb"
 	"github.com/Guilherme-G-Cadilhe/Go-AuctionHouse_Concurrency-Server-Api/configuration/logger"
+	"github.com/Guilherme-G-Cadilhe/Go-AuctionHouse_Concurrency-Server-Api/internal/clock"
 	"github.com/Guilherme-G-Cadilhe/Go-AuctionHouse_Concurrency-Server-Api/internal/entity/auction_entity"
 	"github.com/Guilherme-G-Cadilhe/Go-AuctionHouse_Concurrency-Server-Api/internal/entity/bid_entity"
 	"github.com/Guilherme-G-Cadilhe/Go-AuctionHouse_Concurrency-Server-Api/internal/infra/database/auction"
 	"github.com/Guilherme-G-Cadilhe/Go-AuctionHouse_Concurrency-Server-Api/internal/internal_error"
+	"go.mongodb.org/mongo-driver/bson"
 	"go.mongodb.org/mongo-driver/mongo"
+	"go.uber.org/zap"
 )
 
 type BidEntityMongo struct {
-	Id        string  `bson:"_id"`
-	UserId    string  `bson:"user_id"`
-	AuctionId string  `bson:"auction_id"`
-	Amount    float64 `bson:"amount"`
-	Timestamp int64   `bson:"timestamp"`
+	Id          string  `bson:"_id"`
+	UserId      string  `bson:"user_id"`
+	Handle      string  `bson:"handle,omitempty"` // Apelido de convidado - user_id fica vazio
+	AuctionId   string  `bson:"auction_id"`
+	Amount      float64 `bson:"amount,omitempty"`       // Usado em AMOUNT_MODE=float
+	AmountCents int64   `bson:"amount_cents,omitempty"` // Usado em AMOUNT_MODE=cents
+	Timestamp   int64   `bson:"timestamp"`
+	Sequence    int64   `bson:"sequence,omitempty"` // Ordem de submissão - desempate do vencedor
+}
+
+// newBidEntityMongo converte a entidade para o modelo MongoDB respeitando o AMOUNT_MODE
+// Em modo cents o valor é gravado como inteiro no campo "amount_cents"
+func newBidEntityMongo(bid bid_entity.Bid) *BidEntityMongo {
+	bidEntityMongo := &BidEntityMongo{
+		Id:        bid.Id,
+		UserId:    bid.UserId,
+		Handle:    bid.Handle,
+		AuctionId: bid.AuctionId,
+		Timestamp: bid.Timestamp.Unix(),
+		Sequence:  bid.Sequence,
+	}
+
+	if bid_entity.GetAmountMode() == bid_entity.AmountModeCents {
+		bidEntityMongo.AmountCents = bid.AmountInCents()
+	} else {
+		bidEntityMongo.Amount = bid.Amount
+	}
+
+	return bidEntityMongo
+}
+
+// toEntity converte o modelo MongoDB de volta para a entidade de domínio
+func (bm *BidEntityMongo) toEntity() bid_entity.Bid {
+	amount := bm.Amount
+	if bid_entity.GetAmountMode() == bid_entity.AmountModeCents {
+		amount = float64(bm.AmountCents)
+	}
+
+	return bid_entity.Bid{
+		Id:        bm.Id,
+		UserId:    bm.UserId,
+		Handle:    bm.Handle,
+		AuctionId: bm.AuctionId,
+		Amount:    amount,
+		Timestamp: time.Unix(bm.Timestamp, 0),
+		Sequence:  bm.Sequence,
+	}
+}
+
+// amountField retorna o campo do MongoDB que guarda o valor no AMOUNT_MODE atual
+func amountField() string {
+	if bid_entity.GetAmountMode() == bid_entity.AmountModeCents {
+		return "amount_cents"
+	}
+	return "amount"
+}
+
+// winningBidSort ordena os lances como bid_entity.Bid.Outranks: maior valor, depois o submetido primeiro
+// Lances sem sequence (gravados antes dela existir) ficam com 0 e desempatam pelo timestamp
+func winningBidSort() bson.D {
+	return bson.D{{Key: amountField(), Value: -1}, {Key: "sequence", Value: 1}, {Key: "timestamp", Value: 1}}
 }
 
 // BidRepository agora possui campos para CONCORRÊNCIA e CACHE
 type BidRepository struct {
 	Collection        *mongo.Collection
 	AuctionRepository *auction.AuctionRepository
+	clock             clock.Clock   // Relógio usado para checar o fim dos leilões
+	closeSkew         time.Duration // Tolerância após o fim (AUCTION_CLOSE_SKEW) - a mesma do fechamento automático
+	maxBidsPerAuction int           // MAX_BIDS_PER_AUCTION (0 = ilimitado)
+	firstBidMinimum   float64       // Mínimo do primeiro lance pelo FIRST_BID_POLICY (0 = qualquer valor positivo)
+	minBidIncrement   float64       // AUCTION_MIN_BID_INCREMENT - incremento abaixo da primeira faixa da tabela
+	requiredIncrement float64       // MIN_BID_INCREMENT - quanto o lance supera o vencedor em leilões sem tabela
 
 	// CACHE MAPS - evitam consultas repetidas ao banco
 	auctionStatusMap  map[string]auction_entity.AuctionStatus // Cache do status dos leilões
@@ -37,128 +104,313 @@ type BidRepository struct {
 	auctionStatusMapMutex *sync.Mutex // Protege auctionStatusMap
 	auctionEndTimeMutex   *sync.Mutex // Protege auctionEndTimeMap
 
-	auctionInterval time.Duration // Duração padrão dos leilões
+	// Estado do limite de lances por leilão, carregado sob demanda (ver bid_cap.go)
+	bidCapMap   map[string]*auctionBidCap
+	bidCapMutex *sync.Mutex // Protege bidCapMap e serializa a reserva de vagas
+
+	// Tabela de incrementos e maior lance dos leilões (ver winning_bid_guard.go)
+	incrementMap   map[string]*auctionIncrement
+	incrementMutex *sync.Mutex // Protege incrementMap e serializa a checagem do incremento
+
+	rejectListeners []bid_entity.BidRejectedListener // Avisados de cada lance descartado (status da submissão)
+	batchObservers  []bid_entity.BatchObserver       // Avisados uma vez por batch com os lances gravados (fan-out)
 }
 
-func NewBidRepository(database *mongo.Database, auctionRepository *auction.AuctionRepository) *BidRepository {
+func NewBidRepository(database *mongo.Database, auctionRepository *auction.AuctionRepository, cfg config.AuctionConfig, clk clock.Clock) *BidRepository {
 	return &BidRepository{
-		auctionInterval: getAuctionInterval(),
 		// make() cria maps vazios (similar a {} no JavaScript)
 		auctionStatusMap:  make(map[string]auction_entity.AuctionStatus),
 		auctionEndTimeMap: make(map[string]time.Time),
 		// &sync.Mutex{} cria novos mutexes
 		auctionStatusMapMutex: &sync.Mutex{},
 		auctionEndTimeMutex:   &sync.Mutex{},
+		bidCapMap:             make(map[string]*auctionBidCap),
+		bidCapMutex:           &sync.Mutex{},
+		incrementMap:          make(map[string]*auctionIncrement),
+		incrementMutex:        &sync.Mutex{},
 		Collection:            database.Collection("bids"),
 		AuctionRepository:     auctionRepository,
+		clock:                 clk,
+		closeSkew:             cfg.CloseSkew,
+		maxBidsPerAuction:     cfg.MaxBidsPerAuction,
+		firstBidMinimum:       bid_entity.ParseFirstBidPolicy(cfg.FirstBidPolicy).MinimumFirstBid(cfg.StartingPrice, cfg.MinBidIncrement),
+		minBidIncrement:       cfg.MinBidIncrement,
+		requiredIncrement:     cfg.RequiredIncrement,
 	}
 }
 
-// CreateBidBatch processa múltiplos lances CONCORRENTEMENTE
+// CreateBidBatch processa múltiplos lances CONCORRENTEMENTE (uma goroutine por leilão)
 // Esta é a função mais complexa - usa goroutines + WaitGroup + Mutex
-func (bd *BidRepository) CreateBidBatch(ctx context.Context, bidEntities []bid_entity.Bid) *internal_error.InternalError {
+// Lances que falharam por failover (ver failover.go) são retornados para voltar ao batch
+func (bd *BidRepository) CreateBidBatch(ctx context.Context, bidEntities []bid_entity.Bid) ([]bid_entity.Bid, *internal_error.InternalError) {
 	// sync.WaitGroup coordena múltiplas goroutines
 	// É como Promise.all() no JavaScript, mas mais flexível
 	var wg sync.WaitGroup
 
-	// Itera sobre cada lance no batch
-	for _, bid := range bidEntities {
+	// Contador de inserts que falharam - atomic evita mutex para um simples incremento
+	// Lances rejeitados (leilão fechado) não contam como falha
+	var failedInserts atomic.Int64
+
+	// Lances perdidos na troca de primário - append concorrente, então protegido por mutex
+	var failoverBids []bid_entity.Bid
+	var failoverMutex sync.Mutex
+
+	// Lances gravados, entregues juntos aos BatchObservers depois do wg.Wait
+	var acceptedBids []bid_entity.Bid
+	var acceptedMutex sync.Mutex
+	accept := func(bidValue bid_entity.Bid) {
+		acceptedMutex.Lock()
+		acceptedBids = append(acceptedBids, bidValue)
+		acceptedMutex.Unlock()
+	}
+
+	insert := func(bidValue bid_entity.Bid, bidEntityMongo *BidEntityMongo) {
+		switch bd.insertBid(ctx, bidEntityMongo) {
+		case insertOK:
+			accept(bidValue)
+		case insertFailover:
+			failoverMutex.Lock()
+			failoverBids = append(failoverBids, bidValue)
+			failoverMutex.Unlock()
+			failedInserts.Add(1)
+		case insertFailed:
+			bd.rejectBid(bidValue, bid_entity.RejectStorageFailure)
+			failedInserts.Add(1)
+		}
+	}
+
+	// processBid valida e grava um lance - chamado em sequência dentro do grupo do leilão
+	processBid := func(bidValue bid_entity.Bid) {
+		// === SEÇÃO CRÍTICA 1: Leitura do cache de status ===
+		// Lock() garante acesso exclusivo ao map
+		bd.auctionStatusMapMutex.Lock()
+		auctionStatus, okStatus := bd.auctionStatusMap[bidValue.AuctionId]
+		// Unlock() libera o lock imediatamente após uso
+		bd.auctionStatusMapMutex.Unlock()
+
+		// === SEÇÃO CRÍTICA 2: Leitura do cache de tempo ===
+		bd.auctionEndTimeMutex.Lock()
+		auctionEndTime, okEndTime := bd.auctionEndTimeMap[bidValue.AuctionId]
+		bd.auctionEndTimeMutex.Unlock()
+
+		// Converte entidade para modelo MongoDB
+		bidEntityMongo := newBidEntityMongo(bidValue)
+
+		// CACHE HIT - se temos dados do leilão em cache
+		if okEndTime && okStatus {
+			// Mesma regra do fechamento automático (fim + AUCTION_CLOSE_SKEW)
+			if reason := auction_entity.NotOpenReason(auctionStatus, auctionEndTime, bd.closeSkew, bd.clock.Now(), true); reason != "" {
+				bd.rejectNotOpen(bidValue, reason)
+				return // Lance rejeitado - leilão fechado
+			}
+			if !bd.acceptsFirstBid(ctx, bidValue) {
+				return // Lance rejeitado - primeiro lance abaixo do FIRST_BID_POLICY (logado em acceptsFirstBid)
+			}
+			if !bd.beatsWinningBid(ctx, bidValue) {
+				return // Lance rejeitado - não supera o vencedor pelo incremento exigido (logado em beatsWinningBid)
+			}
+			if !bd.reserveBidSlot(ctx, bidValue) {
+				bd.forgetIncrementTop(bidValue.AuctionId)
+				return // Lance rejeitado - limite de lances do leilão (logado em reserveBidSlot)
+			}
+
+			// Lance válido - insere no banco
+			insert(bidValue, bidEntityMongo)
+			return
+		}
+
+		// CACHE MISS - precisa buscar dados do leilão no banco
+		auctionEntity, err := bd.AuctionRepository.FindAuctionById(ctx, bidValue.AuctionId)
+		if err != nil {
+			logger.Error(fmt.Sprintf("error trying to find auction by id %s", bidValue.AuctionId), err)
+			if err.Err == "not_found" {
+				bd.rejectBid(bidValue, bid_entity.RejectAuctionNotFound)
+			} else {
+				bd.rejectBid(bidValue, bid_entity.RejectStorageFailure)
+			}
+			return
+		}
+
+		// Verifica se leilão está ativo
+		if auctionEntity.Status != auction_entity.Active {
+			bd.rejectNotOpen(bidValue, auction_entity.NotOpenStatusNotActive)
+			return
+		}
+
+		// Leilão holandês não entra no cache: cada lance relê o leilão e disputa o arremate no banco
+		if auctionEntity.IsDutch() {
+			accepted, ok := bd.createDutchBid(ctx, auctionEntity, bidValue)
+			if accepted {
+				accept(bidValue)
+			}
+			if !ok {
+				failedInserts.Add(1)
+			}
+			return
+		}
+
+		// Tabela de incrementos antes do status: quem acertar o cache de status já encontra a tabela
+		bd.rememberIncrementSchedule(auctionEntity)
+
+		// === SEÇÃO CRÍTICA 3: Atualização do cache de status ===
+		bd.auctionStatusMapMutex.Lock()
+		bd.auctionStatusMap[bidValue.AuctionId] = auctionEntity.Status
+		bd.auctionStatusMapMutex.Unlock()
+
+		// === SEÇÃO CRÍTICA 4: Atualização do cache de tempo ===
+		bd.auctionEndTimeMutex.Lock()
+		// Fim efetivo persistido no leilão (considera extensões)
+		bd.auctionEndTimeMap[bidValue.AuctionId] = auctionEntity.EndTime
+		bd.auctionEndTimeMutex.Unlock()
+
+		// O fechamento automático pode ainda não ter gravado o status - o deadline é a referência,
+		// então cache hit e cache miss decidem exatamente igual
+		if reason := auction_entity.NotOpenReason(auctionEntity.Status, auctionEntity.EndTime, bd.closeSkew, bd.clock.Now(), false); reason != "" {
+			bd.rejectNotOpen(bidValue, reason)
+			return // Lance rejeitado - chegou depois do deadline do leilão
+		}
+		if !bd.acceptsFirstBid(ctx, bidValue) {
+			return // Lance rejeitado - primeiro lance abaixo do FIRST_BID_POLICY (logado em acceptsFirstBid)
+		}
+		if !bd.beatsWinningBid(ctx, bidValue) {
+			return // Lance rejeitado - não supera o vencedor pelo incremento exigido (logado em beatsWinningBid)
+		}
+		if !bd.reserveBidSlot(ctx, bidValue) {
+			bd.forgetIncrementTop(bidValue.AuctionId)
+			return // Lance rejeitado - limite de lances do leilão (logado em reserveBidSlot)
+		}
+
+		// Insere lance válido no banco
+		insert(bidValue, bidEntityMongo)
+	}
+
+	// Uma goroutine por leilão: leilões diferentes seguem em paralelo, mas os lances de um mesmo leilão
+	// são avaliados em sequência, do mais forte para o mais fraco (GroupBatchByAuction)
+	// Assim, dois lances do mesmo leilão no mesmo batch têm sempre o mesmo resultado: o maior vence
+	for _, auctionBids := range bid_entity.GroupBatchByAuction(bidEntities) {
 		// wg.Add(1) incrementa o contador de goroutines ativas
 		wg.Add(1)
 
 		// GOROUTINE - executa função em paralelo
 		// go func() é como criar uma nova thread/processo
-		go func(bidValue bid_entity.Bid) {
+		go func(auctionBids []bid_entity.Bid) {
 			// defer wg.Done() decrementa contador quando função termina
 			// É executado independente de como a função sai (return, panic, etc.)
 			defer wg.Done()
 
-			// === SEÇÃO CRÍTICA 1: Leitura do cache de status ===
-			// Lock() garante acesso exclusivo ao map
-			bd.auctionStatusMapMutex.Lock()
-			auctionStatus, okStatus := bd.auctionStatusMap[bidValue.AuctionId]
-			// Unlock() libera o lock imediatamente após uso
-			bd.auctionStatusMapMutex.Unlock()
-
-			// === SEÇÃO CRÍTICA 2: Leitura do cache de tempo ===
-			bd.auctionEndTimeMutex.Lock()
-			auctionEndTime, okEndTime := bd.auctionEndTimeMap[bidValue.AuctionId]
-			bd.auctionEndTimeMutex.Unlock()
-
-			// Converte entidade para modelo MongoDB
-			bidEntityMongo := &BidEntityMongo{
-				Id:        bidValue.Id,
-				UserId:    bidValue.UserId,
-				AuctionId: bidValue.AuctionId,
-				Amount:    bidValue.Amount,
-				Timestamp: bidValue.Timestamp.Unix(),
+			for _, bidValue := range auctionBids {
+				processBid(bidValue)
 			}
+		}(auctionBids) // Passa o grupo como parâmetro para evitar closure issues
+	}
 
-			// CACHE HIT - se temos dados do leilão em cache
-			if okEndTime && okStatus {
-				now := time.Now()
-				// Verifica se leilão já fechou
-				if auctionStatus == auction_entity.Completed || now.After(auctionEndTime) {
-					return // Lance rejeitado - leilão fechado
-				}
-
-				// Lance válido - insere no banco
-				if _, err := bd.Collection.InsertOne(ctx, bidEntityMongo); err != nil {
-					logger.Error("Error trying to insert bid", err)
-					return
-				}
-				return
-			}
+	// wg.Wait() bloqueia até todas as goroutines terminarem
+	// É como await Promise.all() no JavaScript
+	wg.Wait()
 
-			// CACHE MISS - precisa buscar dados do leilão no banco
-			auctionEntity, err := bd.AuctionRepository.FindAuctionById(ctx, bidValue.AuctionId)
-			if err != nil {
-				logger.Error(fmt.Sprintf("error trying to find auction by id %s", bidValue.AuctionId), err)
-				return
-			}
+	// Fan-out uma vez por batch - inclusive em falha parcial: os lances gravados já valem
+	bd.notifyBatchAccepted(acceptedBids)
 
-			// Verifica se leilão está ativo
-			if auctionEntity.Status != auction_entity.Active {
-				logger.Error(fmt.Sprintf("auction with id %s is not open", bidValue.AuctionId), err)
-				return
-			}
+	if failed := failedInserts.Load(); failed > 0 {
+		return failoverBids, internal_error.NewInternalServerError(fmt.Sprintf("%d of %d bids failed to insert", failed, len(bidEntities)))
+	}
+	return nil, nil
+}
 
-			// === SEÇÃO CRÍTICA 3: Atualização do cache de status ===
-			bd.auctionStatusMapMutex.Lock()
-			bd.auctionStatusMap[bidValue.AuctionId] = auctionEntity.Status
-			bd.auctionStatusMapMutex.Unlock()
-
-			// === SEÇÃO CRÍTICA 4: Atualização do cache de tempo ===
-			bd.auctionEndTimeMutex.Lock()
-			// Calcula tempo de fim = timestamp inicial + intervalo
-			bd.auctionEndTimeMap[bidValue.AuctionId] = auctionEntity.Timestamp.Add(bd.auctionInterval)
-			bd.auctionEndTimeMutex.Unlock()
-
-			// Insere lance válido no banco
-			if _, err := bd.Collection.InsertOne(ctx, bidEntityMongo); err != nil {
-				logger.Error("error trying to insert bid", err)
-				return
-			}
+// insertResult diferencia falhas definitivas das causadas por failover do replica set
+type insertResult int
+
+const (
+	insertOK       insertResult = iota // Gravado (ou já gravado antes - duplicate key)
+	insertFailed                       // Falha real - o lance é perdido
+	insertFailover                     // Troca de primário - o lance pode ser reenviado
+)
 
-		}(bid) // Passa bid como parâmetro para evitar closure issues
+// insertBid grava um lance; falhas reais e de failover são distinguidas para o reenvio
+// O _id é o id do lance - quando gerado pelo cliente, um reenvio do mesmo lance gera duplicate key:
+// o lance já foi aceito antes, então é tratado como sucesso (no-op) e não como erro 500
+// Isso também torna o reenvio após failover seguro: se a escrita chegou a ser gravada, o reenvio é no-op
+func (bd *BidRepository) insertBid(ctx context.Context, bidEntityMongo *BidEntityMongo) insertResult {
+	stopTracking := mongodb.TrackQuery("InsertBid", bidEntityMongo.Id)
+	_, err := bd.Collection.InsertOne(ctx, bidEntityMongo)
+	stopTracking()
+
+	if mongo.IsDuplicateKeyError(err) {
+		logger.Debug(fmt.Sprintf("bid %s already accepted, ignoring duplicate", bidEntityMongo.Id))
+		// A vaga reservada no limite não foi usada - recarrega a contagem do banco
+		bd.forgetBidCap(bidEntityMongo.AuctionId)
+		bd.forgetIncrementTop(bidEntityMongo.AuctionId)
+		return insertOK
+	}
+	if isFailoverError(err) {
+		logger.Warn("bid insert failed during MongoDB failover",
+			zap.String("bid_id", bidEntityMongo.Id),
+			zap.String("auction_id", bidEntityMongo.AuctionId),
+			zap.Error(err))
+		// A vaga reservada no limite não foi usada - o reenvio reserva de novo
+		bd.forgetBidCap(bidEntityMongo.AuctionId)
+		bd.forgetIncrementTop(bidEntityMongo.AuctionId)
+		return insertFailover
 	}
+	if err != nil {
+		logger.Error("error trying to insert bid", err)
+		bd.forgetBidCap(bidEntityMongo.AuctionId)
+		bd.forgetIncrementTop(bidEntityMongo.AuctionId)
+		return insertFailed
+	}
+	return insertOK
+}
 
-	// wg.Wait() bloqueia até todas as goroutines terminarem
-	// É como await Promise.all() no JavaScript
-	wg.Wait()
-	return nil
+// OnBidRejected registra um listener avisado de cada lance descartado pelo batch
+// Como o OnAuctionClosed do leilão: registrado na inicialização, antes do primeiro batch
+func (bd *BidRepository) OnBidRejected(listener bid_entity.BidRejectedListener) {
+	bd.rejectListeners = append(bd.rejectListeners, listener)
 }
 
-// getAuctionInterval lê configuração de duração dos leilões
-func getAuctionInterval() time.Duration {
-	auctionInterval := os.Getenv("AUCTION_INTERVAL")
-	// time.ParseDuration() converte string para Duration
-	// Ex: "5m", "30s", "2h45m"
-	duration, err := time.ParseDuration(auctionInterval)
-	if err != nil {
-		return time.Minute * 5 // Fallback: 5 minutos
+// AddBatchObserver registra um observer dos lances gravados - na inicialização, como o OnBidRejected
+func (bd *BidRepository) AddBatchObserver(observer bid_entity.BatchObserver) {
+	bd.batchObservers = append(bd.batchObservers, observer)
+}
+
+// notifyBatchAccepted entrega os lances gravados no batch; batch sem lances gravados não avisa ninguém
+func (bd *BidRepository) notifyBatchAccepted(bids []bid_entity.Bid) {
+	if len(bids) == 0 {
+		return
+	}
+	for _, observer := range bd.batchObservers {
+		observer.OnBatchAccepted(bids)
 	}
-	return duration
+}
+
+// rejectBid avisa os listeners que o lance foi descartado e por quê
+func (bd *BidRepository) rejectBid(bid bid_entity.Bid, reason string) {
+	for _, listener := range bd.rejectListeners {
+		listener(bid, reason)
+	}
+}
+
+// rejectNotOpen loga e rejeita um lance de leilão fechado com o motivo detalhado
+// Info e não Error: lance atrasado é regra de negócio, não falha do sistema
+func (bd *BidRepository) rejectNotOpen(bid bid_entity.Bid, reason string) {
+	logger.Info("bid rejected: auction is not open",
+		zap.String("reason", reason),
+		zap.String("auction_id", bid.AuctionId),
+		zap.String("bid_id", bid.Id))
+	bd.rejectBid(bid, bid_entity.RejectAuctionClosed)
+}
+
+// InvalidateAuctionCache remove o leilão dos caches de status e fim
+// A próxima validação de lance busca os dados atualizados no banco
+func (bd *BidRepository) InvalidateAuctionCache(auctionId string) {
+	bd.auctionStatusMapMutex.Lock()
+	delete(bd.auctionStatusMap, auctionId)
+	bd.auctionStatusMapMutex.Unlock()
+
+	bd.auctionEndTimeMutex.Lock()
+	delete(bd.auctionEndTimeMap, auctionId)
+	bd.auctionEndTimeMutex.Unlock()
+
+	bd.forgetIncrementSchedule(auctionId)
 }
 
 /*
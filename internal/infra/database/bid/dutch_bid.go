@@ -0,0 +1,66 @@
+package bid
+
+import (
+	"context"
+
+	"github.com/Guilherme-G-Cadilhe/Go-AuctionHouse_Concurrency-Server-Api/configuration/logger"
+	"github.com/Guilherme-G-Cadilhe/Go-AuctionHouse_Concurrency-Server-Api/internal/entity/auction_entity"
+	"github.com/Guilherme-G-Cadilhe/Go-AuctionHouse_Concurrency-Server-Api/internal/entity/bid_entity"
+	"go.uber.org/zap"
+)
+
+// createDutchBid aceita o lance de um leilão holandês se ele cobre o preço atual
+// O primeiro lance aceito arremata o leilão: vira o vencedor e fecha o leilão na hora
+// accepted indica que o lance foi gravado (BatchObserver)
+// ok é false apenas em falhas reais (lances rejeitados não são falha, como no fluxo comum)
+func (bd *BidRepository) createDutchBid(ctx context.Context, auction *auction_entity.Auction, bid bid_entity.Bid) (accepted, ok bool) {
+	now := bd.clock.Now()
+	if reason := auction_entity.NotOpenReason(auction.Status, auction.EndTime, bd.closeSkew, now, false); reason != "" {
+		bd.rejectNotOpen(bid, reason)
+		return false, true // Lance rejeitado - leilão fechado
+	}
+
+	// O preço é calculado pela tabela no momento do processamento - o lance é validado ao sair da fila
+	currentPrice := auction.DutchPriceAt(now)
+	// Preço calculado (início - n * decremento) - a comparação tolera o erro de float (AMOUNT_EPSILON)
+	if bid_entity.CompareAmounts(bid.Amount, currentPrice) < 0 {
+		logger.Info("bid rejected: below dutch auction current price",
+			zap.String("auction_id", bid.AuctionId),
+			zap.String("bid_id", bid.Id),
+			zap.Float64("amount", bid.Amount),
+			zap.Float64("current_price", currentPrice))
+		bd.rejectBid(bid, bid_entity.RejectBelowDutchPrice)
+		return false, true
+	}
+
+	// A disputa acontece no banco: só um lance consegue a transição Active -> Completed
+	claimed, err := bd.AuctionRepository.ClaimDutchAuction(ctx, auction.Id, bid)
+	if err != nil {
+		bd.rejectBid(bid, bid_entity.RejectStorageFailure)
+		return false, false
+	}
+	if !claimed {
+		logger.Info("bid rejected: dutch auction already claimed",
+			zap.String("auction_id", bid.AuctionId),
+			zap.String("bid_id", bid.Id))
+		bd.rejectBid(bid, bid_entity.RejectDutchClaimed)
+		return false, true
+	}
+
+	// Próximos lances do leilão caem no cache como fechado, sem nova consulta ao banco
+	bd.auctionStatusMapMutex.Lock()
+	bd.auctionStatusMap[auction.Id] = auction_entity.Completed
+	bd.auctionStatusMapMutex.Unlock()
+
+	bd.auctionEndTimeMutex.Lock()
+	bd.auctionEndTimeMap[auction.Id] = auction.EndTime
+	bd.auctionEndTimeMutex.Unlock()
+
+	// Sem reenvio em failover: o leilão já foi arrematado, então o lance reenviado seria recusado como fechado
+	ok = bd.insertBid(ctx, newBidEntityMongo(bid)) == insertOK
+	if !ok {
+		bd.rejectBid(bid, bid_entity.RejectStorageFailure)
+	}
+	bd.AuctionRepository.FinishDutchAuctionClose(ctx, auction.Id)
+	return ok, ok
+}
@@ -0,0 +1,44 @@
+package bid
+
+import (
+	"errors"
+
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+// Códigos que o MongoDB devolve enquanto o replica set troca de primário
+// O driver já refaz a escrita uma vez (retryable writes), mas a eleição pode levar mais que isso
+var failoverErrorCodes = []int{
+	91,    // ShutdownInProgress
+	189,   // PrimarySteppedDown
+	10107, // NotWritablePrimary ("not master")
+	11600, // InterruptedAtShutdown
+	11602, // InterruptedDueToReplStateChange
+	13435, // NotPrimaryNoSecondaryOk
+	13436, // NotPrimaryOrSecondary
+}
+
+// isFailoverError indica se a escrita falhou por troca de primário ou queda de conexão com o nó
+// Esses lances não foram recusados pelo banco: podem voltar ao batch e ser gravados no próximo ciclo
+func isFailoverError(err error) bool {
+	if err == nil {
+		return false
+	}
+	if mongo.IsNetworkError(err) {
+		return true
+	}
+
+	var serverErr mongo.ServerError
+	if !errors.As(err, &serverErr) {
+		return false
+	}
+	if serverErr.HasErrorLabel("RetryableWriteError") {
+		return true
+	}
+	for _, code := range failoverErrorCodes {
+		if serverErr.HasErrorCode(code) {
+			return true
+		}
+	}
+	return false
+}
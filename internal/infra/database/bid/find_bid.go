@@ -2,64 +2,114 @@ package bid
 
 import (
 	"context"
+	"errors"
 	"fmt"
-	"time"
 
+	"github.com/Guilherme-G-Cadilhe/Go-AuctionHouse_Concurrency-Server-Api/configuration/database/mongodb"
 	"github.com/Guilherme-G-Cadilhe/Go-AuctionHouse_Concurrency-Server-Api/configuration/logger"
+	"github.com/Guilherme-G-Cadilhe/Go-AuctionHouse_Concurrency-Server-Api/internal/entity/auction_entity"
 	"github.com/Guilherme-G-Cadilhe/Go-AuctionHouse_Concurrency-Server-Api/internal/entity/bid_entity"
 	"github.com/Guilherme-G-Cadilhe/Go-AuctionHouse_Concurrency-Server-Api/internal/internal_error"
 	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
 	"go.mongodb.org/mongo-driver/mongo/options"
 )
 
-func (bd *BidRepository) FindBidByAuctionId(ctx context.Context, auctionId string) ([]bid_entity.Bid, *internal_error.InternalError) {
+func (bd *BidRepository) FindBidByAuctionId(ctx context.Context, auctionId string, bidFilter bid_entity.BidFilter) ([]bid_entity.Bid, *internal_error.InternalError) {
 	filter := bson.M{"auction_id": auctionId}
+	// timestamp é gravado em Unix (int64), então a comparação usa since.Unix()
+	if !bidFilter.Since.IsZero() {
+		filter["timestamp"] = bson.M{"$gte": bidFilter.Since.Unix()}
+	}
+	// amount_cents é int64 e amount é double - o MongoDB compara números de tipos diferentes pelo valor
+	if bidFilter.MinAmount > 0 {
+		filter[amountField()] = bson.M{"$gte": bidFilter.MinAmount}
+	}
+
+	// Paginação por cursor (keyset): filtro de faixa na chave de ordenação em vez de SetSkip
+	// O custo não cresce com a página - um índice { auction_id, timestamp, _id } atende filtro e sort
+	findOptions := options.Find()
+	if bidFilter.Paginated() {
+		findOptions.SetSort(bson.D{{Key: "timestamp", Value: 1}, {Key: "_id", Value: 1}})
+		if bidFilter.Limit > 0 {
+			findOptions.SetLimit(int64(bidFilter.Limit))
+		}
+	}
+	if after := bidFilter.After; after != nil {
+		filter["$or"] = bson.A{
+			bson.M{"timestamp": bson.M{"$gt": after.Timestamp.Unix()}},
+			bson.M{"timestamp": after.Timestamp.Unix(), "_id": bson.M{"$gt": after.Id}},
+		}
+	}
 
 	var bids []BidEntityMongo
-	cursor, err := bd.Collection.Find(ctx, filter)
+	defer mongodb.TrackQuery("FindBidByAuctionId", filter)()
+	cursor, err := bd.Collection.Find(ctx, filter, findOptions)
 	if err != nil {
 		logger.Error(fmt.Sprintf("error trying to find bids by auction id %s", auctionId), err)
-		return nil, internal_error.NewInternalServerError(fmt.Sprintf("error trying to find bids by auction id %s", auctionId))
+		return nil, mongodb.ClassifyMongoError(err, "", fmt.Sprintf("error trying to find bids by auction id %s", auctionId))
 	}
 	defer cursor.Close(ctx)
 	fmt.Println(cursor)
 
 	if err := cursor.All(ctx, &bids); err != nil {
 		logger.Error(fmt.Sprintf("error trying to find bids by auction id %s", auctionId), err)
-		return nil, internal_error.NewInternalServerError(fmt.Sprintf("error trying to find bids by auction id %s", auctionId))
+		return nil, mongodb.ClassifyMongoError(err, "", fmt.Sprintf("error trying to find bids by auction id %s", auctionId))
 	}
 
 	fmt.Println(bids)
 
 	bidsEntities := make([]bid_entity.Bid, len(bids))
 	for i, bid := range bids {
-		bidsEntities[i] = bid_entity.Bid{
-			Id:        bid.Id,
-			UserId:    bid.UserId,
-			AuctionId: bid.AuctionId,
-			Amount:    bid.Amount,
-			Timestamp: time.Unix(bid.Timestamp, 0),
-		}
+		bidsEntities[i] = bid.toEntity()
 	}
 	return bidsEntities, nil
 }
 
+// FindWinningBidByAuctionId lê o vencedor gravado no fechamento quando o leilão já fechou
+// Leilões ativos (ou fechados sem vencedor gravado) continuam calculando sob demanda, ordenando os lances
 func (bd *BidRepository) FindWinningBidByAuctionId(ctx context.Context, auctionId string) (*bid_entity.Bid, *internal_error.InternalError) {
+	if auctionEntity, err := bd.AuctionRepository.FindAuctionById(ctx, auctionId); err == nil &&
+		auctionEntity.Status == auction_entity.Completed && auctionEntity.WinningBidId != "" {
+		return bd.FindBidById(ctx, auctionEntity.WinningBidId)
+	}
+
+	return bd.ComputeWinningBidByAuctionId(ctx, auctionId)
+}
+
+// ComputeWinningBidByAuctionId ordena os lances do leilão e devolve o primeiro (winningBidSort)
+// É o cálculo do fechamento - a reconciliação o usa para comparar com o vencedor gravado
+func (bd *BidRepository) ComputeWinningBidByAuctionId(ctx context.Context, auctionId string) (*bid_entity.Bid, *internal_error.InternalError) {
 	filter := bson.M{"auction_id": auctionId}
 
-	opts := options.FindOne().SetSort(bson.D{{Key: "amount", Value: -1}})
+	opts := options.FindOne().SetSort(winningBidSort())
 
 	var bid BidEntityMongo
+	defer mongodb.TrackQuery("FindWinningBidByAuctionId", filter)()
 	err := bd.Collection.FindOne(ctx, filter, opts).Decode(&bid)
 	if err != nil {
-		logger.Error(fmt.Sprintf("error trying to find winning bid by auction id %s", auctionId), err)
-		return nil, internal_error.NewNotFoundError(fmt.Sprintf("error trying to find winning bid by auction id %s", auctionId))
+		// Leilão sem lances é o caso normal (ex: primeiro lance) - só erros de verdade vão para o log
+		if !errors.Is(err, mongo.ErrNoDocuments) {
+			logger.Error(fmt.Sprintf("error trying to find winning bid by auction id %s", auctionId), err)
+		}
+		return nil, mongodb.ClassifyMongoError(err,
+			fmt.Sprintf("error trying to find winning bid by auction id %s", auctionId),
+			fmt.Sprintf("error trying to find winning bid by auction id %s", auctionId))
+	}
+	bidEntity := bid.toEntity()
+	return &bidEntity, nil
+}
+
+// FindBidById busca um lance pelo "_id" - leitura direta pelo índice padrão, sem ordenação
+func (bd *BidRepository) FindBidById(ctx context.Context, bidId string) (*bid_entity.Bid, *internal_error.InternalError) {
+	var bid BidEntityMongo
+	defer mongodb.TrackQuery("FindBidById", bidId)()
+	if err := bd.Collection.FindOne(ctx, bson.M{"_id": bidId}).Decode(&bid); err != nil {
+		logger.Error(fmt.Sprintf("error trying to find bid by id %s", bidId), err)
+		return nil, mongodb.ClassifyMongoError(err,
+			fmt.Sprintf("bid with id %s not found", bidId),
+			fmt.Sprintf("error trying to find bid by id %s", bidId))
 	}
-	return &bid_entity.Bid{
-		Id:        bid.Id,
-		UserId:    bid.UserId,
-		AuctionId: bid.AuctionId,
-		Amount:    bid.Amount,
-		Timestamp: time.Unix(bid.Timestamp, 0),
-	}, nil
+	bidEntity := bid.toEntity()
+	return &bidEntity, nil
 }
@@ -0,0 +1,40 @@
+package bid
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Guilherme-G-Cadilhe/Go-AuctionHouse_Concurrency-Server-Api/configuration/logger"
+	"github.com/Guilherme-G-Cadilhe/Go-AuctionHouse_Concurrency-Server-Api/internal/entity/bid_entity"
+	"go.uber.org/zap"
+)
+
+// acceptsFirstBid aplica o FIRST_BID_POLICY antes do insert
+// Lances que alcançam o mínimo sempre entram; abaixo dele só entram se o leilão já tiver lances gravados
+// A contagem só é consultada nesse caso - com any_positive (padrão) nenhuma consulta extra é feita
+// Sem cache nem mutex: dois lances abaixo do mínimo no mesmo batch de um leilão vazio são ambos rejeitados,
+// o que é o comportamento conservador esperado
+func (bd *BidRepository) acceptsFirstBid(ctx context.Context, bid bid_entity.Bid) bool {
+	if bd.firstBidMinimum <= 0 || bid.MeetsMinimum(bd.firstBidMinimum) {
+		return true
+	}
+
+	count, err := bd.CountBidsByAuctionId(ctx, bid.AuctionId)
+	if err != nil {
+		// Sem a contagem não dá para saber se é o primeiro lance - rejeitado como falha de leitura
+		logger.Error(fmt.Sprintf("error trying to count bids of auction %s", bid.AuctionId), err)
+		bd.rejectBid(bid, bid_entity.RejectStorageFailure)
+		return false
+	}
+	if count > 0 {
+		return true
+	}
+
+	logger.Info("bid rejected: first bid below FIRST_BID_POLICY minimum",
+		zap.String("auction_id", bid.AuctionId),
+		zap.String("bid_id", bid.Id),
+		zap.Float64("amount", bid.Amount),
+		zap.Float64("minimum", bd.firstBidMinimum))
+	bd.rejectBid(bid, bid_entity.RejectFirstBidMinimum)
+	return false
+}
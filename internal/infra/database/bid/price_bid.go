@@ -0,0 +1,57 @@
+package bid
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Guilherme-G-Cadilhe/Go-AuctionHouse_Concurrency-Server-Api/configuration/database/mongodb"
+	"github.com/Guilherme-G-Cadilhe/Go-AuctionHouse_Concurrency-Server-Api/configuration/logger"
+	"github.com/Guilherme-G-Cadilhe/Go-AuctionHouse_Concurrency-Server-Api/internal/entity/bid_entity"
+	"github.com/Guilherme-G-Cadilhe/Go-AuctionHouse_Concurrency-Server-Api/internal/internal_error"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+// bidPriceSummaryMongo é o único documento devolvido pela agregação de FindBidPriceSummary
+type bidPriceSummaryMongo struct {
+	Highest float64 `bson:"highest"`
+	Count   int64   `bson:"count"`
+}
+
+// FindBidPriceSummary calcula o maior lance e a quantidade de lances no próprio MongoDB
+// Só um documento pequeno trafega - nenhum lance completo é lido pela aplicação
+func (bd *BidRepository) FindBidPriceSummary(ctx context.Context, auctionId string) (*bid_entity.BidPriceSummary, *internal_error.InternalError) {
+	// Equivale a: SELECT MAX(amount), COUNT(*) FROM bids WHERE auction_id = ?
+	filter := bson.M{"auction_id": auctionId}
+	pipeline := mongo.Pipeline{
+		{{Key: "$match", Value: filter}},
+		{{Key: "$group", Value: bson.M{
+			"_id":     nil,
+			"highest": bson.M{"$max": "$" + amountField()},
+			"count":   bson.M{"$sum": 1},
+		}}},
+	}
+
+	defer mongodb.TrackQuery("FindBidPriceSummary", filter)()
+	cursor, err := bd.Collection.Aggregate(ctx, pipeline)
+	if err != nil {
+		logger.Error(fmt.Sprintf("error trying to summarize bids by auction id %s", auctionId), err)
+		return nil, mongodb.ClassifyMongoError(err, "", fmt.Sprintf("error trying to summarize bids by auction id %s", auctionId))
+	}
+	defer cursor.Close(ctx)
+
+	var results []bidPriceSummaryMongo
+	if err := cursor.All(ctx, &results); err != nil {
+		logger.Error(fmt.Sprintf("error trying to decode bid summary of auction id %s", auctionId), err)
+		return nil, mongodb.ClassifyMongoError(err, "", fmt.Sprintf("error trying to summarize bids by auction id %s", auctionId))
+	}
+
+	// Sem lances o $group não produz documento algum
+	if len(results) == 0 {
+		return &bid_entity.BidPriceSummary{}, nil
+	}
+	return &bid_entity.BidPriceSummary{
+		HighestAmount: results[0].Highest,
+		BidCount:      results[0].Count,
+	}, nil
+}
@@ -0,0 +1,129 @@
+package bid
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Guilherme-G-Cadilhe/Go-AuctionHouse_Concurrency-Server-Api/configuration/logger"
+	"github.com/Guilherme-G-Cadilhe/Go-AuctionHouse_Concurrency-Server-Api/internal/entity/auction_entity"
+	"github.com/Guilherme-G-Cadilhe/Go-AuctionHouse_Concurrency-Server-Api/internal/entity/bid_entity"
+	"github.com/Guilherme-G-Cadilhe/Go-AuctionHouse_Concurrency-Server-Api/internal/internal_error"
+	"go.uber.org/zap"
+)
+
+// auctionIncrement é o estado em cache do lance vencedor de um leilão e da sua tabela de incrementos
+// A tabela vem junto com status/fim no cache miss; o maior lance é carregado sob demanda
+type auctionIncrement struct {
+	schedule  auction_entity.IncrementSchedule
+	sealed    bool // Sealed-bid: sem checagem contra o vencedor (revelaria o lance oculto)
+	topLoaded bool
+	topAmount float64
+	topBidId  string
+	hasTopBid bool
+}
+
+// rememberIncrementSchedule guarda a tabela do leilão lido no cache miss (mesmo ciclo de vida do cache de status)
+// Leilão sem tabela também é guardado: o cache hit usa o mesmo estado para o maior lance
+func (bd *BidRepository) rememberIncrementSchedule(auction *auction_entity.Auction) {
+	bd.incrementMutex.Lock()
+	bd.incrementMap[auction.Id] = newAuctionIncrement(auction)
+	bd.incrementMutex.Unlock()
+}
+
+func newAuctionIncrement(auction *auction_entity.Auction) *auctionIncrement {
+	return &auctionIncrement{schedule: auction.IncrementSchedule, sealed: auction.Sealed}
+}
+
+// beatsWinningBid exige que o lance supere o vencedor atual: pelo incremento da faixa nos leilões com tabela,
+// pelo MIN_BID_INCREMENT nos demais (0 = basta ser maior). O primeiro lance fica com o FIRST_BID_POLICY (acceptsFirstBid)
+// Leilões sealed-bid ficam de fora: lances abaixo do vencedor oculto são válidos, e o motivo da rejeição
+// no GET /bid/status revelaria o maior lance
+// Como o reserveBidSlot, o mutex serializa as goroutines do batch: cada lance aceito vira o novo maior lance
+func (bd *BidRepository) beatsWinningBid(ctx context.Context, bid bid_entity.Bid) bool {
+	bd.incrementMutex.Lock()
+	defer bd.incrementMutex.Unlock()
+
+	state, ok := bd.incrementMap[bid.AuctionId]
+	if !ok {
+		// Cache invalidado entre a leitura do status e aqui (change stream, extensão, reabertura):
+		// o leilão é relido em vez de aceitar o lance sem checagem
+		auction, err := bd.AuctionRepository.FindAuctionById(ctx, bid.AuctionId)
+		if err != nil {
+			logger.Error(fmt.Sprintf("error trying to reload auction %s for the winning bid check", bid.AuctionId), err)
+			bd.rejectBid(bid, bid_entity.RejectStorageFailure)
+			return false
+		}
+		state = newAuctionIncrement(auction)
+		bd.incrementMap[bid.AuctionId] = state
+	}
+	if state.sealed {
+		return true
+	}
+
+	if !state.topLoaded {
+		if err := bd.loadIncrementTop(ctx, bid.AuctionId, state); err != nil {
+			// Sem o vencedor atual não dá para calcular o mínimo - rejeitado como falha de leitura
+			logger.Error(fmt.Sprintf("error trying to load winning bid of auction %s", bid.AuctionId), err)
+			bd.rejectBid(bid, bid_entity.RejectStorageFailure)
+			return false
+		}
+	}
+
+	// Reenvio do próprio vencedor (ex: requeue de failover que chegou a gravar): segue para o insert,
+	// que o trata como duplicado, em vez de ser reportado como rejeitado
+	if state.hasTopBid && state.topBidId != bid.Id {
+		increment, reason, message := bd.requiredIncrement, bid_entity.RejectBelowWinning, "bid rejected: does not beat winning bid"
+		if len(state.schedule) > 0 {
+			increment = state.schedule.IncrementFor(state.topAmount, bd.minBidIncrement)
+			reason, message = bid_entity.RejectBelowIncrement, "bid rejected: below winning bid plus tier increment"
+		}
+		if !bid.BeatsWinningBid(state.topAmount, increment) {
+			logger.Info(message,
+				zap.String("auction_id", bid.AuctionId),
+				zap.String("bid_id", bid.Id),
+				zap.Float64("amount", bid.Amount),
+				zap.Float64("winning_amount", state.topAmount),
+				zap.Float64("increment", increment))
+			bd.rejectBid(bid, reason)
+			return false
+		}
+	}
+
+	state.topAmount = bid.Amount
+	state.topBidId = bid.Id
+	state.hasTopBid = true
+	return true
+}
+
+// loadIncrementTop calcula o lance vencedor atual do leilão (not_found = leilão sem lances)
+// Direto no ComputeWinningBidByAuctionId: o leilão está ativo, então ainda não há vencedor gravado para ler
+func (bd *BidRepository) loadIncrementTop(ctx context.Context, auctionId string, state *auctionIncrement) *internal_error.InternalError {
+	winningBid, err := bd.ComputeWinningBidByAuctionId(ctx, auctionId)
+	if err != nil && err.Err != "not_found" {
+		return err
+	}
+	state.topLoaded = true
+	state.hasTopBid = err == nil
+	if err == nil {
+		state.topAmount = winningBid.Amount
+		state.topBidId = winningBid.Id
+	}
+	return nil
+}
+
+// forgetIncrementTop descarta o maior lance em cache - o próximo lance recarrega do banco
+// Usado quando um lance aceito não virou lance gravado (insert falhou ou era duplicado)
+func (bd *BidRepository) forgetIncrementTop(auctionId string) {
+	bd.incrementMutex.Lock()
+	if state, ok := bd.incrementMap[auctionId]; ok {
+		state.topLoaded = false
+	}
+	bd.incrementMutex.Unlock()
+}
+
+// forgetIncrementSchedule remove o leilão do cache, junto com status e fim (InvalidateAuctionCache)
+func (bd *BidRepository) forgetIncrementSchedule(auctionId string) {
+	bd.incrementMutex.Lock()
+	delete(bd.incrementMap, auctionId)
+	bd.incrementMutex.Unlock()
+}
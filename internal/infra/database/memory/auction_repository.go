@@ -0,0 +1,688 @@
+// Package memory implementa os repositórios em memória (maps + mutex), sem MongoDB
+// Usado em testes dos use cases/controllers e no modo de demonstração (--in-memory / IN_MEMORY=true)
+// Os dados vivem apenas enquanto o processo está de pé - um restart começa do zero
+// No Node.js seria como trocar o Mongoose por um array em memória atrás da mesma interface
+package memory
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/Guilherme-G-Cadilhe/Go-AuctionHouse_Concurrency-Server-Api/configuration/config"
+	"github.com/Guilherme-G-Cadilhe/Go-AuctionHouse_Concurrency-Server-Api/configuration/logger"
+	"github.com/Guilherme-G-Cadilhe/Go-AuctionHouse_Concurrency-Server-Api/internal/clock"
+	"github.com/Guilherme-G-Cadilhe/Go-AuctionHouse_Concurrency-Server-Api/internal/entity/auction_entity"
+	"github.com/Guilherme-G-Cadilhe/Go-AuctionHouse_Concurrency-Server-Api/internal/entity/bid_entity"
+	"github.com/Guilherme-G-Cadilhe/Go-AuctionHouse_Concurrency-Server-Api/internal/internal_error"
+	"go.uber.org/zap"
+)
+
+// AuctionRepository implementa auction_entity.AuctionRepositoryInterface em memória
+// Mesmo comportamento do repositório MongoDB: fim efetivo, fechamento automático por timer,
+// eventos de status e vencedor gravado no fechamento
+type AuctionRepository struct {
+	mutex    sync.RWMutex // RWMutex: várias leituras simultâneas, escrita exclusiva
+	auctions map[string]auction_entity.Auction
+	order    []string // Ordem de inserção - FindAllAuctions devolve na mesma ordem do MongoDB
+	events   []auction_entity.AuctionEvent
+	watchers map[string]map[string]struct{} // Id do leilão -> usuários observando (equivalente à coleção auction_watchers)
+
+	auctionInterval time.Duration
+	closeSkew       time.Duration
+	persistWinner   bool
+	clock           clock.Clock
+
+	closeTimers      map[string]clock.Timer
+	priceDropTimers  map[string]clock.Timer // Próxima queda de preço dos leilões holandeses
+	closeTimersMutex sync.Mutex
+
+	closeListeners   []func(ctx context.Context, auctionId string)
+	winningBidFinder func(ctx context.Context, auctionId string) (*bid_entity.Bid, *internal_error.InternalError)
+	bidsDeleter      func(auctionId string) // Remove os lances do leilão no DeleteAuction (registrada via SetBidsDeleter)
+}
+
+func NewAuctionRepository(cfg config.AuctionConfig, clk clock.Clock) *AuctionRepository {
+	return &AuctionRepository{
+		auctions:        make(map[string]auction_entity.Auction),
+		watchers:        make(map[string]map[string]struct{}),
+		auctionInterval: cfg.Interval,
+		closeSkew:       cfg.CloseSkew,
+		persistWinner:   cfg.PersistWinner,
+		clock:           clk,
+		closeTimers:     make(map[string]clock.Timer),
+		priceDropTimers: make(map[string]clock.Timer),
+	}
+}
+
+func (ar *AuctionRepository) CreateAuction(ctx context.Context, auction *auction_entity.Auction) *internal_error.InternalError {
+	if auction.EndTime.IsZero() {
+		auction.EndTime = auction.Timestamp.Add(ar.auctionInterval)
+	}
+	if auction.UpdatedAt.IsZero() {
+		auction.UpdatedAt = auction.Timestamp
+	}
+
+	ar.mutex.Lock()
+	if _, exists := ar.auctions[auction.Id]; exists {
+		ar.mutex.Unlock()
+		return internal_error.NewInternalServerError("error trying to create auction")
+	}
+	// Guarda uma CÓPIA - alterações no ponteiro de quem chamou não afetam o "banco"
+	ar.auctions[auction.Id] = *auction
+	ar.order = append(ar.order, auction.Id)
+	ar.mutex.Unlock()
+
+	ar.CreateAuctionEvent(ctx, auction_entity.NewAuctionEvent(auction.Id, auction_entity.AuctionCreatedEvent, ar.clock.Now()))
+	ar.scheduleAuctionClose(auction.Id, auction.EndTime)
+	if auction.IsDutch() {
+		ar.schedulePriceDrop(*auction)
+	}
+	return nil
+}
+
+func (ar *AuctionRepository) FindAuctionById(ctx context.Context, id string) (*auction_entity.Auction, *internal_error.InternalError) {
+	ar.mutex.RLock()
+	defer ar.mutex.RUnlock()
+
+	auction, ok := ar.auctions[id]
+	if !ok {
+		return nil, internal_error.NewNotFoundError(fmt.Sprintf("error trying to find auction by id %s", id))
+	}
+	return &auction, nil
+}
+
+// FindAllAuctions aplica os mesmos filtros do MongoDB
+// Status nil não filtra e productName é uma regex case-insensitive
+func (ar *AuctionRepository) FindAllAuctions(
+	ctx context.Context,
+	filter auction_entity.AuctionListFilter) ([]auction_entity.Auction, *internal_error.InternalError) {
+
+	var productNameRegex *regexp.Regexp
+	if filter.ProductName != "" {
+		compiled, err := regexp.Compile("(?i)" + filter.ProductName)
+		if err != nil {
+			logger.Error("error trying to find auctions", err)
+			return nil, internal_error.NewInternalServerError("error trying to find auctions")
+		}
+		productNameRegex = compiled
+	}
+
+	ar.mutex.RLock()
+	defer ar.mutex.RUnlock()
+
+	// SummaryOnly é ignorado: em memória não há leitura a economizar - a entidade completa já está aqui
+	auctions := []auction_entity.Auction{}
+	for _, id := range ar.order {
+		auction := ar.auctions[id]
+		if filter.Status != nil && auction.Status != *filter.Status {
+			continue
+		}
+		if filter.Category != "" && auction.Category != filter.Category {
+			continue
+		}
+		if productNameRegex != nil && !productNameRegex.MatchString(auction.ProductName) {
+			continue
+		}
+		if filter.FeaturedOnly && !auction.Featured {
+			continue
+		}
+		auctions = append(auctions, auction)
+	}
+
+	// SliceStable preserva a ordem de inserção dentro de cada grupo, como o sort por timestamp do MongoDB
+	if filter.FeaturedFirst {
+		sort.SliceStable(auctions, func(i, j int) bool {
+			return auctions[i].Featured && !auctions[j].Featured
+		})
+	}
+	if filter.Limit > 0 && len(auctions) > filter.Limit {
+		auctions = auctions[:filter.Limit]
+	}
+	return auctions, nil
+}
+
+// FindAuctionsByIds segue o $in do MongoDB: ids repetidos ou inexistentes não geram entradas extras
+func (ar *AuctionRepository) FindAuctionsByIds(ctx context.Context, ids []string) ([]auction_entity.Auction, *internal_error.InternalError) {
+	ar.mutex.RLock()
+	defer ar.mutex.RUnlock()
+
+	auctions := []auction_entity.Auction{}
+	seen := make(map[string]struct{}, len(ids))
+	for _, id := range ids {
+		if _, duplicated := seen[id]; duplicated {
+			continue
+		}
+		seen[id] = struct{}{}
+		if auction, ok := ar.auctions[id]; ok {
+			auctions = append(auctions, auction)
+		}
+	}
+	return auctions, nil
+}
+
+// AggregateCategoryCounts ordena como o pipeline do MongoDB: count desc, categoria asc
+func (ar *AuctionRepository) AggregateCategoryCounts(ctx context.Context, status auction_entity.AuctionStatus) ([]auction_entity.CategoryCount, *internal_error.InternalError) {
+	ar.mutex.RLock()
+	countByCategory := make(map[string]int64)
+	for _, auction := range ar.auctions {
+		if auction.Status == status {
+			countByCategory[auction.Category]++
+		}
+	}
+	ar.mutex.RUnlock()
+
+	counts := make([]auction_entity.CategoryCount, 0, len(countByCategory))
+	for category, count := range countByCategory {
+		counts = append(counts, auction_entity.CategoryCount{Category: category, Count: count})
+	}
+	sort.Slice(counts, func(i, j int) bool {
+		if counts[i].Count != counts[j].Count {
+			return counts[i].Count > counts[j].Count
+		}
+		return counts[i].Category < counts[j].Category
+	})
+	return counts, nil
+}
+
+// FindAuctionWinners aplica os mesmos filtros, ordem e paginação da agregação do MongoDB
+func (ar *AuctionRepository) FindAuctionWinners(ctx context.Context, filter auction_entity.AuctionWinnersFilter) ([]auction_entity.AuctionWinner, int64, *internal_error.InternalError) {
+	ar.mutex.RLock()
+	var auctions []auction_entity.Auction
+	for _, auction := range ar.auctions {
+		if auction.Status != auction_entity.Completed {
+			continue
+		}
+		if filter.Category != "" && auction.Category != filter.Category {
+			continue
+		}
+		if !filter.EndedFrom.IsZero() && auction.EndTime.Before(filter.EndedFrom) {
+			continue
+		}
+		if !filter.EndedTo.IsZero() && auction.EndTime.After(filter.EndedTo) {
+			continue
+		}
+		auctions = append(auctions, auction)
+	}
+	ar.mutex.RUnlock()
+
+	sort.Slice(auctions, func(i, j int) bool {
+		if !auctions[i].EndTime.Equal(auctions[j].EndTime) {
+			return auctions[i].EndTime.After(auctions[j].EndTime)
+		}
+		return auctions[i].Id < auctions[j].Id
+	})
+
+	total := int64(len(auctions))
+	start := min(filter.Offset, len(auctions))
+	end := min(start+filter.Limit, len(auctions))
+
+	winners := make([]auction_entity.AuctionWinner, 0, end-start)
+	for _, auction := range auctions[start:end] {
+		winner := auction_entity.AuctionWinner{Auction: auction}
+		if ar.winningBidFinder != nil {
+			if winningBid, err := ar.winningBidFinder(ctx, auction.Id); err == nil {
+				winner.WinningBid = winningBid
+			}
+		}
+		winners = append(winners, winner)
+	}
+	return winners, total, nil
+}
+
+// FindAuctionsLeadByUser aplica o mesmo critério e ordem da agregação do MongoDB
+func (ar *AuctionRepository) FindAuctionsLeadByUser(ctx context.Context, filter auction_entity.UserLeadingFilter) ([]auction_entity.AuctionWinner, *internal_error.InternalError) {
+	ar.mutex.RLock()
+	var auctions []auction_entity.Auction
+	for _, auction := range ar.auctions {
+		if auction.Status == auction_entity.Active && !auction.Sealed {
+			auctions = append(auctions, auction)
+		}
+	}
+	ar.mutex.RUnlock()
+
+	leading := []auction_entity.AuctionWinner{}
+	if ar.winningBidFinder == nil {
+		return leading, nil
+	}
+	for _, auction := range auctions {
+		winningBid, err := ar.winningBidFinder(ctx, auction.Id)
+		if err == nil && winningBid.UserId == filter.UserId {
+			leading = append(leading, auction_entity.AuctionWinner{Auction: auction, WinningBid: winningBid})
+		}
+	}
+
+	sort.Slice(leading, func(i, j int) bool {
+		if !leading[i].Auction.EndTime.Equal(leading[j].Auction.EndTime) {
+			return leading[i].Auction.EndTime.Before(leading[j].Auction.EndTime)
+		}
+		return leading[i].Auction.Id < leading[j].Auction.Id
+	})
+
+	start := min(filter.Offset, len(leading))
+	end := min(start+filter.Limit, len(leading))
+	return leading[start:end], nil
+}
+
+func (ar *AuctionRepository) UpdateAuctionEndTime(ctx context.Context, auctionId string, endTime time.Time, maxExtensions int) *internal_error.InternalError {
+	ar.mutex.Lock()
+	auction, ok := ar.auctions[auctionId]
+	if !ok || auction.Status != auction_entity.Active || (maxExtensions > 0 && auction.ExtensionCount >= maxExtensions) {
+		ar.mutex.Unlock()
+		return internal_error.NewConflictError(fmt.Sprintf("auction %s is not active or reached the maximum number of extensions", auctionId))
+	}
+	auction.EndTime = endTime
+	auction.ExtensionCount++
+	auction.UpdatedAt = ar.clock.Now()
+	ar.auctions[auctionId] = auction
+	ar.mutex.Unlock()
+
+	ar.CreateAuctionEvent(ctx, auction_entity.NewAuctionExtendedEvent(auctionId, endTime, ar.clock.Now()))
+	ar.scheduleAuctionClose(auctionId, endTime)
+	return nil
+}
+
+func (ar *AuctionRepository) SetAuctionFeatured(ctx context.Context, auctionId string, featured bool) *internal_error.InternalError {
+	ar.mutex.Lock()
+	defer ar.mutex.Unlock()
+
+	auction, ok := ar.auctions[auctionId]
+	if !ok {
+		return internal_error.NewNotFoundError(fmt.Sprintf("auction %s not found", auctionId))
+	}
+	auction.Featured = featured
+	auction.UpdatedAt = ar.clock.Now()
+	ar.auctions[auctionId] = auction
+	return nil
+}
+
+// SetAuctionWinner segue o repositório MongoDB: só leilões fechados aceitam o vencedor manual
+func (ar *AuctionRepository) SetAuctionWinner(ctx context.Context, auctionId string, bid bid_entity.Bid) *internal_error.InternalError {
+	ar.mutex.Lock()
+	auction, ok := ar.auctions[auctionId]
+	if !ok || auction.Status != auction_entity.Completed {
+		ar.mutex.Unlock()
+		return internal_error.NewConflictError(fmt.Sprintf("auction %s is not closed", auctionId))
+	}
+	auction.WinningBidId = bid.Id
+	auction.WinningAmount = bid.Amount
+	auction.CloseReason = auction_entity.CloseReasonSold
+	auction.UpdatedAt = ar.clock.Now()
+	ar.auctions[auctionId] = auction
+	ar.mutex.Unlock()
+
+	ar.CreateAuctionEvent(ctx, auction_entity.NewAuctionEvent(auctionId, auction_entity.AuctionWinnerOverriddenEvent, ar.clock.Now()))
+	return nil
+}
+
+// UpdateAuctionWinner segue o repositório MongoDB: nil remove o vencedor e grava no_bids
+func (ar *AuctionRepository) UpdateAuctionWinner(ctx context.Context, auctionId string, winningBid *bid_entity.Bid) *internal_error.InternalError {
+	ar.mutex.Lock()
+	auction, ok := ar.auctions[auctionId]
+	if !ok || auction.Status != auction_entity.Completed {
+		ar.mutex.Unlock()
+		return internal_error.NewConflictError(fmt.Sprintf("auction %s is not closed", auctionId))
+	}
+	auction.WinningBidId = ""
+	auction.WinningAmount = 0
+	auction.CloseReason = auction_entity.CloseReasonNoBids
+	if winningBid != nil {
+		auction.WinningBidId = winningBid.Id
+		auction.WinningAmount = winningBid.Amount
+		auction.CloseReason = auction_entity.CloseReasonSold
+	}
+	auction.UpdatedAt = ar.clock.Now()
+	ar.auctions[auctionId] = auction
+	ar.mutex.Unlock()
+
+	ar.CreateAuctionEvent(ctx, auction_entity.NewAuctionEvent(auctionId, auction_entity.AuctionWinnerRecomputedEvent, ar.clock.Now()))
+	return nil
+}
+
+// DeleteAuction segue o repositório MongoDB: só leilões fechados, junto com lances, eventos e observadores
+// Os lances são removidos com o mutex do leilão travado - ninguém lê o leilão removido com lances ainda gravados
+func (ar *AuctionRepository) DeleteAuction(ctx context.Context, auctionId string) *internal_error.InternalError {
+	ar.mutex.Lock()
+	auction, ok := ar.auctions[auctionId]
+	if !ok {
+		// Remoção concorrente já levou o leilão - 404, não um 409 enganoso
+		ar.mutex.Unlock()
+		return internal_error.NewNotFoundError(fmt.Sprintf("auction %s not found", auctionId))
+	}
+	if auction.Status != auction_entity.Completed {
+		ar.mutex.Unlock()
+		return internal_error.NewConflictError(fmt.Sprintf("auction %s is not closed", auctionId))
+	}
+
+	delete(ar.auctions, auctionId)
+	for i, id := range ar.order {
+		if id == auctionId {
+			ar.order = append(ar.order[:i], ar.order[i+1:]...)
+			break
+		}
+	}
+	events := ar.events[:0]
+	for _, event := range ar.events {
+		if event.AuctionId != auctionId {
+			events = append(events, event)
+		}
+	}
+	ar.events = events
+	delete(ar.watchers, auctionId)
+	if ar.bidsDeleter != nil {
+		ar.bidsDeleter(auctionId)
+	}
+	ar.mutex.Unlock()
+
+	ar.stopCloseTimer(auctionId)
+	ar.stopPriceDrop(auctionId)
+	return nil
+}
+
+func (ar *AuctionRepository) AddAuctionWatcher(ctx context.Context, auctionId, userId string) (int64, *internal_error.InternalError) {
+	ar.mutex.Lock()
+	defer ar.mutex.Unlock()
+
+	if ar.watchers[auctionId] == nil {
+		ar.watchers[auctionId] = make(map[string]struct{})
+	}
+	ar.watchers[auctionId][userId] = struct{}{}
+	return int64(len(ar.watchers[auctionId])), nil
+}
+
+func (ar *AuctionRepository) RemoveAuctionWatcher(ctx context.Context, auctionId, userId string) (int64, *internal_error.InternalError) {
+	ar.mutex.Lock()
+	defer ar.mutex.Unlock()
+
+	// delete em map nil é no-op
+	delete(ar.watchers[auctionId], userId)
+	return int64(len(ar.watchers[auctionId])), nil
+}
+
+func (ar *AuctionRepository) CountAuctionWatchers(ctx context.Context, auctionId string) (int64, *internal_error.InternalError) {
+	ar.mutex.RLock()
+	defer ar.mutex.RUnlock()
+	return int64(len(ar.watchers[auctionId])), nil
+}
+
+func (ar *AuctionRepository) ReopenAuction(ctx context.Context, auctionId string, endTime time.Time) *internal_error.InternalError {
+	ar.mutex.Lock()
+	auction, ok := ar.auctions[auctionId]
+	if !ok || auction.Status != auction_entity.Completed {
+		ar.mutex.Unlock()
+		return internal_error.NewConflictError(fmt.Sprintf("auction %s is not closed", auctionId))
+	}
+	auction.Status = auction_entity.Active
+	auction.EndTime = endTime
+	auction.WinningBidId = ""
+	auction.WinningAmount = 0
+	auction.CloseReason = ""
+	auction.ExtensionCount = 0
+	auction.UpdatedAt = ar.clock.Now()
+	ar.auctions[auctionId] = auction
+	ar.mutex.Unlock()
+
+	ar.CreateAuctionEvent(ctx, auction_entity.NewAuctionEvent(auctionId, auction_entity.AuctionReopenedEvent, ar.clock.Now()))
+	ar.scheduleAuctionClose(auctionId, endTime)
+	return nil
+}
+
+func (ar *AuctionRepository) CreateAuctionEvent(ctx context.Context, event *auction_entity.AuctionEvent) *internal_error.InternalError {
+	ar.mutex.Lock()
+	ar.events = append(ar.events, *event)
+	ar.mutex.Unlock()
+	return nil
+}
+
+// FindAuctionEventsByAuctionId devolve os eventos em ordem cronológica
+func (ar *AuctionRepository) FindAuctionEventsByAuctionId(ctx context.Context, auctionId string) ([]auction_entity.AuctionEvent, *internal_error.InternalError) {
+	ar.mutex.RLock()
+	var events []auction_entity.AuctionEvent
+	for _, event := range ar.events {
+		if event.AuctionId == auctionId {
+			events = append(events, event)
+		}
+	}
+	ar.mutex.RUnlock()
+
+	// SliceStable mantém a ordem de inserção entre eventos do mesmo instante
+	sort.SliceStable(events, func(i, j int) bool {
+		return events[i].Timestamp.Before(events[j].Timestamp)
+	})
+	return events, nil
+}
+
+// OnAuctionClosed registra uma função chamada sempre que um leilão é fechado automaticamente
+func (ar *AuctionRepository) OnAuctionClosed(listener func(ctx context.Context, auctionId string)) {
+	ar.closeListeners = append(ar.closeListeners, listener)
+}
+
+// SetWinningBidFinder registra a busca do lance vencedor usada no fechamento
+func (ar *AuctionRepository) SetWinningBidFinder(finder func(ctx context.Context, auctionId string) (*bid_entity.Bid, *internal_error.InternalError)) {
+	ar.winningBidFinder = finder
+}
+
+// SetBidsDeleter registra a remoção dos lances usada no DeleteAuction
+// Equivale ao DeleteMany na coleção "bids" feito pelo repositório MongoDB
+func (ar *AuctionRepository) SetBidsDeleter(deleter func(auctionId string)) {
+	ar.bidsDeleter = deleter
+}
+
+// RestoreAuctionCloseSchedules existe para manter a mesma API do repositório MongoDB
+// Em memória não há o que restaurar: um restart apaga todos os leilões
+func (ar *AuctionRepository) RestoreAuctionCloseSchedules(ctx context.Context) *internal_error.InternalError {
+	return nil
+}
+
+// ReconcileOverdueAuctions fecha leilões Active com o deadline vencido - mesma lógica do repositório MongoDB
+// Em memória um timer só se perde por bug, mas o comportamento fica idêntico nos dois modos
+func (ar *AuctionRepository) ReconcileOverdueAuctions(ctx context.Context) (int, *internal_error.InternalError) {
+	now := ar.clock.Now()
+
+	ar.mutex.RLock()
+	var overdue []string
+	for _, id := range ar.order {
+		auction := ar.auctions[id]
+		if auction.Status == auction_entity.Active && !auction_entity.IsOpenAt(auction.Status, auction.EndTime, ar.closeSkew, now) {
+			overdue = append(overdue, id)
+		}
+	}
+	ar.mutex.RUnlock()
+
+	reconciled := 0
+	for _, auctionId := range overdue {
+		if !ar.closeAuction(ctx, auctionId) {
+			continue
+		}
+		ar.stopCloseTimer(auctionId)
+		reconciled++
+		logger.Info("auction reconciled: overdue active auction closed",
+			zap.String("auction_id", auctionId))
+	}
+	return reconciled, nil
+}
+
+// stopCloseTimer descarta o timer de fechamento de um leilão já fechado
+func (ar *AuctionRepository) stopCloseTimer(auctionId string) {
+	ar.closeTimersMutex.Lock()
+	if timer, ok := ar.closeTimers[auctionId]; ok {
+		timer.Stop()
+		delete(ar.closeTimers, auctionId)
+	}
+	ar.closeTimersMutex.Unlock()
+}
+
+// scheduleAuctionClose agenda (ou reagenda) o fechamento automático - mesma lógica do repositório MongoDB
+func (ar *AuctionRepository) scheduleAuctionClose(auctionId string, endTime time.Time) {
+	ar.closeTimersMutex.Lock()
+	defer ar.closeTimersMutex.Unlock()
+
+	if timer, ok := ar.closeTimers[auctionId]; ok {
+		timer.Stop()
+	}
+
+	deadline := auction_entity.CloseDeadline(endTime, ar.closeSkew)
+	var timer clock.Timer
+	timer = ar.clock.AfterFunc(deadline.Sub(ar.clock.Now()), func() {
+		ar.closeTimersMutex.Lock()
+		current, ok := ar.closeTimers[auctionId]
+		if !ok || current != timer {
+			ar.closeTimersMutex.Unlock()
+			return
+		}
+		delete(ar.closeTimers, auctionId)
+		ar.closeTimersMutex.Unlock()
+
+		ar.closeAuction(context.Background(), auctionId)
+	})
+	ar.closeTimers[auctionId] = timer
+}
+
+// closeAuction faz a transição Active -> Completed uma única vez
+// Os efeitos colaterais (vencedor, evento, listeners) só rodam para quem fez a transição
+func (ar *AuctionRepository) closeAuction(ctx context.Context, auctionId string) bool {
+	ar.mutex.Lock()
+	auction, ok := ar.auctions[auctionId]
+	if !ok || auction.Status != auction_entity.Active {
+		ar.mutex.Unlock()
+		return false
+	}
+	auction.Status = auction_entity.Completed
+	auction.UpdatedAt = ar.clock.Now()
+	ar.auctions[auctionId] = auction
+	ar.mutex.Unlock()
+
+	ar.stopPriceDrop(auctionId)
+	ar.persistCloseOutcome(ctx, auctionId)
+	ar.notifyAuctionClosed(ctx, auctionId)
+	return true
+}
+
+func (ar *AuctionRepository) notifyAuctionClosed(ctx context.Context, auctionId string) {
+	ar.CreateAuctionEvent(ctx, auction_entity.NewAuctionEvent(auctionId, auction_entity.AuctionClosedEvent, ar.clock.Now()))
+
+	for _, listener := range ar.closeListeners {
+		go listener(ctx, auctionId)
+	}
+}
+
+// schedulePriceDrop agenda a próxima queda de preço - mesma lógica do repositório MongoDB
+func (ar *AuctionRepository) schedulePriceDrop(auction auction_entity.Auction) {
+	nextDropAt, ok := auction.NextPriceDropAt(ar.clock.Now())
+
+	ar.closeTimersMutex.Lock()
+	defer ar.closeTimersMutex.Unlock()
+
+	if timer, exists := ar.priceDropTimers[auction.Id]; exists {
+		timer.Stop()
+		delete(ar.priceDropTimers, auction.Id)
+	}
+	if !ok {
+		return
+	}
+
+	var timer clock.Timer
+	timer = ar.clock.AfterFunc(nextDropAt.Sub(ar.clock.Now()), func() {
+		ar.closeTimersMutex.Lock()
+		current, exists := ar.priceDropTimers[auction.Id]
+		if !exists || current != timer {
+			ar.closeTimersMutex.Unlock()
+			return
+		}
+		delete(ar.priceDropTimers, auction.Id)
+		ar.closeTimersMutex.Unlock()
+
+		ar.applyPriceDrop(auction.Id)
+	})
+	ar.priceDropTimers[auction.Id] = timer
+}
+
+// applyPriceDrop grava o preço da tabela no instante atual e agenda a próxima queda
+func (ar *AuctionRepository) applyPriceDrop(auctionId string) {
+	ar.mutex.Lock()
+	auction, ok := ar.auctions[auctionId]
+	if !ok || auction.Status != auction_entity.Active || !auction.IsDutch() {
+		ar.mutex.Unlock()
+		return
+	}
+	auction.CurrentPrice = auction.DutchPriceAt(ar.clock.Now())
+	auction.UpdatedAt = ar.clock.Now()
+	ar.auctions[auctionId] = auction
+	ar.mutex.Unlock()
+
+	ar.schedulePriceDrop(auction)
+}
+
+func (ar *AuctionRepository) stopPriceDrop(auctionId string) {
+	ar.closeTimersMutex.Lock()
+	defer ar.closeTimersMutex.Unlock()
+
+	if timer, ok := ar.priceDropTimers[auctionId]; ok {
+		timer.Stop()
+		delete(ar.priceDropTimers, auctionId)
+	}
+}
+
+// ClaimDutchAuction fecha o leilão holandês com o lance como vencedor - só o primeiro lance consegue
+func (ar *AuctionRepository) ClaimDutchAuction(ctx context.Context, auctionId string, bid bid_entity.Bid) (bool, *internal_error.InternalError) {
+	ar.mutex.Lock()
+	defer ar.mutex.Unlock()
+
+	auction, ok := ar.auctions[auctionId]
+	if !ok || auction.Status != auction_entity.Active || !auction.IsDutch() {
+		return false, nil
+	}
+	auction.Status = auction_entity.Completed
+	auction.WinningBidId = bid.Id
+	auction.WinningAmount = bid.Amount
+	auction.CloseReason = auction_entity.CloseReasonSold
+	auction.UpdatedAt = ar.clock.Now()
+	ar.auctions[auctionId] = auction
+	return true, nil
+}
+
+// FinishDutchAuctionClose cancela os timers do leilão arrematado e roda os efeitos do fechamento
+func (ar *AuctionRepository) FinishDutchAuctionClose(ctx context.Context, auctionId string) {
+	ar.closeTimersMutex.Lock()
+	if timer, ok := ar.closeTimers[auctionId]; ok {
+		timer.Stop()
+		delete(ar.closeTimers, auctionId)
+	}
+	ar.closeTimersMutex.Unlock()
+
+	ar.stopPriceDrop(auctionId)
+	ar.notifyAuctionClosed(ctx, auctionId)
+}
+
+// persistCloseOutcome grava o motivo do fechamento e, com AUCTION_PERSIST_WINNER, o vencedor
+func (ar *AuctionRepository) persistCloseOutcome(ctx context.Context, auctionId string) {
+	if ar.winningBidFinder == nil {
+		return
+	}
+
+	winningBid, err := ar.winningBidFinder(ctx, auctionId)
+	reason := auction_entity.CloseReasonFor(winningBid, err)
+	if reason == "" {
+		return
+	}
+
+	ar.mutex.Lock()
+	defer ar.mutex.Unlock()
+	if auction, ok := ar.auctions[auctionId]; ok && auction.Status == auction_entity.Completed {
+		auction.CloseReason = reason
+		if reason == auction_entity.CloseReasonSold && ar.persistWinner {
+			auction.WinningBidId = winningBid.Id
+			auction.WinningAmount = winningBid.Amount
+		}
+		auction.UpdatedAt = ar.clock.Now()
+		ar.auctions[auctionId] = auction
+	}
+}
+
+// Ping sempre responde: o armazenamento é o próprio processo
+func (ar *AuctionRepository) Ping(ctx context.Context) *internal_error.InternalError {
+	return nil
+}
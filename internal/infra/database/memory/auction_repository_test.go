@@ -0,0 +1,192 @@
+package memory
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/Guilherme-G-Cadilhe/Go-AuctionHouse_Concurrency-Server-Api/internal/entity/auction_entity"
+	"github.com/Guilherme-G-Cadilhe/Go-AuctionHouse_Concurrency-Server-Api/internal/entity/bid_entity"
+)
+
+// O timer de fechamento, o reconcile e fechamentos diretos disputam o mesmo leilão:
+// só uma transição Active -> Completed pode acontecer e os efeitos rodam uma única vez
+func TestCloseAuctionDoubleCloseRace(t *testing.T) {
+	cfg := testAuctionConfig()
+	ar, bd, clk := newTestRepositories(cfg)
+	auction := createTestAuction(t, ar, clk, nil)
+	if _, err := bd.CreateBidBatch(context.Background(), []bid_entity.Bid{newTestBid(auction.Id, 10, 1, clk)}); err != nil {
+		t.Fatalf("CreateBidBatch: %v", err)
+	}
+
+	listenerCalls := make(chan string, 16)
+	ar.OnAuctionClosed(func(ctx context.Context, auctionId string) {
+		listenerCalls <- auctionId
+	})
+
+	const closers = 8
+	var (
+		wg          sync.WaitGroup
+		mutex       sync.Mutex
+		transitions int
+	)
+	start := make(chan struct{})
+	for i := 0; i < closers; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			<-start
+			if ar.closeAuction(context.Background(), auction.Id) {
+				mutex.Lock()
+				transitions++
+				mutex.Unlock()
+			}
+		}()
+	}
+	wg.Add(2)
+	go func() {
+		defer wg.Done()
+		<-start
+		clk.Advance(cfg.Interval + time.Second)
+	}()
+	go func() {
+		defer wg.Done()
+		<-start
+		ar.ReconcileOverdueAuctions(context.Background())
+	}()
+	close(start)
+	wg.Wait()
+
+	// O timer e o reconcile não contam em transitions - a checagem real é o evento e o listener
+	if transitions > 1 {
+		t.Fatalf("closeAuction reported %d transitions, want at most 1", transitions)
+	}
+
+	select {
+	case <-listenerCalls:
+	case <-time.After(time.Second):
+		t.Fatal("close listener was not called")
+	}
+	// Listeners rodam em goroutines: dá tempo para um segundo disparo indevido aparecer
+	time.Sleep(50 * time.Millisecond)
+	if extra := len(listenerCalls); extra != 0 {
+		t.Fatalf("close listener called %d extra times", extra)
+	}
+
+	events, _ := ar.FindAuctionEventsByAuctionId(context.Background(), auction.Id)
+	closed := 0
+	for _, event := range events {
+		if event.Type == auction_entity.AuctionClosedEvent {
+			closed++
+		}
+	}
+	if closed != 1 {
+		t.Fatalf("got %d closed events, want 1", closed)
+	}
+
+	stored, _ := ar.FindAuctionById(context.Background(), auction.Id)
+	if stored.Status != auction_entity.Completed || stored.WinningAmount != 10 {
+		t.Fatalf("stored auction = status %v winning %v, want Completed with 10", stored.Status, stored.WinningAmount)
+	}
+}
+
+func TestCloseAuctionAlreadyCompletedIsNoop(t *testing.T) {
+	ar, _, clk := newTestRepositories(testAuctionConfig())
+	auction := createTestAuction(t, ar, clk, nil)
+
+	if !ar.closeAuction(context.Background(), auction.Id) {
+		t.Fatal("first close did not transition the auction")
+	}
+	if ar.closeAuction(context.Background(), auction.Id) {
+		t.Fatal("second close transitioned an already completed auction")
+	}
+}
+
+func TestAuctionAutoClosesAtEndTime(t *testing.T) {
+	cfg := testAuctionConfig()
+	ar, _, clk := newTestRepositories(cfg)
+	auction := createTestAuction(t, ar, clk, nil)
+
+	clk.Advance(cfg.Interval - time.Second)
+	if stored, _ := ar.FindAuctionById(context.Background(), auction.Id); stored.Status != auction_entity.Active {
+		t.Fatalf("auction status = %v before EndTime, want Active", stored.Status)
+	}
+
+	clk.Advance(time.Second)
+	stored, _ := ar.FindAuctionById(context.Background(), auction.Id)
+	if stored.Status != auction_entity.Completed {
+		t.Fatalf("auction status = %v at EndTime, want Completed", stored.Status)
+	}
+	if stored.CloseReason != auction_entity.CloseReasonNoBids {
+		t.Fatalf("close reason = %q, want %q", stored.CloseReason, auction_entity.CloseReasonNoBids)
+	}
+}
+
+func TestFindAllAuctionsStatusFilter(t *testing.T) {
+	cfg := testAuctionConfig()
+	ar, _, clk := newTestRepositories(cfg)
+	completed := createTestAuction(t, ar, clk, nil)
+	clk.Advance(cfg.Interval) // Fecha o primeiro leilão
+	active := createTestAuction(t, ar, clk, nil)
+
+	activeStatus, completedStatus := auction_entity.Active, auction_entity.Completed
+	tests := []struct {
+		name    string
+		status  *auction_entity.AuctionStatus
+		wantIds []string
+	}{
+		{"nil lists every status", nil, []string{completed.Id, active.Id}},
+		{"Active lists only active", &activeStatus, []string{active.Id}},
+		{"Completed lists only completed", &completedStatus, []string{completed.Id}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			auctions, err := ar.FindAllAuctions(context.Background(), auction_entity.AuctionListFilter{Status: tt.status})
+			if err != nil {
+				t.Fatalf("FindAllAuctions: %v", err)
+			}
+			if len(auctions) != len(tt.wantIds) {
+				t.Fatalf("got %d auctions, want %d", len(auctions), len(tt.wantIds))
+			}
+			for i, auction := range auctions {
+				if auction.Id != tt.wantIds[i] {
+					t.Fatalf("auction %d = %s, want %s", i, auction.Id, tt.wantIds[i])
+				}
+			}
+		})
+	}
+}
+
+// Timer perdido (crash, bug): o reconcile fecha o leilão vencido uma única vez
+func TestReconcileOverdueAuctionsClosesMissedAuction(t *testing.T) {
+	cfg := testAuctionConfig()
+	ar, _, clk := newTestRepositories(cfg)
+	overdue := createTestAuction(t, ar, clk, nil)
+	ar.stopCloseTimer(overdue.Id)
+
+	clk.Advance(cfg.Interval + time.Second)
+	notYetDue := createTestAuction(t, ar, clk, nil)
+	if stored, _ := ar.FindAuctionById(context.Background(), overdue.Id); stored.Status != auction_entity.Active {
+		t.Fatalf("seeded auction status = %v, want Active", stored.Status)
+	}
+
+	reconciled, err := ar.ReconcileOverdueAuctions(context.Background())
+	if err != nil {
+		t.Fatalf("ReconcileOverdueAuctions: %v", err)
+	}
+	if reconciled != 1 {
+		t.Fatalf("reconciled = %d, want 1", reconciled)
+	}
+	if stored, _ := ar.FindAuctionById(context.Background(), overdue.Id); stored.Status != auction_entity.Completed {
+		t.Fatalf("overdue auction status = %v, want Completed", stored.Status)
+	}
+	if stored, _ := ar.FindAuctionById(context.Background(), notYetDue.Id); stored.Status != auction_entity.Active {
+		t.Fatalf("auction inside its interval status = %v, want Active", stored.Status)
+	}
+
+	// Idempotente: a segunda varredura não encontra nada
+	if reconciled, _ := ar.ReconcileOverdueAuctions(context.Background()); reconciled != 0 {
+		t.Fatalf("second reconcile = %d, want 0", reconciled)
+	}
+}
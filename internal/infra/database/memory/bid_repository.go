@@ -0,0 +1,408 @@
+package memory
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/Guilherme-G-Cadilhe/Go-AuctionHouse_Concurrency-Server-Api/configuration/config"
+	"github.com/Guilherme-G-Cadilhe/Go-AuctionHouse_Concurrency-Server-Api/configuration/logger"
+	"github.com/Guilherme-G-Cadilhe/Go-AuctionHouse_Concurrency-Server-Api/internal/clock"
+	"github.com/Guilherme-G-Cadilhe/Go-AuctionHouse_Concurrency-Server-Api/internal/entity/auction_entity"
+	"github.com/Guilherme-G-Cadilhe/Go-AuctionHouse_Concurrency-Server-Api/internal/entity/bid_entity"
+	"github.com/Guilherme-G-Cadilhe/Go-AuctionHouse_Concurrency-Server-Api/internal/internal_error"
+	"go.uber.org/zap"
+)
+
+// BidRepository implementa bid_entity.BidEntityRepository em memória
+// Lances ficam agrupados por leilão, em ordem de inserção
+type BidRepository struct {
+	mutex             sync.RWMutex
+	bidsByAuction     map[string][]bid_entity.Bid
+	deletedAuctions   map[string]struct{} // Leilões removidos - o CreateBidBatch leu o leilão antes do mutex e precisa rechecar aqui
+	auctionRepository *AuctionRepository  // Consultado para rejeitar lances em leilões fechados e arrematar holandeses
+	closeSkew         time.Duration
+	maxBidsPerAuction int
+	firstBidMinimum   float64 // FIRST_BID_POLICY já resolvido (0 = qualquer valor positivo)
+	minBidIncrement   float64 // AUCTION_MIN_BID_INCREMENT - incremento abaixo da primeira faixa da tabela
+	requiredIncrement float64 // MIN_BID_INCREMENT - quanto o lance supera o vencedor em leilões sem tabela
+	clock             clock.Clock
+	rejectListeners   []bid_entity.BidRejectedListener
+	batchObservers    []bid_entity.BatchObserver
+}
+
+func NewBidRepository(auctionRepository *AuctionRepository, cfg config.AuctionConfig, clk clock.Clock) *BidRepository {
+	return &BidRepository{
+		bidsByAuction:     make(map[string][]bid_entity.Bid),
+		deletedAuctions:   make(map[string]struct{}),
+		auctionRepository: auctionRepository,
+		closeSkew:         cfg.CloseSkew,
+		maxBidsPerAuction: cfg.MaxBidsPerAuction,
+		firstBidMinimum:   bid_entity.ParseFirstBidPolicy(cfg.FirstBidPolicy).MinimumFirstBid(cfg.StartingPrice, cfg.MinBidIncrement),
+		minBidIncrement:   cfg.MinBidIncrement,
+		requiredIncrement: cfg.RequiredIncrement,
+		clock:             clk,
+	}
+}
+
+// CreateBidBatch aplica as mesmas regras do repositório MongoDB:
+// lances em leilões inexistentes, fechados ou após o deadline (fim + AUCTION_CLOSE_SKEW) são descartados sem erro
+// Sem cache: a leitura do leilão em memória já é barata; sem failover, nenhum lance volta para reenvio
+// Os lances de cada leilão são avaliados do mais forte para o mais fraco, como no MongoDB (GroupBatchByAuction)
+func (bd *BidRepository) CreateBidBatch(ctx context.Context, bidEntities []bid_entity.Bid) ([]bid_entity.Bid, *internal_error.InternalError) {
+	var acceptedBids []bid_entity.Bid
+	var orderedBids []bid_entity.Bid
+	for _, auctionBids := range bid_entity.GroupBatchByAuction(bidEntities) {
+		orderedBids = append(orderedBids, auctionBids...)
+	}
+	for _, bid := range orderedBids {
+		auction, err := bd.auctionRepository.FindAuctionById(ctx, bid.AuctionId)
+		if err != nil {
+			logger.Error(fmt.Sprintf("error trying to find auction by id %s", bid.AuctionId), err)
+			bd.rejectBid(bid, bid_entity.RejectAuctionNotFound)
+			continue
+		}
+		// Sem cache: o leilão é sempre lido do repositório, então não existe "cache_closed"
+		if reason := auction_entity.NotOpenReason(auction.Status, auction.EndTime, bd.closeSkew, bd.clock.Now(), false); reason != "" {
+			logger.Info("bid rejected: auction is not open",
+				zap.String("reason", reason),
+				zap.String("auction_id", bid.AuctionId),
+				zap.String("bid_id", bid.Id))
+			bd.rejectBid(bid, bid_entity.RejectAuctionClosed)
+			continue // Lance rejeitado - leilão fechado
+		}
+		if auction.IsDutch() {
+			if bd.createDutchBid(ctx, auction, bid) {
+				acceptedBids = append(acceptedBids, bid)
+			}
+			continue
+		}
+
+		bd.mutex.Lock()
+		// Mesmo efeito do duplicate key no _id do MongoDB: reenvio do mesmo id é ignorado
+		reason := ""
+		switch {
+		case bd.isDeleted(bid.AuctionId):
+			// O leilão foi removido depois da leitura acima - o lance não pode virar órfão
+			reason = bid_entity.RejectAuctionNotFound
+		case bd.containsBid(bid):
+		case !bd.acceptsFirstBid(bid):
+			reason = bid_entity.RejectFirstBidMinimum
+		case !bd.beatsWinningBid(auction, bid):
+			reason = bid_entity.RejectBelowWinning
+			if auction.HasIncrementSchedule() {
+				reason = bid_entity.RejectBelowIncrement
+			}
+		case !bd.underBidCap(bid):
+			reason = bid_entity.RejectBidCapReached
+		default:
+			bd.bidsByAuction[bid.AuctionId] = append(bd.bidsByAuction[bid.AuctionId], bid)
+		}
+		bd.mutex.Unlock()
+
+		// Fora do mutex: o listener não pode travar o repositório
+		// Reenvio ignorado (containsBid) também conta como gravado - mesmo "pelo menos uma vez" do MongoDB
+		if reason != "" {
+			bd.rejectBid(bid, reason)
+		} else {
+			acceptedBids = append(acceptedBids, bid)
+		}
+	}
+
+	if len(acceptedBids) > 0 {
+		for _, observer := range bd.batchObservers {
+			observer.OnBatchAccepted(acceptedBids)
+		}
+	}
+	return nil, nil
+}
+
+// createDutchBid segue o repositório MongoDB: o primeiro lance >= preço atual arremata o leilão
+// Retorna true quando o lance foi gravado
+func (bd *BidRepository) createDutchBid(ctx context.Context, auction *auction_entity.Auction, bid bid_entity.Bid) bool {
+	currentPrice := auction.DutchPriceAt(bd.clock.Now())
+	// Preço calculado (início - n * decremento) - a comparação tolera o erro de float (AMOUNT_EPSILON)
+	if bid_entity.CompareAmounts(bid.Amount, currentPrice) < 0 {
+		logger.Info("bid rejected: below dutch auction current price",
+			zap.String("auction_id", bid.AuctionId),
+			zap.String("bid_id", bid.Id),
+			zap.Float64("amount", bid.Amount),
+			zap.Float64("current_price", currentPrice))
+		bd.rejectBid(bid, bid_entity.RejectBelowDutchPrice)
+		return false
+	}
+
+	if claimed, _ := bd.auctionRepository.ClaimDutchAuction(ctx, auction.Id, bid); !claimed {
+		logger.Info("bid rejected: dutch auction already claimed",
+			zap.String("auction_id", bid.AuctionId),
+			zap.String("bid_id", bid.Id))
+		bd.rejectBid(bid, bid_entity.RejectDutchClaimed)
+		return false
+	}
+
+	bd.mutex.Lock()
+	if bd.isDeleted(bid.AuctionId) {
+		bd.mutex.Unlock()
+		bd.rejectBid(bid, bid_entity.RejectAuctionNotFound)
+		return false
+	}
+	bd.bidsByAuction[bid.AuctionId] = append(bd.bidsByAuction[bid.AuctionId], bid)
+	bd.mutex.Unlock()
+
+	bd.auctionRepository.FinishDutchAuctionClose(ctx, auction.Id)
+	return true
+}
+
+func (bd *BidRepository) OnBidRejected(listener bid_entity.BidRejectedListener) {
+	bd.rejectListeners = append(bd.rejectListeners, listener)
+}
+
+func (bd *BidRepository) AddBatchObserver(observer bid_entity.BatchObserver) {
+	bd.batchObservers = append(bd.batchObservers, observer)
+}
+
+func (bd *BidRepository) rejectBid(bid bid_entity.Bid, reason string) {
+	for _, listener := range bd.rejectListeners {
+		listener(bid, reason)
+	}
+}
+
+// DeleteBidsByAuctionId remove todos os lances do leilão (DeleteAuction do repositório de leilões)
+// O leilão fica marcado como removido: um batch que já o leu antes da remoção não grava lances órfãos
+// A marcação nunca é limpa - ids de leilão são UUIDs e não voltam a existir
+func (bd *BidRepository) DeleteBidsByAuctionId(auctionId string) {
+	bd.mutex.Lock()
+	delete(bd.bidsByAuction, auctionId)
+	bd.deletedAuctions[auctionId] = struct{}{}
+	bd.mutex.Unlock()
+}
+
+// isDeleted indica se o leilão foi removido pelo DeleteAuction
+// Deve ser chamado com o mutex travado - é o mesmo mutex do DeleteBidsByAuctionId
+func (bd *BidRepository) isDeleted(auctionId string) bool {
+	_, deleted := bd.deletedAuctions[auctionId]
+	return deleted
+}
+
+// containsBid procura o id em todos os leilões, como a unicidade do _id na coleção "bids"
+// Deve ser chamado com o mutex travado
+func (bd *BidRepository) containsBid(bid bid_entity.Bid) bool {
+	for _, bids := range bd.bidsByAuction {
+		for _, stored := range bids {
+			if stored.Id == bid.Id {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// acceptsFirstBid aplica o FIRST_BID_POLICY: abaixo do mínimo o lance só entra se o leilão já tiver lances
+// Deve ser chamado com o mutex travado
+func (bd *BidRepository) acceptsFirstBid(bid bid_entity.Bid) bool {
+	if bd.firstBidMinimum <= 0 || bid.MeetsMinimum(bd.firstBidMinimum) || len(bd.bidsByAuction[bid.AuctionId]) > 0 {
+		return true
+	}
+
+	logger.Info("bid rejected: first bid below FIRST_BID_POLICY minimum",
+		zap.String("auction_id", bid.AuctionId),
+		zap.String("bid_id", bid.Id),
+		zap.Float64("amount", bid.Amount),
+		zap.Float64("minimum", bd.firstBidMinimum))
+	return false
+}
+
+// beatsWinningBid exige que o lance supere o vencedor: pelo incremento da faixa nos leilões com tabela,
+// pelo MIN_BID_INCREMENT nos demais (0 = basta ser maior)
+// Leilões sealed-bid ficam de fora, como no MongoDB: a rejeição revelaria o vencedor oculto
+// O primeiro lance fica com o FIRST_BID_POLICY; deve ser chamado com o mutex travado
+func (bd *BidRepository) beatsWinningBid(auction *auction_entity.Auction, bid bid_entity.Bid) bool {
+	bids := bd.bidsByAuction[bid.AuctionId]
+	if auction.Sealed || len(bids) == 0 {
+		return true
+	}
+
+	var topAmount float64
+	for _, stored := range bids {
+		topAmount = max(topAmount, stored.Amount)
+	}
+	increment, message := bd.requiredIncrement, "bid rejected: does not beat winning bid"
+	if auction.HasIncrementSchedule() {
+		increment, message = auction.BidIncrementAt(topAmount, bd.minBidIncrement), "bid rejected: below winning bid plus tier increment"
+	}
+	if bid.BeatsWinningBid(topAmount, increment) {
+		return true
+	}
+
+	logger.Info(message,
+		zap.String("auction_id", bid.AuctionId),
+		zap.String("bid_id", bid.Id),
+		zap.Float64("amount", bid.Amount),
+		zap.Float64("winning_amount", topAmount),
+		zap.Float64("increment", increment))
+	return false
+}
+
+// underBidCap aplica o MAX_BIDS_PER_AUCTION: no limite só entra o lance que supera o maior lance
+// Deve ser chamado com o mutex travado
+func (bd *BidRepository) underBidCap(bid bid_entity.Bid) bool {
+	bids := bd.bidsByAuction[bid.AuctionId]
+	if bd.maxBidsPerAuction <= 0 || len(bids) < bd.maxBidsPerAuction {
+		return true
+	}
+
+	var topAmount float64
+	for _, stored := range bids {
+		topAmount = max(topAmount, stored.Amount)
+	}
+	if bid_entity.CompareAmounts(bid.Amount, topAmount) > 0 {
+		return true
+	}
+
+	logger.Info("bid rejected: auction reached MAX_BIDS_PER_AUCTION",
+		zap.String("auction_id", bid.AuctionId),
+		zap.String("bid_id", bid.Id),
+		zap.Int("stored_bids", len(bids)),
+		zap.Float64("amount", bid.Amount),
+		zap.Float64("winning_amount", topAmount))
+	return false
+}
+
+func (bd *BidRepository) CountBidsByAuctionId(ctx context.Context, auctionId string) (int64, *internal_error.InternalError) {
+	bd.mutex.RLock()
+	defer bd.mutex.RUnlock()
+	return int64(len(bd.bidsByAuction[auctionId])), nil
+}
+
+// FindBidByAuctionId devolve uma cópia dos lances - quem chama pode alterar o slice sem afetar o repositório
+func (bd *BidRepository) FindBidByAuctionId(ctx context.Context, auctionId string, filter bid_entity.BidFilter) ([]bid_entity.Bid, *internal_error.InternalError) {
+	bd.mutex.RLock()
+	defer bd.mutex.RUnlock()
+
+	bids := []bid_entity.Bid{}
+	for _, bid := range bd.bidsByAuction[auctionId] {
+		// Mesma precisão do MongoDB, que grava o timestamp em segundos
+		if !filter.Since.IsZero() && bid.Timestamp.Unix() < filter.Since.Unix() {
+			continue
+		}
+		if filter.MinAmount > 0 && bid.Amount < filter.MinAmount {
+			continue
+		}
+		if filter.After != nil && filter.After.Before(bid) {
+			continue
+		}
+		bids = append(bids, bid)
+	}
+
+	// Mesma ordem (timestamp em segundos, id) e limite do MongoDB
+	if filter.Paginated() {
+		sort.Slice(bids, func(i, j int) bool {
+			if bids[i].Timestamp.Unix() != bids[j].Timestamp.Unix() {
+				return bids[i].Timestamp.Unix() < bids[j].Timestamp.Unix()
+			}
+			return bids[i].Id < bids[j].Id
+		})
+		if filter.Limit > 0 && len(bids) > filter.Limit {
+			bids = bids[:filter.Limit]
+		}
+	}
+	return bids, nil
+}
+
+// FindWinningBidByAuctionId usa o vencedor gravado no fechamento quando existir
+// Caso contrário procura o maior lance (empate: o submetido primeiro, pela Sequence)
+func (bd *BidRepository) FindWinningBidByAuctionId(ctx context.Context, auctionId string) (*bid_entity.Bid, *internal_error.InternalError) {
+	var winningBidId string
+	if auction, err := bd.auctionRepository.FindAuctionById(ctx, auctionId); err == nil &&
+		auction.Status == auction_entity.Completed {
+		winningBidId = auction.WinningBidId
+	}
+
+	return bd.findWinningBid(auctionId, winningBidId)
+}
+
+// ComputeWinningBidByAuctionId procura o maior lance mesmo com um vencedor gravado (reconciliação)
+func (bd *BidRepository) ComputeWinningBidByAuctionId(ctx context.Context, auctionId string) (*bid_entity.Bid, *internal_error.InternalError) {
+	return bd.findWinningBid(auctionId, "")
+}
+
+// findWinningBid devolve o lance winningBidId ou, vazio, o que vence pela regra do Outranks
+func (bd *BidRepository) findWinningBid(auctionId, winningBidId string) (*bid_entity.Bid, *internal_error.InternalError) {
+	bd.mutex.RLock()
+	defer bd.mutex.RUnlock()
+
+	var winningBid *bid_entity.Bid
+	for i, bid := range bd.bidsByAuction[auctionId] {
+		if winningBidId != "" {
+			if bid.Id == winningBidId {
+				winningBid = &bd.bidsByAuction[auctionId][i]
+				break
+			}
+			continue
+		}
+		if bid.Outranks(winningBid) {
+			winningBid = &bd.bidsByAuction[auctionId][i]
+		}
+	}
+
+	if winningBid == nil {
+		message := fmt.Sprintf("error trying to find winning bid by auction id %s", auctionId)
+		return nil, internal_error.NewNotFoundError(message)
+	}
+
+	bid := *winningBid
+	return &bid, nil
+}
+
+func (bd *BidRepository) FindBidById(ctx context.Context, bidId string) (*bid_entity.Bid, *internal_error.InternalError) {
+	bd.mutex.RLock()
+	defer bd.mutex.RUnlock()
+
+	for _, bids := range bd.bidsByAuction {
+		for _, bid := range bids {
+			if bid.Id == bidId {
+				return &bid, nil
+			}
+		}
+	}
+	return nil, internal_error.NewNotFoundError(fmt.Sprintf("bid with id %s not found", bidId))
+}
+
+// InvalidateAuctionCache não faz nada - este repositório não mantém cache de leilões
+func (bd *BidRepository) InvalidateAuctionCache(auctionId string) {}
+
+func (bd *BidRepository) AnonymizeBidsByUserId(ctx context.Context, userId string) *internal_error.InternalError {
+	bd.mutex.Lock()
+	defer bd.mutex.Unlock()
+
+	for _, bids := range bd.bidsByAuction {
+		for i := range bids {
+			if bids[i].UserId == userId {
+				bids[i].UserId = bid_entity.DeletedUserId
+			}
+		}
+	}
+	return nil
+}
+
+// FindBidPriceSummary percorre os lances do leilão guardando o maior valor e a contagem
+func (bd *BidRepository) FindBidPriceSummary(ctx context.Context, auctionId string) (*bid_entity.BidPriceSummary, *internal_error.InternalError) {
+	bd.mutex.RLock()
+	defer bd.mutex.RUnlock()
+
+	summary := &bid_entity.BidPriceSummary{}
+	for _, bid := range bd.bidsByAuction[auctionId] {
+		if summary.BidCount == 0 || bid.Amount > summary.HighestAmount {
+			summary.HighestAmount = bid.Amount
+		}
+		summary.BidCount++
+	}
+	return summary, nil
+}
+
+// Ping sempre responde: o armazenamento é o próprio processo
+func (bd *BidRepository) Ping(ctx context.Context) *internal_error.InternalError {
+	return nil
+}
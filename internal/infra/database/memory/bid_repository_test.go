@@ -0,0 +1,241 @@
+package memory
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/Guilherme-G-Cadilhe/Go-AuctionHouse_Concurrency-Server-Api/internal/entity/auction_entity"
+	"github.com/Guilherme-G-Cadilhe/Go-AuctionHouse_Concurrency-Server-Api/internal/entity/bid_entity"
+)
+
+// Dois lances do mesmo leilão no mesmo batch: o maior vence, em qualquer ordem de chegada
+func TestCreateBidBatchSameAuctionHigherBidWins(t *testing.T) {
+	for _, name := range []string{"low first", "high first"} {
+		t.Run(name, func(t *testing.T) {
+			ar, bd, clk := newTestRepositories(testAuctionConfig())
+			rejections := recordRejections(bd)
+			auction := createTestAuction(t, ar, clk, nil)
+
+			low := newTestBid(auction.Id, 10, 1, clk)
+			high := newTestBid(auction.Id, 20, 2, clk)
+			batch := []bid_entity.Bid{low, high}
+			if name == "high first" {
+				batch = []bid_entity.Bid{high, low}
+			}
+
+			if _, err := bd.CreateBidBatch(context.Background(), batch); err != nil {
+				t.Fatalf("CreateBidBatch: %v", err)
+			}
+
+			if got := storedAmounts(t, bd, auction.Id); !slices.Equal(got, []float64{20}) {
+				t.Errorf("stored amounts = %v, want [20]", got)
+			}
+			if got := rejections.reason(low.Id); got != bid_entity.RejectBelowWinning {
+				t.Errorf("low bid rejection = %q, want %q", got, bid_entity.RejectBelowWinning)
+			}
+		})
+	}
+}
+
+// Empate no mesmo batch: fica o submetido primeiro (menor sequence)
+func TestCreateBidBatchTieKeepsFirstSubmitted(t *testing.T) {
+	ar, bd, clk := newTestRepositories(testAuctionConfig())
+	auction := createTestAuction(t, ar, clk, nil)
+
+	first := newTestBid(auction.Id, 15, 1, clk)
+	second := newTestBid(auction.Id, 15, 2, clk)
+	if _, err := bd.CreateBidBatch(context.Background(), []bid_entity.Bid{second, first}); err != nil {
+		t.Fatalf("CreateBidBatch: %v", err)
+	}
+
+	winner, err := bd.FindWinningBidByAuctionId(context.Background(), auction.Id)
+	if err != nil {
+		t.Fatalf("FindWinningBidByAuctionId: %v", err)
+	}
+	if winner.Id != first.Id {
+		t.Errorf("winner = %s, want the first submitted bid %s", winner.Id, first.Id)
+	}
+	if got := storedAmounts(t, bd, auction.Id); len(got) != 1 {
+		t.Errorf("stored amounts = %v, want only the first bid", got)
+	}
+}
+
+func TestCreateBidBatchRequiredIncrement(t *testing.T) {
+	cfg := testAuctionConfig()
+	cfg.RequiredIncrement = 5
+	ar, bd, clk := newTestRepositories(cfg)
+	rejections := recordRejections(bd)
+	auction := createTestAuction(t, ar, clk, nil)
+
+	ctx := context.Background()
+	bd.CreateBidBatch(ctx, []bid_entity.Bid{newTestBid(auction.Id, 10, 1, clk)})
+
+	below := newTestBid(auction.Id, 14, 2, clk)
+	bd.CreateBidBatch(ctx, []bid_entity.Bid{below})
+	if got := rejections.reason(below.Id); got != bid_entity.RejectBelowWinning {
+		t.Errorf("14 over 10 rejection = %q, want %q", got, bid_entity.RejectBelowWinning)
+	}
+
+	exact := newTestBid(auction.Id, 15, 3, clk)
+	bd.CreateBidBatch(ctx, []bid_entity.Bid{exact})
+	if got := rejections.reason(exact.Id); got != "" {
+		t.Errorf("15 over 10 rejected with %q, want accepted", got)
+	}
+	if got := storedAmounts(t, bd, auction.Id); !slices.Equal(got, []float64{10, 15}) {
+		t.Errorf("stored amounts = %v, want [10 15]", got)
+	}
+}
+
+// Sealed-bid aceita lances abaixo do vencedor oculto - a rejeição revelaria o maior lance
+func TestCreateBidBatchSealedSkipsWinnerCheck(t *testing.T) {
+	cfg := testAuctionConfig()
+	cfg.RequiredIncrement = 5
+	ar, bd, clk := newTestRepositories(cfg)
+	rejections := recordRejections(bd)
+	auction := createTestAuction(t, ar, clk, func(a *auction_entity.Auction) { a.Sealed = true })
+
+	high := newTestBid(auction.Id, 50, 1, clk)
+	low := newTestBid(auction.Id, 10, 2, clk)
+	bd.CreateBidBatch(context.Background(), []bid_entity.Bid{high})
+	bd.CreateBidBatch(context.Background(), []bid_entity.Bid{low})
+
+	if got := rejections.reason(low.Id); got != "" {
+		t.Errorf("sealed bid below the winner rejected with %q", got)
+	}
+	if got := storedAmounts(t, bd, auction.Id); !slices.Equal(got, []float64{50, 10}) {
+		t.Errorf("stored amounts = %v, want [50 10]", got)
+	}
+}
+
+// O lance e o fechamento usam o mesmo deadline (fim + AUCTION_CLOSE_SKEW)
+func TestCreateBidBatchCloseSkewBoundary(t *testing.T) {
+	cfg := testAuctionConfig()
+	cfg.CloseSkew = 2 * time.Second
+	ar, bd, clk := newTestRepositories(cfg)
+	auction := createTestAuction(t, ar, clk, nil)
+	rejections := recordRejections(bd)
+
+	// Depois do EndTime, dentro da tolerância: leilão ainda ativo e o lance é aceito
+	clk.Advance(cfg.Interval + time.Second)
+	inside := newTestBid(auction.Id, 10, 1, clk)
+	if _, err := bd.CreateBidBatch(context.Background(), []bid_entity.Bid{inside}); err != nil {
+		t.Fatalf("CreateBidBatch: %v", err)
+	}
+	if reason := rejections.reason(inside.Id); reason != "" {
+		t.Fatalf("bid inside the skew was rejected: %s", reason)
+	}
+
+	// No deadline o timer fecha o leilão e o lance seguinte é descartado
+	clk.Advance(time.Second)
+	if stored, _ := ar.FindAuctionById(context.Background(), auction.Id); stored.Status != auction_entity.Completed {
+		t.Fatalf("auction status at the deadline = %v, want Completed", stored.Status)
+	}
+	late := newTestBid(auction.Id, 20, 2, clk)
+	if _, err := bd.CreateBidBatch(context.Background(), []bid_entity.Bid{late}); err != nil {
+		t.Fatalf("CreateBidBatch: %v", err)
+	}
+	if rejections.reason(late.Id) == "" {
+		t.Fatal("bid at the deadline was accepted")
+	}
+	if amounts := storedAmounts(t, bd, auction.Id); len(amounts) != 1 || amounts[0] != 10 {
+		t.Fatalf("stored amounts = %v, want [10]", amounts)
+	}
+}
+
+func TestCreateBidBatchFirstBidPolicy(t *testing.T) {
+	tests := []struct {
+		policy       string
+		firstAmount  float64
+		wantAccepted bool
+	}{
+		{"any_positive", 1, true},
+		{"meet_start", 9.99, false},
+		{"meet_start", 10, true},
+		{"meet_start_plus_increment", 10, false},
+		{"meet_start_plus_increment", 11, true},
+	}
+	for _, tt := range tests {
+		t.Run(fmt.Sprintf("%s %v", tt.policy, tt.firstAmount), func(t *testing.T) {
+			cfg := testAuctionConfig()
+			cfg.StartingPrice = 10
+			cfg.FirstBidPolicy = tt.policy
+			ar, bd, clk := newTestRepositories(cfg)
+			auction := createTestAuction(t, ar, clk, nil)
+			rejections := recordRejections(bd)
+
+			first := newTestBid(auction.Id, tt.firstAmount, 1, clk)
+			if _, err := bd.CreateBidBatch(context.Background(), []bid_entity.Bid{first}); err != nil {
+				t.Fatalf("CreateBidBatch: %v", err)
+			}
+			reason := rejections.reason(first.Id)
+			if tt.wantAccepted && reason != "" {
+				t.Fatalf("first bid %v rejected: %s", tt.firstAmount, reason)
+			}
+			if !tt.wantAccepted && reason != bid_entity.RejectFirstBidMinimum {
+				t.Fatalf("first bid %v: reason %q, want %q", tt.firstAmount, reason, bid_entity.RejectFirstBidMinimum)
+			}
+		})
+	}
+}
+
+// O mínimo vale só para o primeiro lance: depois dele basta superar o vencedor
+func TestCreateBidBatchFirstBidPolicyOnlyAppliesToFirstBid(t *testing.T) {
+	cfg := testAuctionConfig()
+	cfg.StartingPrice = 10
+	cfg.MinBidIncrement = 5
+	cfg.FirstBidPolicy = "meet_start_plus_increment"
+	ar, bd, clk := newTestRepositories(cfg)
+	auction := createTestAuction(t, ar, clk, nil)
+
+	first := newTestBid(auction.Id, 15, 1, clk)
+	second := newTestBid(auction.Id, 16, 2, clk)
+	for _, bid := range []bid_entity.Bid{first, second} {
+		if _, err := bd.CreateBidBatch(context.Background(), []bid_entity.Bid{bid}); err != nil {
+			t.Fatalf("CreateBidBatch: %v", err)
+		}
+	}
+	if amounts := storedAmounts(t, bd, auction.Id); !slices.Equal(amounts, []float64{15, 16}) {
+		t.Fatalf("stored amounts = %v, want [15 16]", amounts)
+	}
+}
+
+// Com tabela de incrementos o lance precisa superar o vencedor pelo incremento da faixa dele
+func TestCreateBidBatchIncrementScheduleTiers(t *testing.T) {
+	schedule := auction_entity.IncrementSchedule{{Threshold: 0, Increment: 1}, {Threshold: 100, Increment: 5}, {Threshold: 500, Increment: 25}}
+
+	tests := []struct {
+		winning    float64
+		amount     float64
+		wantReject bool
+	}{
+		{99, 99.99, true},
+		{99, 100, false},
+		{100, 104.99, true}, // 100 já está na faixa de +5
+		{100, 105, false},
+		{500, 524, true},
+		{500, 525, false},
+	}
+	for _, tt := range tests {
+		t.Run(fmt.Sprintf("%v over %v", tt.amount, tt.winning), func(t *testing.T) {
+			ar, bd, clk := newTestRepositories(testAuctionConfig())
+			rejections := recordRejections(bd)
+			auction := createTestAuction(t, ar, clk, func(a *auction_entity.Auction) { a.IncrementSchedule = schedule })
+
+			ctx := context.Background()
+			bd.CreateBidBatch(ctx, []bid_entity.Bid{newTestBid(auction.Id, tt.winning, 1, clk)})
+			bid := newTestBid(auction.Id, tt.amount, 2, clk)
+			bd.CreateBidBatch(ctx, []bid_entity.Bid{bid})
+
+			want := ""
+			if tt.wantReject {
+				want = bid_entity.RejectBelowIncrement
+			}
+			if got := rejections.reason(bid.Id); got != want {
+				t.Errorf("rejection = %q, want %q", got, want)
+			}
+		})
+	}
+}
@@ -0,0 +1,73 @@
+package memory
+
+import (
+	"context"
+	"testing"
+
+	"github.com/Guilherme-G-Cadilhe/Go-AuctionHouse_Concurrency-Server-Api/internal/entity/bid_entity"
+)
+
+func TestDeleteAuctionErrors(t *testing.T) {
+	ar, _, clk := newTestRepositories(testAuctionConfig())
+	active := createTestAuction(t, ar, clk, nil)
+
+	if err := ar.DeleteAuction(context.Background(), "missing"); err == nil || err.Err != "not_found" {
+		t.Fatalf("missing auction: err = %v, want not_found", err)
+	}
+	if err := ar.DeleteAuction(context.Background(), active.Id); err == nil || err.Err != "conflict" {
+		t.Fatalf("active auction: err = %v, want conflict", err)
+	}
+}
+
+// Remoção leva lances, eventos e observadores juntos; uma segunda remoção (concorrente) recebe 404
+func TestDeleteAuctionCascadesBids(t *testing.T) {
+	cfg := testAuctionConfig()
+	ar, bd, clk := newTestRepositories(cfg)
+	auction := createTestAuction(t, ar, clk, nil)
+	ctx := context.Background()
+	bd.CreateBidBatch(ctx, []bid_entity.Bid{newTestBid(auction.Id, 10, 1, clk)})
+	bd.CreateBidBatch(ctx, []bid_entity.Bid{newTestBid(auction.Id, 20, 2, clk)})
+	ar.AddAuctionWatcher(ctx, auction.Id, testBidderId)
+	clk.Advance(cfg.Interval)
+
+	if err := ar.DeleteAuction(ctx, auction.Id); err != nil {
+		t.Fatalf("DeleteAuction: %v", err)
+	}
+
+	if _, err := ar.FindAuctionById(ctx, auction.Id); err == nil || err.Err != "not_found" {
+		t.Fatalf("FindAuctionById after delete: err = %v, want not_found", err)
+	}
+	if got := storedAmounts(t, bd, auction.Id); len(got) != 0 {
+		t.Fatalf("stored amounts after delete = %v, want none", got)
+	}
+	if events, _ := ar.FindAuctionEventsByAuctionId(ctx, auction.Id); len(events) != 0 {
+		t.Fatalf("got %d events after delete, want none", len(events))
+	}
+	if watchers, _ := ar.CountAuctionWatchers(ctx, auction.Id); watchers != 0 {
+		t.Fatalf("got %d watchers after delete, want none", watchers)
+	}
+
+	if err := ar.DeleteAuction(ctx, auction.Id); err == nil || err.Err != "not_found" {
+		t.Fatalf("second delete: err = %v, want not_found", err)
+	}
+}
+
+// Batch que leu o leilão antes da remoção: o leilão ainda está no repositório de leilões (leitura antiga),
+// mas a remoção dos lances já aconteceu - a gravação é rejeitada em vez de deixar um lance órfão
+func TestCreateBidBatchAfterDeleteLeavesNoOrphans(t *testing.T) {
+	ar, bd, clk := newTestRepositories(testAuctionConfig())
+	rejections := recordRejections(bd)
+	auction := createTestAuction(t, ar, clk, nil)
+
+	// Mesmo passo que o DeleteAuction executa sob o mutex do leilão
+	bd.DeleteBidsByAuctionId(auction.Id)
+
+	bid := newTestBid(auction.Id, 10, 1, clk)
+	bd.CreateBidBatch(context.Background(), []bid_entity.Bid{bid})
+	if got := rejections.reason(bid.Id); got != bid_entity.RejectAuctionNotFound {
+		t.Fatalf("rejection = %q, want %q", got, bid_entity.RejectAuctionNotFound)
+	}
+	if got := storedAmounts(t, bd, auction.Id); len(got) != 0 {
+		t.Fatalf("stored amounts = %v, want no orphan bids", got)
+	}
+}
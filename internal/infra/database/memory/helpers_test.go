@@ -0,0 +1,104 @@
+package memory
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/Guilherme-G-Cadilhe/Go-AuctionHouse_Concurrency-Server-Api/configuration/config"
+	"github.com/Guilherme-G-Cadilhe/Go-AuctionHouse_Concurrency-Server-Api/internal/clock"
+	"github.com/Guilherme-G-Cadilhe/Go-AuctionHouse_Concurrency-Server-Api/internal/entity/auction_entity"
+	"github.com/Guilherme-G-Cadilhe/Go-AuctionHouse_Concurrency-Server-Api/internal/entity/bid_entity"
+	"github.com/Guilherme-G-Cadilhe/Go-AuctionHouse_Concurrency-Server-Api/internal/sanitize"
+	"github.com/google/uuid"
+)
+
+const testBidderId = "22222222-2222-2222-2222-222222222222"
+
+// testAuctionConfig é a configuração padrão dos repositórios nos testes
+func testAuctionConfig() config.AuctionConfig {
+	return config.AuctionConfig{
+		Interval:        5 * time.Minute,
+		PersistWinner:   true,
+		MinBidIncrement: 1,
+		StartingPrice:   1,
+		FirstBidPolicy:  "any_positive",
+	}
+}
+
+// newTestRepositories liga os repositórios em memória como o database.NewInMemoryRepositories
+func newTestRepositories(cfg config.AuctionConfig) (*AuctionRepository, *BidRepository, *clock.FakeClock) {
+	clk := clock.NewFake(time.Date(2024, 1, 2, 15, 0, 0, 0, time.UTC))
+	auctionRepository := NewAuctionRepository(cfg, clk)
+	bidRepository := NewBidRepository(auctionRepository, cfg, clk)
+	auctionRepository.SetWinningBidFinder(bidRepository.FindWinningBidByAuctionId)
+	auctionRepository.SetBidsDeleter(bidRepository.DeleteBidsByAuctionId)
+	return auctionRepository, bidRepository, clk
+}
+
+// createTestAuction grava um leilão ativo; edit ajusta os campos antes da gravação
+func createTestAuction(t *testing.T, ar *AuctionRepository, clk clock.Clock, edit func(*auction_entity.Auction)) *auction_entity.Auction {
+	t.Helper()
+	// Mesmo caminho da criação real: o horário vem do relógio injetado, não é preenchido à mão
+	auction, errBody := auction_entity.CreateAuctionBody("Phone X", "Electronics", "a nice phone here ok",
+		auction_entity.New, auction_entity.AuctionFieldBounds{}, sanitize.NewHTMLPolicy(nil), clk.Now())
+	if errBody != nil {
+		t.Fatalf("CreateAuctionBody: %v", errBody)
+	}
+	if edit != nil {
+		edit(auction)
+	}
+	if err := ar.CreateAuction(context.Background(), auction); err != nil {
+		t.Fatalf("CreateAuction: %v", err)
+	}
+	return auction
+}
+
+// newTestBid cria um lance com sequence crescente (ordem de submissão)
+func newTestBid(auctionId string, amount float64, sequence int64, clk clock.Clock) bid_entity.Bid {
+	return bid_entity.Bid{
+		Id:        uuid.New().String(),
+		UserId:    testBidderId,
+		AuctionId: auctionId,
+		Amount:    amount,
+		Timestamp: clk.Now(),
+		Sequence:  sequence,
+	}
+}
+
+// rejectionRecorder guarda os lances descartados pelo CreateBidBatch (id -> motivo)
+type rejectionRecorder struct {
+	mutex   sync.Mutex
+	reasons map[string]string
+}
+
+func recordRejections(bd *BidRepository) *rejectionRecorder {
+	recorder := &rejectionRecorder{reasons: make(map[string]string)}
+	bd.OnBidRejected(func(bid bid_entity.Bid, reason string) {
+		recorder.mutex.Lock()
+		recorder.reasons[bid.Id] = reason
+		recorder.mutex.Unlock()
+	})
+	return recorder
+}
+
+func (r *rejectionRecorder) reason(bidId string) string {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	return r.reasons[bidId]
+}
+
+// storedAmounts devolve os valores gravados do leilão, na ordem de gravação
+func storedAmounts(t *testing.T, bd *BidRepository, auctionId string) []float64 {
+	t.Helper()
+	bids, err := bd.FindBidByAuctionId(context.Background(), auctionId, bid_entity.BidFilter{})
+	if err != nil {
+		t.Fatalf("FindBidByAuctionId: %v", err)
+	}
+	amounts := make([]float64, len(bids))
+	for i, bid := range bids {
+		amounts[i] = bid.Amount
+	}
+	return amounts
+}
@@ -0,0 +1,93 @@
+package memory
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"github.com/Guilherme-G-Cadilhe/Go-AuctionHouse_Concurrency-Server-Api/internal/entity/user_entity"
+	"github.com/Guilherme-G-Cadilhe/Go-AuctionHouse_Concurrency-Server-Api/internal/internal_error"
+)
+
+// UserRepository implementa user_entity.UserRepositoryInterface em memória
+type UserRepository struct {
+	mutex sync.RWMutex
+	users map[string]user_entity.User
+}
+
+func NewUserRepository() *UserRepository {
+	return &UserRepository{
+		users: make(map[string]user_entity.User),
+	}
+}
+
+func (ur *UserRepository) FindUserById(ctx context.Context, id string) (*user_entity.User, *internal_error.InternalError) {
+	ur.mutex.RLock()
+	defer ur.mutex.RUnlock()
+
+	user, ok := ur.users[id]
+	if !ok {
+		return nil, internal_error.NewNotFoundError(fmt.Sprintf("user with id %s not found", id))
+	}
+	return &user, nil
+}
+
+func (ur *UserRepository) CreateUser(ctx context.Context, user *user_entity.User) *internal_error.InternalError {
+	ur.mutex.Lock()
+	defer ur.mutex.Unlock()
+
+	if _, exists := ur.users[user.Id]; exists {
+		return internal_error.NewInternalServerError("error trying to create user")
+	}
+	ur.users[user.Id] = *user
+	return nil
+}
+
+// UpdateUser altera apenas o nome, igual ao $set do repositório MongoDB
+func (ur *UserRepository) UpdateUser(ctx context.Context, user *user_entity.User) *internal_error.InternalError {
+	ur.mutex.Lock()
+	defer ur.mutex.Unlock()
+
+	stored, ok := ur.users[user.Id]
+	if !ok {
+		return internal_error.NewNotFoundError(fmt.Sprintf("user with id %s not found", user.Id))
+	}
+	stored.Name = user.Name
+	ur.users[user.Id] = stored
+	return nil
+}
+
+func (ur *UserRepository) DeleteUser(ctx context.Context, id string) *internal_error.InternalError {
+	ur.mutex.Lock()
+	defer ur.mutex.Unlock()
+
+	if _, ok := ur.users[id]; !ok {
+		return internal_error.NewNotFoundError(fmt.Sprintf("user with id %s not found", id))
+	}
+	delete(ur.users, id)
+	return nil
+}
+
+func (ur *UserRepository) FindUsersByIds(ctx context.Context, ids []string) ([]user_entity.User, *internal_error.InternalError) {
+	ur.mutex.RLock()
+	defer ur.mutex.RUnlock()
+
+	// Como no $in do MongoDB, ids repetidos retornam o usuário uma única vez
+	users := []user_entity.User{}
+	seen := make(map[string]struct{}, len(ids))
+	for _, id := range ids {
+		if _, duplicated := seen[id]; duplicated {
+			continue
+		}
+		seen[id] = struct{}{}
+		if user, ok := ur.users[id]; ok {
+			users = append(users, user)
+		}
+	}
+	return users, nil
+}
+
+// Ping sempre responde: o armazenamento é o próprio processo
+func (ur *UserRepository) Ping(ctx context.Context) *internal_error.InternalError {
+	return nil
+}
@@ -0,0 +1,51 @@
+package database
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/Guilherme-G-Cadilhe/Go-AuctionHouse_Concurrency-Server-Api/configuration/logger"
+	"github.com/Guilherme-G-Cadilhe/Go-AuctionHouse_Concurrency-Server-Api/internal/clock"
+)
+
+// StartAuctionReconciler roda ReconcileOverdueAuctions a cada interval (AUCTION_RECONCILE_INTERVAL)
+// Complementa os timers de fechamento: fecha leilões que passaram do deadline e continuam Active
+// No Node.js seria um setInterval - aqui um timer do clock injetado, para os testes avançarem o tempo
+// interval 0 desabilita; a função retornada para o loop e espera a varredura em andamento (shutdown)
+func StartAuctionReconciler(repository AuctionRepository, interval time.Duration, clk clock.Clock) (stop func()) {
+	if interval <= 0 {
+		return func() {}
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan struct{})
+
+	go func() {
+		defer close(done)
+
+		timer := clk.NewTimer(interval)
+		defer timer.Stop()
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-timer.C():
+				if _, err := repository.ReconcileOverdueAuctions(ctx); err != nil {
+					// Só loga - a próxima varredura tenta de novo
+					logger.Error("error trying to reconcile overdue auctions", err)
+				}
+				timer.Reset(interval)
+			}
+		}
+	}()
+
+	var once sync.Once
+	return func() {
+		once.Do(func() {
+			cancel()
+			<-done
+		})
+	}
+}
@@ -0,0 +1,60 @@
+package database
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/Guilherme-G-Cadilhe/Go-AuctionHouse_Concurrency-Server-Api/internal/clock"
+	"github.com/Guilherme-G-Cadilhe/Go-AuctionHouse_Concurrency-Server-Api/internal/internal_error"
+)
+
+// countingRepository avisa cada varredura do reconciler; os demais métodos não são usados
+type countingRepository struct {
+	AuctionRepository
+	scans chan struct{}
+}
+
+func (r *countingRepository) ReconcileOverdueAuctions(ctx context.Context) (int, *internal_error.InternalError) {
+	select {
+	case r.scans <- struct{}{}:
+	case <-ctx.Done(): // stop() cancela o ctx - a varredura não fica presa no envio
+	}
+	return 0, nil
+}
+
+func TestStartAuctionReconcilerScansEveryInterval(t *testing.T) {
+	fake := clock.NewFake(time.Date(2024, 1, 2, 15, 0, 0, 0, time.UTC))
+	repository := &countingRepository{scans: make(chan struct{})}
+
+	stop := StartAuctionReconciler(repository, time.Minute, fake)
+	defer stop()
+
+	for scan := 1; scan <= 2; scan++ {
+		// A goroutine pode ainda não ter armado o timer: avança até a varredura chegar
+		deadline := time.After(time.Second)
+		for received := false; !received; {
+			fake.Advance(time.Minute)
+			select {
+			case <-repository.scans:
+				received = true
+			case <-time.After(10 * time.Millisecond):
+			case <-deadline:
+				t.Fatalf("scan %d did not happen", scan)
+			}
+		}
+	}
+}
+
+func TestStartAuctionReconcilerDisabled(t *testing.T) {
+	fake := clock.NewFake(time.Date(2024, 1, 2, 15, 0, 0, 0, time.UTC))
+	repository := &countingRepository{scans: make(chan struct{}, 1)}
+
+	stop := StartAuctionReconciler(repository, 0, fake)
+	fake.Advance(time.Hour)
+	stop()
+
+	if len(repository.scans) != 0 {
+		t.Fatal("reconciler with interval 0 should never scan")
+	}
+}
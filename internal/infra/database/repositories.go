@@ -0,0 +1,79 @@
+// Package database escolhe a implementação dos repositórios (MongoDB ou memória)
+// O restante da aplicação só conhece as interfaces - a troca acontece apenas aqui
+package database
+
+import (
+	"context"
+
+	"github.com/Guilherme-G-Cadilhe/Go-AuctionHouse_Concurrency-Server-Api/configuration/config"
+	"github.com/Guilherme-G-Cadilhe/Go-AuctionHouse_Concurrency-Server-Api/internal/clock"
+	"github.com/Guilherme-G-Cadilhe/Go-AuctionHouse_Concurrency-Server-Api/internal/entity/auction_entity"
+	"github.com/Guilherme-G-Cadilhe/Go-AuctionHouse_Concurrency-Server-Api/internal/entity/bid_entity"
+	"github.com/Guilherme-G-Cadilhe/Go-AuctionHouse_Concurrency-Server-Api/internal/entity/user_entity"
+	"github.com/Guilherme-G-Cadilhe/Go-AuctionHouse_Concurrency-Server-Api/internal/infra/database/auction"
+	"github.com/Guilherme-G-Cadilhe/Go-AuctionHouse_Concurrency-Server-Api/internal/infra/database/bid"
+	"github.com/Guilherme-G-Cadilhe/Go-AuctionHouse_Concurrency-Server-Api/internal/infra/database/memory"
+	"github.com/Guilherme-G-Cadilhe/Go-AuctionHouse_Concurrency-Server-Api/internal/infra/database/user"
+	"github.com/Guilherme-G-Cadilhe/Go-AuctionHouse_Concurrency-Server-Api/internal/internal_error"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+// AuctionRepository é a interface de domínio mais os ganchos usados na inicialização
+// Implementada por auction.AuctionRepository (MongoDB) e memory.AuctionRepository
+type AuctionRepository interface {
+	auction_entity.AuctionRepositoryInterface
+	OnAuctionClosed(listener func(ctx context.Context, auctionId string))
+	RestoreAuctionCloseSchedules(ctx context.Context) *internal_error.InternalError
+	ReconcileOverdueAuctions(ctx context.Context) (int, *internal_error.InternalError)
+}
+
+// Repositories agrupa os repositórios já ligados entre si
+type Repositories struct {
+	Auction AuctionRepository
+	Bid     bid_entity.BidEntityRepository
+	User    user_entity.UserRepositoryInterface
+
+	// disconnect fecha a conexão com o banco no shutdown (nil no modo em memória)
+	disconnect func(ctx context.Context) error
+}
+
+// Close encerra a conexão com o MongoDB - chamado por último no shutdown, depois do flush final dos lances
+func (r *Repositories) Close(ctx context.Context) error {
+	if r.disconnect == nil {
+		return nil
+	}
+	return r.disconnect(ctx)
+}
+
+// NewMongoRepositories cria os repositórios persistidos no MongoDB
+func NewMongoRepositories(database *mongo.Database, cfg *config.Config, clk clock.Clock) *Repositories {
+	auctionRepository := auction.NewAuctionRepository(database, cfg.Auction, clk)
+	bidRepository := bid.NewBidRepository(database, auctionRepository, cfg.Auction, clk)
+	// Mantém o cache de leilões do repositório de lances atualizado via change stream (replica set)
+	bidRepository.WatchAuctionChanges(context.Background())
+	// O fechamento grava o vencedor no leilão (AUCTION_PERSIST_WINNER) usando a busca do repositório de lances
+	auctionRepository.SetWinningBidFinder(bidRepository.FindWinningBidByAuctionId)
+
+	return &Repositories{
+		Auction: auctionRepository,
+		Bid:     bidRepository,
+		User:    user.NewUserRepository(database),
+
+		disconnect: database.Client().Disconnect,
+	}
+}
+
+// NewInMemoryRepositories cria repositórios em memória - sem MongoDB, dados perdidos no restart
+// Usado no modo --in-memory (demonstrações) e em testes de use cases/controllers
+func NewInMemoryRepositories(cfg *config.Config, clk clock.Clock) *Repositories {
+	auctionRepository := memory.NewAuctionRepository(cfg.Auction, clk)
+	bidRepository := memory.NewBidRepository(auctionRepository, cfg.Auction, clk)
+	auctionRepository.SetWinningBidFinder(bidRepository.FindWinningBidByAuctionId)
+	auctionRepository.SetBidsDeleter(bidRepository.DeleteBidsByAuctionId)
+
+	return &Repositories{
+		Auction: auctionRepository,
+		Bid:     bidRepository,
+		User:    memory.NewUserRepository(),
+	}
+}
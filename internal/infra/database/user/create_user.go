@@ -3,6 +3,7 @@ package user
 import (
 	"context"
 
+	"github.com/Guilherme-G-Cadilhe/Go-AuctionHouse_Concurrency-Server-Api/configuration/database/mongodb"
 	"github.com/Guilherme-G-Cadilhe/Go-AuctionHouse_Concurrency-Server-Api/configuration/logger"
 	"github.com/Guilherme-G-Cadilhe/Go-AuctionHouse_Concurrency-Server-Api/internal/entity/user_entity"
 	"github.com/Guilherme-G-Cadilhe/Go-AuctionHouse_Concurrency-Server-Api/internal/internal_error"
@@ -12,12 +13,15 @@ import (
 func (ur *UserRepository) CreateUser(ctx context.Context, user *user_entity.User) *internal_error.InternalError {
 	// Converte entidade para modelo MongoDB
 	userEntityMongo := &UserEntityMongo{
-		Id:   user.Id,
-		Name: user.Name,
+		Id:    user.Id,
+		Name:  user.Name,
+		Email: user.Email,
 	}
 
 	// Insere no banco
+	stopTracking := mongodb.TrackQuery("CreateUser", userEntityMongo.Id)
 	_, err := ur.Collection.InsertOne(ctx, userEntityMongo)
+	stopTracking()
 	if err != nil {
 		logger.Error("Error trying to create user", err)
 		return internal_error.NewInternalServerError("error trying to create user")
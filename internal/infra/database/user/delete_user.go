@@ -0,0 +1,30 @@
+package user
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Guilherme-G-Cadilhe/Go-AuctionHouse_Concurrency-Server-Api/configuration/database/mongodb"
+	"github.com/Guilherme-G-Cadilhe/Go-AuctionHouse_Concurrency-Server-Api/configuration/logger"
+	"github.com/Guilherme-G-Cadilhe/Go-AuctionHouse_Concurrency-Server-Api/internal/internal_error"
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+// DeleteUser remove o documento do usuário
+// Os lances do usuário NÃO são removidos aqui - o histórico dos leilões é preservado
+func (ur *UserRepository) DeleteUser(ctx context.Context, id string) *internal_error.InternalError {
+	filter := bson.M{"_id": id}
+
+	defer mongodb.TrackQuery("DeleteUser", filter)()
+	result, err := ur.Collection.DeleteOne(ctx, filter)
+	if err != nil {
+		logger.Error(fmt.Sprintf("error trying to delete user with id %s", id), err)
+		return internal_error.NewInternalServerError(fmt.Sprintf("error trying to delete user with id %s", id))
+	}
+
+	if result.DeletedCount == 0 {
+		return internal_error.NewNotFoundError(fmt.Sprintf("user with id %s not found", id))
+	}
+
+	return nil
+}
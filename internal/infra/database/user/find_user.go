@@ -8,6 +8,7 @@ import (
 	"errors"
 	"fmt"
 
+	"github.com/Guilherme-G-Cadilhe/Go-AuctionHouse_Concurrency-Server-Api/configuration/database/mongodb"
 	"github.com/Guilherme-G-Cadilhe/Go-AuctionHouse_Concurrency-Server-Api/configuration/logger"
 	"github.com/Guilherme-G-Cadilhe/Go-AuctionHouse_Concurrency-Server-Api/internal/entity/user_entity"
 	"github.com/Guilherme-G-Cadilhe/Go-AuctionHouse_Concurrency-Server-Api/internal/internal_error"
@@ -20,8 +21,9 @@ import (
 // Separamos a entidade de domínio (User) da representação no banco (UserEntityMongo)
 // No Node.js com Mongoose, isso seria um Schema
 type UserEntityMongo struct {
-	Id   string `bson:"_id"`  // Mapeia para o campo "_id" do MongoDB
-	Name string `bson:"name"` // Mapeia para o campo "name" do MongoDB
+	Id    string `bson:"_id"`             // Mapeia para o campo "_id" do MongoDB
+	Name  string `bson:"name"`            // Mapeia para o campo "name" do MongoDB
+	Email string `bson:"email,omitempty"` // Opcional - omitempty não grava o campo vazio
 }
 
 // UserRepository é a implementação CONCRETA da UserRepositoryInterface
@@ -69,25 +71,57 @@ func (ur *UserRepository) FindUserById(ctx context.Context, id string) (*user_en
 		if errors.Is(err, mongo.ErrNoDocuments) {
 			// fmt.Sprintf() é como template literals ou string interpolation
 			logger.Error(fmt.Sprintf("user with id %s not found", id), err)
-			// Retorna erro customizado de "not found" (404)
-			return nil, internal_error.NewNotFoundError(fmt.Sprintf("user with id %s not found", id))
+		} else {
+			logger.Error(fmt.Sprintf("error trying to find user with id %s", id), err)
 		}
 
-		// Qualquer outro erro é considerado erro interno do servidor
-		logger.Error(fmt.Sprintf("error trying to find user with id %s", id), err)
-		// Retorna erro customizado de "internal server error" (500)
-		return nil, internal_error.NewInternalServerError(fmt.Sprintf("error trying to find user with id %s", id))
+		// ClassifyMongoError separa 404 (não encontrado), 504 (timeout), 499 (cancelado) e 500
+		return nil, mongodb.ClassifyMongoError(err,
+			fmt.Sprintf("user with id %s not found", id),
+			fmt.Sprintf("error trying to find user with id %s", id))
 	}
 
 	// Se chegou aqui, encontrou o usuário com sucesso
 	// Converte de UserEntityMongo (representação do banco) para User (entidade de domínio)
 	// &user_entity.User{} cria uma nova instância e retorna seu ponteiro
 	return &user_entity.User{
-		Id:   user.Id,
-		Name: user.Name,
+		Id:    user.Id,
+		Name:  user.Name,
+		Email: user.Email,
 	}, nil // nil indica que não houve erro
 }
 
+// FindUsersByIds busca todos os usuários cujo "_id" está na lista em UMA query
+// $in equivale ao UserModel.find({ _id: { $in: ids } }) do Mongoose
+// Ids sem usuário simplesmente não aparecem no resultado (não é erro)
+func (ur *UserRepository) FindUsersByIds(ctx context.Context, ids []string) ([]user_entity.User, *internal_error.InternalError) {
+	filter := bson.M{"_id": bson.M{"$in": ids}}
+
+	defer mongodb.TrackQuery("FindUsersByIds", filter)()
+	cursor, err := ur.Collection.Find(ctx, filter)
+	if err != nil {
+		logger.Error("error trying to find users by ids", err)
+		return nil, mongodb.ClassifyMongoError(err, "", "error trying to find users by ids")
+	}
+	defer cursor.Close(ctx)
+
+	var users []UserEntityMongo
+	if err := cursor.All(ctx, &users); err != nil {
+		logger.Error("error trying to decode users by ids", err)
+		return nil, mongodb.ClassifyMongoError(err, "", "error trying to find users by ids")
+	}
+
+	userEntities := make([]user_entity.User, len(users))
+	for i, user := range users {
+		userEntities[i] = user_entity.User{
+			Id:    user.Id,
+			Name:  user.Name,
+			Email: user.Email,
+		}
+	}
+	return userEntities, nil
+}
+
 /*
 PADRÃO REPOSITORY em Go vs Node.js:
 
@@ -0,0 +1,13 @@
+package user
+
+import (
+	"context"
+
+	"github.com/Guilherme-G-Cadilhe/Go-AuctionHouse_Concurrency-Server-Api/configuration/database/mongodb"
+	"github.com/Guilherme-G-Cadilhe/Go-AuctionHouse_Concurrency-Server-Api/internal/internal_error"
+)
+
+// Ping verifica se o MongoDB da coleção "users" está acessível (usado pelo /health)
+func (ur *UserRepository) Ping(ctx context.Context) *internal_error.InternalError {
+	return mongodb.Ping(ctx, ur.Collection)
+}
@@ -0,0 +1,32 @@
+package user
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Guilherme-G-Cadilhe/Go-AuctionHouse_Concurrency-Server-Api/configuration/database/mongodb"
+	"github.com/Guilherme-G-Cadilhe/Go-AuctionHouse_Concurrency-Server-Api/configuration/logger"
+	"github.com/Guilherme-G-Cadilhe/Go-AuctionHouse_Concurrency-Server-Api/internal/entity/user_entity"
+	"github.com/Guilherme-G-Cadilhe/Go-AuctionHouse_Concurrency-Server-Api/internal/internal_error"
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+// UpdateUser atualiza os dados editáveis do usuário ($set apenas do nome)
+// MatchedCount == 0 significa que nenhum documento tinha o id informado
+func (ur *UserRepository) UpdateUser(ctx context.Context, user *user_entity.User) *internal_error.InternalError {
+	filter := bson.M{"_id": user.Id}
+	update := bson.M{"$set": bson.M{"name": user.Name}}
+
+	defer mongodb.TrackQuery("UpdateUser", filter)()
+	result, err := ur.Collection.UpdateOne(ctx, filter, update)
+	if err != nil {
+		logger.Error(fmt.Sprintf("error trying to update user with id %s", user.Id), err)
+		return internal_error.NewInternalServerError(fmt.Sprintf("error trying to update user with id %s", user.Id))
+	}
+
+	if result.MatchedCount == 0 {
+		return internal_error.NewNotFoundError(fmt.Sprintf("user with id %s not found", user.Id))
+	}
+
+	return nil
+}
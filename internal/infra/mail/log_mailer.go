@@ -0,0 +1,22 @@
+package mail
+
+import (
+	"context"
+
+	"github.com/Guilherme-G-Cadilhe/Go-AuctionHouse_Concurrency-Server-Api/configuration/logger"
+	"go.uber.org/zap"
+)
+
+// LogMailer não envia nada - apenas registra o e-mail no log
+type LogMailer struct{}
+
+func NewLogMailer() *LogMailer {
+	return &LogMailer{}
+}
+
+func (lm *LogMailer) Send(ctx context.Context, to, subject, body string) error {
+	logger.Info("email not sent (no SMTP configured)",
+		zap.String("to", to),
+		zap.String("subject", subject))
+	return nil
+}
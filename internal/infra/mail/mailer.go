@@ -0,0 +1,24 @@
+// Package mail implementa o envio de notificações por e-mail
+// No Node.js seria o equivalente a um wrapper sobre o nodemailer com "transports" plugáveis
+package mail
+
+import (
+	"context"
+
+	"github.com/Guilherme-G-Cadilhe/Go-AuctionHouse_Concurrency-Server-Api/configuration/config"
+)
+
+// Mailer é o contrato usado pelos casos de uso para enviar e-mails
+// Implementações: LogMailer (padrão, apenas loga) e SMTPMailer (envio real)
+type Mailer interface {
+	Send(ctx context.Context, to, subject, body string) error
+}
+
+// NewMailer escolhe a implementação pela configuração
+// Sem SMTP_HOST os e-mails são apenas logados - útil em desenvolvimento
+func NewMailer(cfg config.MailConfig) Mailer {
+	if cfg.SMTPHost == "" {
+		return NewLogMailer()
+	}
+	return NewSMTPMailer(cfg)
+}
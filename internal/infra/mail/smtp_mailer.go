@@ -0,0 +1,56 @@
+package mail
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"net/smtp"
+	"strconv"
+	"strings"
+
+	"github.com/Guilherme-G-Cadilhe/Go-AuctionHouse_Concurrency-Server-Api/configuration/config"
+)
+
+// SMTPMailer envia e-mails em texto puro via net/smtp
+type SMTPMailer struct {
+	address string    // host:porta do servidor SMTP
+	from    string    // Remetente (SMTP_FROM)
+	auth    smtp.Auth // nil quando não há usuário configurado
+}
+
+func NewSMTPMailer(cfg config.MailConfig) *SMTPMailer {
+	var auth smtp.Auth
+	if cfg.SMTPUsername != "" {
+		// PlainAuth só envia a senha em conexões TLS (ou localhost)
+		auth = smtp.PlainAuth("", cfg.SMTPUsername, cfg.SMTPPassword, cfg.SMTPHost)
+	}
+
+	return &SMTPMailer{
+		address: net.JoinHostPort(cfg.SMTPHost, strconv.Itoa(cfg.SMTPPort)),
+		from:    cfg.From,
+		auth:    auth,
+	}
+}
+
+// Send monta a mensagem com os headers mínimos e envia
+// smtp.SendMail não aceita context - o cancelamento só é checado antes do envio
+func (sm *SMTPMailer) Send(ctx context.Context, to, subject, body string) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	message := strings.Join([]string{
+		"From: " + sm.from,
+		"To: " + to,
+		"Subject: " + subject,
+		"MIME-Version: 1.0",
+		"Content-Type: text/plain; charset=UTF-8",
+		"",
+		body,
+	}, "\r\n")
+
+	if err := smtp.SendMail(sm.address, sm.auth, sm.from, []string{to}, []byte(message)); err != nil {
+		return fmt.Errorf("error sending email to %s: %w", to, err)
+	}
+	return nil
+}
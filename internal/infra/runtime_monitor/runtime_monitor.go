@@ -0,0 +1,145 @@
+// Package runtime_monitor acompanha goroutines e memória do processo
+// O sistema cria goroutines por lance, por fechamento de leilão e em rotinas de fundo - o monitor
+// dá visibilidade a um crescimento sem limite antes que ele derrube o processo (OOM)
+package runtime_monitor
+
+import (
+	"context"
+	"runtime"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/Guilherme-G-Cadilhe/Go-AuctionHouse_Concurrency-Server-Api/configuration/config"
+	"github.com/Guilherme-G-Cadilhe/Go-AuctionHouse_Concurrency-Server-Api/configuration/logger"
+	"github.com/Guilherme-G-Cadilhe/Go-AuctionHouse_Concurrency-Server-Api/internal/clock"
+	"go.uber.org/zap"
+)
+
+// Monitor amostra runtime.NumGoroutine periodicamente e guarda o pico observado
+type Monitor struct {
+	threshold int           // RUNTIME_GOROUTINE_WARN_THRESHOLD (0 = sem warning)
+	interval  time.Duration // RUNTIME_MONITOR_INTERVAL
+	clock     clock.Clock
+	startedAt time.Time
+
+	peak atomic.Int64 // Maior contagem vista nas amostras e nas consultas
+
+	// above só é acessado pela goroutine do monitor: o warning sai uma vez ao cruzar o limite,
+	// e não a cada amostra enquanto a contagem continuar alta
+	above bool
+}
+
+func NewMonitor(cfg config.RuntimeConfig, clk clock.Clock) *Monitor {
+	return &Monitor{
+		threshold: cfg.GoroutineWarnThreshold,
+		interval:  cfg.MonitorInterval,
+		clock:     clk,
+		startedAt: clk.Now(),
+	}
+}
+
+// MemoryStats é o subconjunto do runtime.MemStats útil para operação
+type MemoryStats struct {
+	HeapAllocBytes uint64 `json:"heap_alloc_bytes"` // Bytes alocados e ainda em uso no heap
+	HeapInuseBytes uint64 `json:"heap_inuse_bytes"` // Spans do heap em uso
+	SysBytes       uint64 `json:"sys_bytes"`        // Memória total obtida do sistema operacional
+	NumGC          uint32 `json:"num_gc"`           // Ciclos de GC completos
+}
+
+// Snapshot é a resposta do GET /internal/runtime
+type Snapshot struct {
+	Goroutines             int         `json:"goroutines"`
+	PeakGoroutines         int64       `json:"peak_goroutines"`
+	GoroutineWarnThreshold int         `json:"goroutine_warn_threshold"` // 0 = desabilitado
+	AboveThreshold         bool        `json:"above_threshold"`
+	UptimeSeconds          int64       `json:"uptime_seconds"`
+	Memory                 MemoryStats `json:"memory"`
+}
+
+// Snapshot lê o estado atual - runtime.ReadMemStats pausa o mundo brevemente, então só roda sob demanda
+func (m *Monitor) Snapshot() Snapshot {
+	goroutines := m.sample()
+
+	var memStats runtime.MemStats
+	runtime.ReadMemStats(&memStats)
+
+	return Snapshot{
+		Goroutines:             goroutines,
+		PeakGoroutines:         m.peak.Load(),
+		GoroutineWarnThreshold: m.threshold,
+		AboveThreshold:         m.threshold > 0 && goroutines > m.threshold,
+		UptimeSeconds:          int64(m.clock.Now().Sub(m.startedAt).Seconds()),
+		Memory: MemoryStats{
+			HeapAllocBytes: memStats.HeapAlloc,
+			HeapInuseBytes: memStats.HeapInuse,
+			SysBytes:       memStats.Sys,
+			NumGC:          memStats.NumGC,
+		},
+	}
+}
+
+// sample conta as goroutines e atualiza o pico
+func (m *Monitor) sample() int {
+	goroutines := runtime.NumGoroutine()
+	for {
+		peak := m.peak.Load()
+		if int64(goroutines) <= peak || m.peak.CompareAndSwap(peak, int64(goroutines)) {
+			return goroutines
+		}
+	}
+}
+
+// Start roda a amostragem a cada interval - mesmo formato do StartAuctionReconciler
+// A função retornada para o loop e espera a goroutine terminar (shutdown)
+func (m *Monitor) Start() (stop func()) {
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan struct{})
+
+	go func() {
+		defer close(done)
+
+		timer := m.clock.NewTimer(m.interval)
+		defer timer.Stop()
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-timer.C():
+				m.check()
+				timer.Reset(m.interval)
+			}
+		}
+	}()
+
+	var once sync.Once
+	return func() {
+		once.Do(func() {
+			cancel()
+			<-done
+		})
+	}
+}
+
+// check loga ao cruzar o limite (warning) e ao voltar para baixo dele (info)
+func (m *Monitor) check() {
+	goroutines := m.sample()
+	if m.threshold <= 0 {
+		return
+	}
+
+	switch {
+	case goroutines > m.threshold && !m.above:
+		m.above = true
+		logger.Warn("goroutine count above RUNTIME_GOROUTINE_WARN_THRESHOLD",
+			zap.Int("goroutines", goroutines),
+			zap.Int("threshold", m.threshold),
+			zap.Int64("peak_goroutines", m.peak.Load()))
+	case goroutines <= m.threshold && m.above:
+		m.above = false
+		logger.Info("goroutine count back below RUNTIME_GOROUTINE_WARN_THRESHOLD",
+			zap.Int("goroutines", goroutines),
+			zap.Int("threshold", m.threshold))
+	}
+}
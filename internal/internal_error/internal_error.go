@@ -1,8 +1,18 @@
 package internal_error
 
+import "time"
+
 type InternalError struct {
+	Message    string
+	Err        string
+	Causes     []Cause       // Erros por campo (opcional) - viram "causes" na resposta HTTP
+	RetryAfter time.Duration // Espera sugerida antes de repetir (opcional) - vira o header Retry-After
+}
+
+// Cause descreve o erro de um campo específico (ex: limites de tamanho)
+type Cause struct {
+	Field   string
 	Message string
-	Err     string
 }
 
 func (err *InternalError) Error() string {
@@ -22,9 +32,68 @@ func NewInternalServerError(message string) *InternalError {
 	}
 }
 
-func NewBadRequestError(message string) *InternalError {
+func NewBadRequestError(message string, causes ...Cause) *InternalError {
 	return &InternalError{
 		Message: message,
 		Err:     "bad_request",
+		Causes:  causes,
+	}
+}
+
+func NewUnauthorizedError(message string) *InternalError {
+	return &InternalError{
+		Message: message,
+		Err:     "unauthorized",
+	}
+}
+
+func NewForbiddenError(message string) *InternalError {
+	return &InternalError{
+		Message: message,
+		Err:     "forbidden",
+	}
+}
+
+func NewConflictError(message string) *InternalError {
+	return &InternalError{
+		Message: message,
+		Err:     "conflict",
+	}
+}
+
+func NewTooManyRequestsError(message string) *InternalError {
+	return &InternalError{
+		Message: message,
+		Err:     "too_many_requests",
+	}
+}
+
+// NewTooManyRequestsErrorRetryAfter informa quanto tempo o cliente deve esperar antes de repetir
+func NewTooManyRequestsErrorRetryAfter(message string, retryAfter time.Duration) *InternalError {
+	return &InternalError{
+		Message:    message,
+		Err:        "too_many_requests",
+		RetryAfter: retryAfter,
+	}
+}
+
+func NewTimeoutError(message string) *InternalError {
+	return &InternalError{
+		Message: message,
+		Err:     "timeout",
+	}
+}
+
+func NewRequestCanceledError(message string) *InternalError {
+	return &InternalError{
+		Message: message,
+		Err:     "request_canceled",
+	}
+}
+
+func NewServiceUnavailableError(message string) *InternalError {
+	return &InternalError{
+		Message: message,
+		Err:     "service_unavailable",
 	}
 }
@@ -0,0 +1,93 @@
+// Package jsontime define o formato dos horários nas respostas da API (JSON_TIME_FORMAT)
+// O encoding/json usado pelo Gin ignora tags como time_format - o formato só muda com um tipo próprio
+// No Node.js seria um replacer no JSON.stringify ou um toJSON() nas datas
+package jsontime
+
+import (
+	"bytes"
+	"encoding/json"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Formatos aceitos no JSON_TIME_FORMAT; qualquer outro valor é tratado como layout do Go (ex: "2006-01-02 15:04:05")
+const (
+	FormatRFC3339 = "rfc3339" // Padrão - mesma saída do time.Time (RFC3339 com frações de segundo quando existirem)
+	FormatUnix    = "unix"    // Segundos desde a epoch, como número
+)
+
+// Definidos uma única vez na inicialização (SetFormat), como o AMOUNT_MODE
+var (
+	unixFormat = false
+	layout     = time.RFC3339Nano
+)
+
+// SetFormat configura o formato a partir do JSON_TIME_FORMAT já carregado pelo config
+// Vazio mantém o RFC3339
+func SetFormat(format string) {
+	switch strings.ToLower(strings.TrimSpace(format)) {
+	case "", FormatRFC3339:
+		unixFormat, layout = false, time.RFC3339Nano
+	case FormatUnix:
+		unixFormat, layout = true, time.RFC3339Nano
+	default:
+		unixFormat, layout = false, format
+	}
+}
+
+// Time é o time.Time dos DTOs de resposta - embutido, então Before, Unix, IsZero etc. continuam disponíveis
+type Time struct {
+	time.Time
+}
+
+// New envolve um time.Time para serialização
+func New(t time.Time) Time {
+	return Time{Time: t}
+}
+
+// MarshalJSON escreve o horário no formato configurado: número em unix, string nos demais
+func (t Time) MarshalJSON() ([]byte, error) {
+	if unixFormat {
+		return strconv.AppendInt(nil, t.Unix(), 10), nil
+	}
+	return json.Marshal(t.Format(layout))
+}
+
+// MarshalText é usado nas respostas em XML (Accept: application/xml) - mesmo formato, sem aspas
+func (t Time) MarshalText() ([]byte, error) {
+	if unixFormat {
+		return strconv.AppendInt(nil, t.Unix(), 10), nil
+	}
+	return []byte(t.Format(layout)), nil
+}
+
+// UnmarshalJSON aceita o que MarshalJSON produz, para clientes Go que reaproveitam os DTOs
+// Strings fora do layout configurado ainda são aceitas em RFC3339
+func (t *Time) UnmarshalJSON(data []byte) error {
+	if bytes.Equal(data, []byte("null")) {
+		return nil
+	}
+
+	if len(data) > 0 && data[0] != '"' {
+		seconds, err := strconv.ParseInt(string(data), 10, 64)
+		if err != nil {
+			return err
+		}
+		t.Time = time.Unix(seconds, 0)
+		return nil
+	}
+
+	var value string
+	if err := json.Unmarshal(data, &value); err != nil {
+		return err
+	}
+	parsed, err := time.Parse(layout, value)
+	if err != nil {
+		if parsed, err = time.Parse(time.RFC3339Nano, value); err != nil {
+			return err
+		}
+	}
+	t.Time = parsed
+	return nil
+}
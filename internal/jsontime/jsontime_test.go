@@ -0,0 +1,80 @@
+package jsontime
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+)
+
+// useFormat troca o formato global durante o teste e restaura o RFC3339 no fim
+func useFormat(t *testing.T, format string) {
+	t.Helper()
+	SetFormat(format)
+	t.Cleanup(func() { SetFormat("") })
+}
+
+var sample = time.Date(2024, 1, 2, 15, 4, 5, 0, time.UTC)
+
+func TestMarshalJSONFormats(t *testing.T) {
+	tests := []struct {
+		format string
+		value  time.Time
+		want   string
+	}{
+		{"", sample, `"2024-01-02T15:04:05Z"`},
+		{"RFC3339", sample.Add(500 * time.Millisecond), `"2024-01-02T15:04:05.5Z"`},
+		{"unix", sample, `1704207845`},
+		{"2006-01-02 15:04:05", sample, `"2024-01-02 15:04:05"`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.format, func(t *testing.T) {
+			useFormat(t, tt.format)
+
+			got, err := json.Marshal(struct {
+				Timestamp Time `json:"timestamp"`
+			}{New(tt.value)})
+			if err != nil {
+				t.Fatalf("Marshal: %v", err)
+			}
+			if want := `{"timestamp":` + tt.want + `}`; string(got) != want {
+				t.Fatalf("Marshal = %s, want %s", got, want)
+			}
+		})
+	}
+}
+
+func TestMarshalTextUsesTheSameFormat(t *testing.T) {
+	useFormat(t, "unix")
+	if got, _ := New(sample).MarshalText(); string(got) != "1704207845" {
+		t.Fatalf("MarshalText = %s, want 1704207845", got)
+	}
+}
+
+func TestUnmarshalJSONRoundTrip(t *testing.T) {
+	for _, format := range []string{"", "unix", "2006-01-02 15:04:05"} {
+		t.Run(format, func(t *testing.T) {
+			useFormat(t, format)
+
+			data, _ := json.Marshal(New(sample))
+			var decoded Time
+			if err := json.Unmarshal(data, &decoded); err != nil {
+				t.Fatalf("Unmarshal(%s): %v", data, err)
+			}
+			if !decoded.Equal(sample) {
+				t.Fatalf("round trip = %v, want %v", decoded.Time, sample)
+			}
+		})
+	}
+}
+
+func TestUnmarshalJSONAcceptsRFC3339WithCustomLayout(t *testing.T) {
+	useFormat(t, "2006-01-02 15:04:05")
+
+	var decoded Time
+	if err := json.Unmarshal([]byte(`"2024-01-02T15:04:05Z"`), &decoded); err != nil {
+		t.Fatalf("Unmarshal: %v", err)
+	}
+	if !decoded.Equal(sample) {
+		t.Fatalf("decoded = %v, want %v", decoded.Time, sample)
+	}
+}
@@ -0,0 +1,96 @@
+// Package sanitize neutraliza HTML enviado pelos usuários antes de ser persistido
+// No Node.js seria o papel de bibliotecas como "sanitize-html" ou "DOMPurify" no servidor
+package sanitize
+
+import (
+	"strings"
+
+	"golang.org/x/net/html"
+	"golang.org/x/net/html/atom"
+)
+
+// HTMLPolicy define quais tags sobrevivem à sanitização
+// Tags permitidas são reescritas SEM atributos - onclick, style e href nunca chegam ao banco
+// O resto do HTML é removido e o texto é escapado (& < > " ')
+type HTMLPolicy struct {
+	allowedTags map[atom.Atom]bool
+}
+
+// NewHTMLPolicy cria a política com a lista de tags permitidas (ex: "b", "i", "p")
+// Lista vazia remove todas as tags; script, style e similares nunca são permitidas
+func NewHTMLPolicy(allowedTags []string) *HTMLPolicy {
+	policy := &HTMLPolicy{allowedTags: make(map[atom.Atom]bool)}
+	for _, tag := range allowedTags {
+		tagAtom := atom.Lookup([]byte(strings.ToLower(strings.TrimSpace(tag))))
+		if tagAtom == 0 || dropsContent(tagAtom) {
+			continue // Tag desconhecida ou perigosa - ignorada
+		}
+		policy.allowedTags[tagAtom] = true
+	}
+	return policy
+}
+
+// Sanitize percorre o texto com o tokenizer do golang.org/x/net/html (o mesmo algoritmo dos navegadores)
+// Em vez de regex: tags malformadas, maiúsculas ou quebradas em linhas são reconhecidas igual ao browser
+func (p *HTMLPolicy) Sanitize(value string) string {
+	var builder strings.Builder
+	tokenizer := html.NewTokenizer(strings.NewReader(value))
+
+	// Dentro de <script>, <style> etc. o conteúdo é descartado junto com a tag
+	skipDepth := 0
+
+	for {
+		tokenType := tokenizer.Next()
+		if tokenType == html.ErrorToken {
+			// io.EOF é o fim normal; qualquer outro erro encerra com o que já foi sanitizado
+			return builder.String()
+		}
+
+		token := tokenizer.Token()
+		switch tokenType {
+		case html.TextToken:
+			if skipDepth == 0 {
+				// Token() já decodifica entidades (&lt; vira <) - EscapeString codifica de novo
+				builder.WriteString(html.EscapeString(token.Data))
+			}
+		case html.StartTagToken:
+			if dropsContent(token.DataAtom) {
+				skipDepth++
+				continue
+			}
+			if skipDepth == 0 && p.allowedTags[token.DataAtom] {
+				builder.WriteString("<" + token.DataAtom.String() + ">")
+			}
+		case html.EndTagToken:
+			if dropsContent(token.DataAtom) {
+				if skipDepth > 0 {
+					skipDepth--
+				}
+				continue
+			}
+			if skipDepth == 0 && p.allowedTags[token.DataAtom] {
+				builder.WriteString("</" + token.DataAtom.String() + ">")
+			}
+		case html.SelfClosingTagToken:
+			// O navegador ignora a "/" em tags não-void: <script/> abre um script como <script>
+			if dropsContent(token.DataAtom) {
+				skipDepth++
+				continue
+			}
+			if skipDepth == 0 && p.allowedTags[token.DataAtom] {
+				builder.WriteString("<" + token.DataAtom.String() + ">")
+			}
+		}
+		// Comentários e doctype são descartados
+	}
+}
+
+// dropsContent indica tags cujo conteúdo não é texto para o usuário e deve sumir por completo
+// Apenas tags com fechamento - tags void (embed, img) não têm conteúdo e já são removidas por não serem permitidas
+func dropsContent(tagAtom atom.Atom) bool {
+	switch tagAtom {
+	case atom.Script, atom.Style, atom.Iframe, atom.Object, atom.Noscript, atom.Template, atom.Textarea, atom.Title, atom.Xmp, atom.Noembed, atom.Noframes, atom.Plaintext:
+		return true
+	}
+	return false
+}
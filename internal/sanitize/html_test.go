@@ -0,0 +1,50 @@
+package sanitize
+
+import "testing"
+
+func TestSanitizeNeutralizesScriptInjection(t *testing.T) {
+	policy := NewHTMLPolicy([]string{"a", "b", "img"})
+
+	tests := []struct {
+		name  string
+		input string
+		want  string
+	}{
+		{"script tag", `<script>alert(1)</script>ok`, "ok"},
+		{"mixed case script", `<ScRiPt>alert(1)</sCrIpT>ok`, "ok"},
+		{"self-closing uppercase script", `<SCRIPT/>alert(1)</SCRIPT>ok`, "ok"},
+		{"lone self-closing script", `<SCRIPT/>`, ""},
+		{"img onerror", `<img src=x onerror=alert(1)>ok`, "<img>ok"},
+		{"javascript href", `<a href="javascript:alert(1)">click</a>`, "<a>click</a>"},
+		{"event handler on allowed tag", `<b onclick="steal()">bold</b>`, "<b>bold</b>"},
+		{"plaintext swallows the rest", `intro<plaintext><b>x</b>`, "intro"},
+		{"entity encoded script stays text", `&lt;script&gt;alert(1)&lt;/script&gt;`, "&lt;script&gt;alert(1)&lt;/script&gt;"},
+		{"plain text is escaped", `5 > 3 & "ok"`, "5 &gt; 3 &amp; &#34;ok&#34;"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := policy.Sanitize(tt.input); got != tt.want {
+				t.Fatalf("Sanitize(%q) = %q, want %q", tt.input, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSanitizeWithoutAllowedTagsStripsEverything(t *testing.T) {
+	policy := NewHTMLPolicy(nil)
+
+	got := policy.Sanitize(`<b>bold</b> <a href="javascript:x()">link</a> <img src=x onerror=alert(1)>`)
+	if want := "bold link "; got != want {
+		t.Fatalf("Sanitize = %q, want %q", got, want)
+	}
+}
+
+func TestNewHTMLPolicyNeverAllowsDangerousTags(t *testing.T) {
+	policy := NewHTMLPolicy([]string{"script", "STYLE", "iframe", "unknown-tag"})
+
+	got := policy.Sanitize(`<script>a()</script><style>p{}</style><iframe src=x></iframe>text`)
+	if got != "text" {
+		t.Fatalf("Sanitize = %q, want %q", got, "text")
+	}
+}
@@ -0,0 +1,131 @@
+package auction_usecase
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/Guilherme-G-Cadilhe/Go-AuctionHouse_Concurrency-Server-Api/configuration/config"
+	"github.com/Guilherme-G-Cadilhe/Go-AuctionHouse_Concurrency-Server-Api/configuration/logger"
+	"github.com/Guilherme-G-Cadilhe/Go-AuctionHouse_Concurrency-Server-Api/configuration/tracing"
+	"github.com/Guilherme-G-Cadilhe/Go-AuctionHouse_Concurrency-Server-Api/internal/clock"
+	"github.com/Guilherme-G-Cadilhe/Go-AuctionHouse_Concurrency-Server-Api/internal/entity/auction_entity"
+	"github.com/Guilherme-G-Cadilhe/Go-AuctionHouse_Concurrency-Server-Api/internal/entity/bid_entity"
+	"github.com/Guilherme-G-Cadilhe/Go-AuctionHouse_Concurrency-Server-Api/internal/entity/user_entity"
+	"github.com/Guilherme-G-Cadilhe/Go-AuctionHouse_Concurrency-Server-Api/internal/infra/mail"
+	"go.uber.org/zap"
+)
+
+// AuctionCloseNotifier avisa vencedor e vendedor quando um leilão fecha
+// Registrado no AuctionRepository via OnAuctionClosed (roda fora de qualquer request)
+// Falhas nunca interrompem o fechamento: são logadas e reenviadas até MAIL_MAX_RETRIES
+type AuctionCloseNotifier struct {
+	auctionRepository auction_entity.AuctionRepositoryInterface
+	bidRepository     bid_entity.BidEntityRepository
+	userRepository    user_entity.UserRepositoryInterface
+	mailer            mail.Mailer
+	maxRetries        int
+	retryBackoff      time.Duration
+	clock             clock.Clock
+}
+
+func NewAuctionCloseNotifier(
+	auctionRepository auction_entity.AuctionRepositoryInterface,
+	bidRepository bid_entity.BidEntityRepository,
+	userRepository user_entity.UserRepositoryInterface,
+	mailer mail.Mailer,
+	cfg config.MailConfig,
+	clk clock.Clock) *AuctionCloseNotifier {
+	return &AuctionCloseNotifier{
+		auctionRepository: auctionRepository,
+		bidRepository:     bidRepository,
+		userRepository:    userRepository,
+		mailer:            mailer,
+		maxRetries:        cfg.MaxRetries,
+		retryBackoff:      cfg.RetryBackoff,
+		clock:             clk,
+	}
+}
+
+// NotifyAuctionClosed determina o vencedor e envia os e-mails de fechamento
+// Usuários sem e-mail cadastrado (ou removidos) são ignorados
+func (n *AuctionCloseNotifier) NotifyAuctionClosed(ctx context.Context, auctionId string) {
+	ctx, span := tracing.Start(ctx, "AuctionCloseNotifier.NotifyAuctionClosed")
+	defer span.End()
+
+	auction, err := n.auctionRepository.FindAuctionById(ctx, auctionId)
+	if err != nil {
+		logger.Error(fmt.Sprintf("error trying to notify close of auction %s", auctionId), err)
+		return
+	}
+
+	// FindWinningBidByAuctionId retorna erro quando o leilão não recebeu lances
+	winningBid, errBid := n.bidRepository.FindWinningBidByAuctionId(ctx, auctionId)
+	if errBid != nil {
+		winningBid = nil
+	}
+
+	if winningBid != nil {
+		n.notifyUser(ctx, winningBid.UserId,
+			fmt.Sprintf("You won the auction for %s", auction.ProductName),
+			fmt.Sprintf("Congratulations! Your bid of %s won the auction for %s (auction %s).",
+				formatAmount(winningBid.Amount), auction.ProductName, auction.Id))
+	}
+
+	if auction.OwnerId == "" {
+		return
+	}
+
+	sellerBody := fmt.Sprintf("Your auction for %s (auction %s) closed without bids.", auction.ProductName, auction.Id)
+	if winningBid != nil {
+		sellerBody = fmt.Sprintf("Your auction for %s (auction %s) closed. Winning bid: %s.",
+			auction.ProductName, auction.Id, formatAmount(winningBid.Amount))
+	}
+	n.notifyUser(ctx, auction.OwnerId, fmt.Sprintf("Your auction for %s has closed", auction.ProductName), sellerBody)
+}
+
+// notifyUser busca o e-mail do usuário e envia a mensagem com retry
+func (n *AuctionCloseNotifier) notifyUser(ctx context.Context, userId, subject, body string) {
+	// Tombstone de usuário removido ou lance de convidado (sem cadastro, sem e-mail)
+	if userId == bid_entity.DeletedUserId || userId == "" {
+		return
+	}
+
+	user, err := n.userRepository.FindUserById(ctx, userId)
+	if err != nil || user.Email == "" {
+		return
+	}
+
+	n.sendWithRetry(ctx, user.Email, subject, body)
+}
+
+// sendWithRetry tenta 1 + maxRetries vezes, esperando retryBackoff * tentativa entre elas
+func (n *AuctionCloseNotifier) sendWithRetry(ctx context.Context, to, subject, body string) {
+	for attempt := 0; ; attempt++ {
+		err := n.mailer.Send(ctx, to, subject, body)
+		if err == nil {
+			return
+		}
+
+		if attempt >= n.maxRetries {
+			logger.Error("giving up sending email", err, zap.String("to", to), zap.Int("attempts", attempt+1))
+			return
+		}
+
+		logger.Error("error sending email, retrying", err, zap.String("to", to), zap.Int("attempt", attempt+1))
+		select {
+		case <-n.clock.After(n.retryBackoff * time.Duration(attempt+1)):
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+// formatAmount exibe o valor conforme o AMOUNT_MODE (centavos em modo cents)
+func formatAmount(amount float64) string {
+	if bid_entity.GetAmountMode() == bid_entity.AmountModeCents {
+		return strconv.FormatFloat(bid_entity.FromCents(int64(amount)), 'f', 2, 64)
+	}
+	return strconv.FormatFloat(amount, 'f', 2, 64)
+}
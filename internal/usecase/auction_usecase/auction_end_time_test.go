@@ -0,0 +1,37 @@
+package auction_usecase
+
+import (
+	"context"
+	"testing"
+	"time"
+)
+
+func TestAuctionOutputEndTime(t *testing.T) {
+	cfg := testAuctionConfig()
+	env := newTestEnv(cfg)
+	auction := env.createAuction(t, nil)
+	ctx := context.Background()
+
+	output, err := env.useCase.FindAuctionById(ctx, auction.Id)
+	if err != nil {
+		t.Fatalf("FindAuctionById: %v", err)
+	}
+	if want := output.CreatedAt.Add(cfg.Interval); !output.EndTime.Equal(want) {
+		t.Fatalf("end time = %v, want creation + interval %v", output.EndTime.Time, want)
+	}
+
+	// Extensões movem o fim efetivo; a listagem expõe o mesmo valor
+	if err := env.useCase.ExtendAuction(ctx, auction.Id, AuctionExtendInputDTO{Duration: "1m"}); err != nil {
+		t.Fatalf("ExtendAuction: %v", err)
+	}
+	auctions, err := env.useCase.FindAllAuctions(ctx, AuctionListInputDTO{})
+	if err != nil {
+		t.Fatalf("FindAllAuctions: %v", err)
+	}
+	if len(auctions) != 1 {
+		t.Fatalf("got %d auctions, want 1", len(auctions))
+	}
+	if want := output.CreatedAt.Add(cfg.Interval + time.Minute); !auctions[0].EndTime.Equal(want) {
+		t.Fatalf("end time after extension = %v, want %v", auctions[0].EndTime.Time, want)
+	}
+}
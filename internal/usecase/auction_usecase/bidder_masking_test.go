@@ -0,0 +1,87 @@
+package auction_usecase
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/Guilherme-G-Cadilhe/Go-AuctionHouse_Concurrency-Server-Api/configuration/config"
+	"github.com/Guilherme-G-Cadilhe/Go-AuctionHouse_Concurrency-Server-Api/internal/auth_context"
+	"github.com/Guilherme-G-Cadilhe/Go-AuctionHouse_Concurrency-Server-Api/internal/bidder_mask"
+	"github.com/Guilherme-G-Cadilhe/Go-AuctionHouse_Concurrency-Server-Api/internal/usecase/bid_usecase"
+)
+
+const testOtherId = "44444444-4444-4444-4444-444444444444"
+
+// Toda resposta do caso de uso de leilões que traz um lance aplica a mesma anonimização da listagem de lances
+func TestAuctionResponsesMaskBidderIds(t *testing.T) {
+	env := newTestEnv(testAuctionConfig())
+	env.useCase = NewAuctionUseCase(env.auctions, env.bids, testAuctionConfig(), config.BidConfig{
+		MaskBidderIds: true,
+		AdminUserIds:  []string{testAdminId},
+	}, env.clock).(*AuctionUseCase)
+	auction := env.createAuction(t, nil)
+	env.storeBids(t, auction.Id, 10)
+
+	// Leilão ativo: o líder aparece no relatório do próprio usuário
+	owner := auth_context.WithUserID(context.Background(), testBidderId)
+	leading, err := env.useCase.FindUserLeadingAuctions(owner, testBidderId, UserLeadingInputDTO{Page: 1, PageSize: 20})
+	if err != nil {
+		t.Fatalf("FindUserLeadingAuctions: %v", err)
+	}
+	if len(leading) != 1 || leading[0].Bid.UserId != testBidderId {
+		t.Fatalf("leading auctions = %+v, want the owner's real id", leading)
+	}
+
+	env.closeByTimer(t, auction.Id)
+	tests := []struct {
+		name       string
+		viewerId   string
+		wantMasked bool
+	}{
+		{"other user", testOtherId, true},
+		{"bid owner", testBidderId, false},
+		{"admin", testAdminId, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := auth_context.WithUserID(context.Background(), tt.viewerId)
+			bids := map[string]*bid_usecase.BidOutputDTO{}
+
+			winning, err := env.useCase.FindWinningBidByAuctionId(ctx, auction.Id)
+			if err != nil {
+				t.Fatalf("FindWinningBidByAuctionId: %v", err)
+			}
+			bids["winning bid"] = winning.Bid
+
+			activity, err := env.useCase.FindAuctionActivity(ctx, auction.Id)
+			if err != nil {
+				t.Fatalf("FindAuctionActivity: %v", err)
+			}
+			for _, item := range activity {
+				if item.Bid != nil {
+					bids["activity"] = item.Bid
+				}
+			}
+
+			winners, err := env.useCase.FindAuctionWinners(ctx, AuctionWinnersInputDTO{Page: 1, PageSize: 20})
+			if err != nil {
+				t.Fatalf("FindAuctionWinners: %v", err)
+			}
+			if len(winners.Items) == 1 {
+				bids["winners report"] = winners.Items[0].Bid
+			}
+
+			for _, endpoint := range []string{"winning bid", "activity", "winners report"} {
+				bid := bids[endpoint]
+				if bid == nil {
+					t.Fatalf("%s: no bid in the response", endpoint)
+				}
+				masked := strings.HasPrefix(bid.UserId, bidder_mask.MaskedUserIdPrefix)
+				if masked != tt.wantMasked || (!masked && bid.UserId != testBidderId) {
+					t.Fatalf("%s: user_id = %q, want masked %v", endpoint, bid.UserId, tt.wantMasked)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,31 @@
+package auction_usecase
+
+import (
+	"context"
+	"errors"
+
+	"github.com/Guilherme-G-Cadilhe/Go-AuctionHouse_Concurrency-Server-Api/internal/internal_error"
+)
+
+// contextCheckInterval define de quantos em quantos itens a conversão de uma listagem consulta o ctx
+// ctx.Err() é barato, mas checar a cada item não traz ganho - o custo está nas listagens enormes
+const contextCheckInterval = 256
+
+// checkContextEvery devolve erro quando o ctx da request já terminou, verificando a cada contextCheckInterval itens
+// Sem isso uma listagem grande seria convertida inteira só para o handler falhar ao escrever a resposta
+// Mesmo mapeamento do mongodb.ClassifyMongoError: deadline -> 504, cancelamento -> request_canceled
+func checkContextEvery(ctx context.Context, index int, message string) *internal_error.InternalError {
+	if index%contextCheckInterval != 0 {
+		return nil
+	}
+
+	err := ctx.Err()
+	switch {
+	case err == nil:
+		return nil
+	case errors.Is(err, context.DeadlineExceeded):
+		return internal_error.NewTimeoutError(message + ": request deadline exceeded")
+	default:
+		return internal_error.NewRequestCanceledError(message + ": request canceled")
+	}
+}
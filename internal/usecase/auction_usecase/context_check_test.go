@@ -0,0 +1,73 @@
+package auction_usecase
+
+import (
+	"context"
+	"testing"
+	"time"
+)
+
+// cancelAfterFirstCheck simula o cliente desistindo no meio da conversão:
+// a primeira consulta a Err() ainda vê a request ativa, as seguintes já veem o cancelamento
+type cancelAfterFirstCheck struct {
+	context.Context
+	checks int
+}
+
+func (c *cancelAfterFirstCheck) Err() error {
+	c.checks++
+	if c.checks == 1 {
+		return nil
+	}
+	return context.Canceled
+}
+
+func TestFindAllAuctionsStopsWhenCanceledMidConversion(t *testing.T) {
+	env := newTestEnv(testAuctionConfig())
+	for i := 0; i < contextCheckInterval+1; i++ {
+		env.createAuction(t, nil)
+	}
+
+	ctx := &cancelAfterFirstCheck{Context: context.Background()}
+	auctions, err := env.useCase.FindAllAuctions(ctx, AuctionListInputDTO{})
+	if err == nil || err.Err != "request_canceled" {
+		t.Fatalf("err = %v, want request_canceled", err)
+	}
+	if auctions != nil {
+		t.Fatalf("got %d auctions, want none after the cancellation", len(auctions))
+	}
+	// Uma checagem no item 0 e outra no item contextCheckInterval - não uma por item
+	if ctx.checks != 2 {
+		t.Fatalf("ctx.Err() called %d times, want 2", ctx.checks)
+	}
+}
+
+func TestCheckContextEvery(t *testing.T) {
+	canceled, cancel := context.WithCancel(context.Background())
+	cancel()
+	expired, cancelExpired := context.WithDeadline(context.Background(), time.Now().Add(-time.Second))
+	defer cancelExpired()
+
+	tests := []struct {
+		name  string
+		ctx   context.Context
+		index int
+		want  string
+	}{
+		{"active context", context.Background(), 0, ""},
+		{"canceled context", canceled, 0, "request_canceled"},
+		{"expired deadline", expired, contextCheckInterval, "timeout"},
+		{"between checks", canceled, contextCheckInterval - 1, ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := checkContextEvery(tt.ctx, tt.index, "listing")
+			got := ""
+			if err != nil {
+				got = err.Err
+			}
+			if got != tt.want {
+				t.Fatalf("err = %v, want %q", err, tt.want)
+			}
+		})
+	}
+}
@@ -2,63 +2,247 @@ package auction_usecase
 
 import (
 	"context"
+	"encoding/xml"
 	"time"
 
+	"github.com/Guilherme-G-Cadilhe/Go-AuctionHouse_Concurrency-Server-Api/configuration/config"
+	"github.com/Guilherme-G-Cadilhe/Go-AuctionHouse_Concurrency-Server-Api/configuration/logger"
+	"github.com/Guilherme-G-Cadilhe/Go-AuctionHouse_Concurrency-Server-Api/configuration/tracing"
+	"github.com/Guilherme-G-Cadilhe/Go-AuctionHouse_Concurrency-Server-Api/internal/auth_context"
+	"github.com/Guilherme-G-Cadilhe/Go-AuctionHouse_Concurrency-Server-Api/internal/bidder_mask"
+	"github.com/Guilherme-G-Cadilhe/Go-AuctionHouse_Concurrency-Server-Api/internal/clock"
 	"github.com/Guilherme-G-Cadilhe/Go-AuctionHouse_Concurrency-Server-Api/internal/entity/auction_entity"
 	"github.com/Guilherme-G-Cadilhe/Go-AuctionHouse_Concurrency-Server-Api/internal/entity/bid_entity"
 	"github.com/Guilherme-G-Cadilhe/Go-AuctionHouse_Concurrency-Server-Api/internal/internal_error"
+	"github.com/Guilherme-G-Cadilhe/Go-AuctionHouse_Concurrency-Server-Api/internal/jsontime"
+	"github.com/Guilherme-G-Cadilhe/Go-AuctionHouse_Concurrency-Server-Api/internal/sanitize"
 	"github.com/Guilherme-G-Cadilhe/Go-AuctionHouse_Concurrency-Server-Api/internal/usecase/bid_usecase"
+	"go.uber.org/zap"
 )
 
+// Os limites de tamanho são configuráveis (AUCTION_*_LENGTH) e validados na entidade
+// Por isso as binding tags só exigem presença - tags são estáticas e não leem o ambiente
 type AuctionInputDTO struct {
-	ProductName string           `json:"product_name" binding:"required,min=1"`
-	Category    string           `json:"category" binding:"required,min=2"`
-	Description string           `json:"description" binding:"required,min=10,max=200"`
-	Condition   ProductCondition `json:"condition" ` // binding:"required,oneof=1 2 3"
+	ProductName string            `json:"product_name" binding:"required"`
+	Category    string            `json:"category" binding:"required"`
+	Description string            `json:"description" binding:"required"`
+	Condition   *ProductCondition `json:"condition"` // Ponteiro: omitida (nil) usa AUCTION_DEFAULT_CONDITION; 0 explícito é new
+	Sealed      bool              `json:"sealed"`    // Sealed-bid: lances ocultos até o fechamento
+	Featured    bool              `json:"featured"`  // Destaque - apenas administradores (ADMIN_USER_IDS)
+
+	// AllowGuestBids aceita lances de convidados (apelido, sem cadastro) - só vale com GUEST_BIDS_ENABLED=true
+	AllowGuestBids bool `json:"allow_guest_bids"`
+
+	// Leilão holandês (type 1): preço cai price_decrement a cada decrement_interval até floor_price
+	// decrement_interval usa o formato do time.ParseDuration (ex: "30s", "5m")
+	Type              AuctionType `json:"type"`
+	StartPrice        float64     `json:"start_price"`
+	FloorPrice        float64     `json:"floor_price"`
+	PriceDecrement    float64     `json:"price_decrement"`
+	DecrementInterval string      `json:"decrement_interval"`
+
+	// Tabela de incrementos por faixa de preço (opcional, só leilões comuns) - exigida nos lances seguintes
+	IncrementSchedule []IncrementTierDTO `json:"increment_schedule"`
 }
 
+// IncrementTierDTO é uma faixa da tabela de incrementos: a partir de threshold, o lance sobe increment
+type IncrementTierDTO struct {
+	Threshold float64 `json:"threshold" xml:"threshold"`
+	Increment float64 `json:"increment" xml:"increment"`
+}
+
+// AuctionOutputDTO também é serializado em XML (Accept: application/xml) para integrações legadas
+// XMLName define o elemento raiz <auction>; json:"-" o mantém fora do JSON
 type AuctionOutputDTO struct {
-	Id          string           `json:"id"`
-	ProductName string           `json:"product_name"`
-	Category    string           `json:"category"`
-	Description string           `json:"description"`
-	Condition   ProductCondition `json:"condition"`
-	Status      AuctionStatus    `json:"status"`
-	Timestamp   time.Time        `json:"timestamp" time_format:"2006-01-02 15:04:05"`
+	XMLName        xml.Name         `json:"-" xml:"auction"`
+	Id             string           `json:"id" xml:"id"`
+	ProductName    string           `json:"product_name" xml:"product_name"`
+	Category       string           `json:"category" xml:"category"`
+	Description    string           `json:"description" xml:"description"`
+	Condition      ProductCondition `json:"condition" xml:"condition"`
+	Status         AuctionStatus    `json:"status" xml:"status"`
+	OwnerId        string           `json:"owner_id,omitempty" xml:"owner_id,omitempty"`
+	Sealed         bool             `json:"sealed" xml:"sealed"`
+	Featured       bool             `json:"featured" xml:"featured"` // A UI exibe um selo de destaque
+	AllowGuestBids bool             `json:"allow_guest_bids" xml:"allow_guest_bids"`
+	Timestamp      jsontime.Time    `json:"timestamp" xml:"timestamp"`
+	// CreatedAt repete o timestamp com um nome explícito; UpdatedAt muda a cada alteração persistida
+	CreatedAt jsontime.Time `json:"created_at" xml:"created_at"`
+	UpdatedAt jsontime.Time `json:"updated_at" xml:"updated_at"`
+	// EndTime é o fim efetivo (criação + AUCTION_INTERVAL, somadas as extensões) - a UI monta a contagem regressiva
+	// sem conhecer a configuração do servidor; sem AUCTION_CLOSE_SKEW, que é só uma tolerância interna
+	EndTime jsontime.Time `json:"end_time" xml:"end_time"`
+	Type    AuctionType   `json:"type" xml:"type"`
+
+	// CurrentPrice é o preço atual de um leilão holandês ativo - lances a partir dele arrematam o leilão
+	CurrentPrice *float64 `json:"current_price,omitempty" xml:"current_price,omitempty"`
+
+	// IncrementSchedule é a tabela de incrementos do leilão (ausente = incremento único)
+	IncrementSchedule []IncrementTierDTO `json:"increment_schedule,omitempty" xml:"increment_schedule>tier,omitempty"`
+
+	// NextMinimumBid sugere o próximo lance (vencedor + incremento) - só em GET /auctions/:auctionId
+	// Ausente em leilões fechados e em sealed-bid ativos (revelaria o lance vencedor)
+	NextMinimumBid *float64 `json:"next_minimum_bid,omitempty" xml:"next_minimum_bid,omitempty"`
+}
+
+// AuctionSummaryOutputDTO é a visão resumida da listagem (GET /auctions?view=summary)
+// Só o necessário para uma lista leve: sem descrição, datas ou flags do leilão
+type AuctionSummaryOutputDTO struct {
+	XMLName      xml.Name      `json:"-" xml:"auction"`
+	Id           string        `json:"id" xml:"id"`
+	ProductName  string        `json:"product_name" xml:"product_name"`
+	Status       AuctionStatus `json:"status" xml:"status"`
+	CurrentPrice *float64      `json:"current_price,omitempty" xml:"current_price,omitempty"` // Mesmo cálculo da visão completa
+}
+
+// AuctionSummaryListXMLDTO é o elemento raiz <auctions> da visão resumida
+type AuctionSummaryListXMLDTO struct {
+	XMLName  xml.Name                  `xml:"auctions"`
+	Auctions []AuctionSummaryOutputDTO `xml:"auction"`
+}
+
+// AuctionListXMLDTO envolve a lista de leilões em um elemento raiz <auctions>
+// XML exige um único elemento raiz - um slice puro geraria um documento inválido
+type AuctionListXMLDTO struct {
+	XMLName  xml.Name           `xml:"auctions"`
+	Auctions []AuctionOutputDTO `xml:"auction"`
 }
 
 type WinningInfoOutputDTO struct {
 	Auction AuctionOutputDTO          `json:"auction"`
-	Bid     *bid_usecase.BidOutputDTO `json:"bid ,omitempty"`
+	Bid     *bid_usecase.BidOutputDTO `json:"bid,omitempty"`
+	// CloseReason só aparece em leilões fechados: "sold" (com bid) ou "no_bids" (bid ausente, resposta 200)
+	CloseReason string `json:"close_reason,omitempty"`
 }
 
 type ProductCondition int64
 type AuctionStatus int64
+type AuctionType int64
 
 type AuctionUseCase struct {
 	auctionRepositoryInterface auction_entity.AuctionRepositoryInterface
 	bidRepositoryInterface     bid_entity.BidEntityRepository
+	reopenGraceWindow          time.Duration                 // Prazo após o fechamento em que o leilão pode ser reaberto
+	durationBounds             auction_entity.DurationBounds // AUCTION_MIN_DURATION / AUCTION_MAX_DURATION
+	fieldBounds                auction_entity.AuctionFieldBounds
+	descriptionPolicy          *sanitize.HTMLPolicy // Sanitização do HTML da descrição (AUCTION_DESCRIPTION_ALLOWED_TAGS)
+	minBidIncrement            float64              // Somado ao lance vencedor no next_minimum_bid
+	startingPrice              float64              // Preço de leilões sem lances
+	firstBidMinimum            float64              // FIRST_BID_POLICY - mínimo exigido do primeiro lance (0 = qualquer positivo)
+	defaultCondition           ProductCondition     // Condição aplicada quando a criação omite "condition"
+	unfilteredLimit            int                  // AUCTION_UNFILTERED_LIMIT - teto da listagem sem filtros (0 = sem limite)
+	maxExtensions              int                  // AUCTION_MAX_EXTENSIONS - extensões por leilão (0 = ilimitado)
+	admins                     map[string]struct{}  // ADMIN_USER_IDS - únicos que podem destacar leilões
+	bidderMasker               *bidder_mask.Masker  // Anonimização do user_id em todo lance das respostas (sealed / MASK_BIDDER_IDS)
+	clock                      clock.Clock
 }
 
 type AuctionUseCaseInterface interface {
 	CreateAuction(ctx context.Context, auctionInput AuctionInputDTO) *internal_error.InternalError
 	FindAuctionById(ctx context.Context, id string) (*AuctionOutputDTO, *internal_error.InternalError)
-	FindAllAuctions(ctx context.Context, status AuctionStatus, category, productName string) ([]AuctionOutputDTO, *internal_error.InternalError)
+	FindAllAuctions(ctx context.Context, input AuctionListInputDTO) ([]AuctionOutputDTO, *internal_error.InternalError)
+	FindAuctionSummaries(ctx context.Context, input AuctionListInputDTO) ([]AuctionSummaryOutputDTO, *internal_error.InternalError)
+	FindAuctionsByIds(ctx context.Context, input AuctionBatchInputDTO) ([]AuctionOutputDTO, *internal_error.InternalError)
 	FindWinningBidByAuctionId(ctx context.Context, auctionId string) (*WinningInfoOutputDTO, *internal_error.InternalError)
+	FindAuctionActivity(ctx context.Context, auctionId string) ([]ActivityOutputDTO, *internal_error.InternalError)
+	ExtendAuction(ctx context.Context, auctionId string, extendInput AuctionExtendInputDTO) *internal_error.InternalError
+	ReopenAuction(ctx context.Context, auctionId string, reopenInput AuctionReopenInputDTO) *internal_error.InternalError
+	SetAuctionFeatured(ctx context.Context, auctionId string, featuredInput AuctionFeaturedInputDTO) *internal_error.InternalError
+	OverrideAuctionWinner(ctx context.Context, auctionId string, input AuctionWinnerInputDTO) (*WinningInfoOutputDTO, *internal_error.InternalError)
+	RecomputeWinners(ctx context.Context, input RecomputeWinnersInputDTO) (*RecomputeWinnersOutputDTO, *internal_error.InternalError)
+	DeleteAuction(ctx context.Context, auctionId string) *internal_error.InternalError
+	FindAuctionExtensions(ctx context.Context, auctionId string) ([]ExtensionOutputDTO, *internal_error.InternalError)
+	FindAuctionPrice(ctx context.Context, auctionId string) (*AuctionPriceOutputDTO, *internal_error.InternalError)
+	FindCategoryCounts(ctx context.Context) ([]CategoryCountOutputDTO, *internal_error.InternalError)
+	FindConditions(ctx context.Context) []ConditionOutputDTO
+	FindAuctionWinners(ctx context.Context, input AuctionWinnersInputDTO) (*AuctionWinnersOutputDTO, *internal_error.InternalError)
+	FindUserLeadingAuctions(ctx context.Context, userId string, input UserLeadingInputDTO) ([]WinningInfoOutputDTO, *internal_error.InternalError)
+	WatchAuction(ctx context.Context, auctionId string) (*AuctionWatchersOutputDTO, *internal_error.InternalError)
+	UnwatchAuction(ctx context.Context, auctionId string) (*AuctionWatchersOutputDTO, *internal_error.InternalError)
+	FindAuctionWatchers(ctx context.Context, auctionId string) (*AuctionWatchersOutputDTO, *internal_error.InternalError)
 }
 
-func NewAuctionUseCase(auctionRepositoryInterface auction_entity.AuctionRepositoryInterface, bidRepositoryInterface bid_entity.BidEntityRepository) AuctionUseCaseInterface {
+// bidCfg traz os administradores (ADMIN_USER_IDS) e a anonimização dos lances exibidos (MASK_BIDDER_IDS)
+func NewAuctionUseCase(auctionRepositoryInterface auction_entity.AuctionRepositoryInterface, bidRepositoryInterface bid_entity.BidEntityRepository, cfg config.AuctionConfig, bidCfg config.BidConfig, clk clock.Clock) AuctionUseCaseInterface {
+	admins := make(map[string]struct{}, len(bidCfg.AdminUserIds))
+	for _, userId := range bidCfg.AdminUserIds {
+		admins[userId] = struct{}{}
+	}
+
+	// A criação usa sempre o AUCTION_INTERVAL - fora dos limites é erro de configuração, não do vendedor
+	durationBounds := auction_entity.DurationBounds{Min: cfg.MinDuration, Max: cfg.MaxDuration}
+	conditions, defaultCondition := newProductConditions(cfg.Conditions, cfg.DefaultCondition)
+	if cause := durationBounds.Check("AUCTION_INTERVAL", cfg.Interval); cause != nil {
+		logger.Warn("AUCTION_INTERVAL is outside AUCTION_MIN_DURATION/AUCTION_MAX_DURATION", zap.String("reason", cause.Message))
+	}
+
 	return &AuctionUseCase{
 		auctionRepositoryInterface: auctionRepositoryInterface,
 		bidRepositoryInterface:     bidRepositoryInterface,
+		reopenGraceWindow:          cfg.ReopenGraceWindow,
+		durationBounds:             durationBounds,
+		fieldBounds: auction_entity.AuctionFieldBounds{
+			ProductName: auction_entity.LengthBounds{Min: cfg.ProductNameMinLength, Max: cfg.ProductNameMaxLength},
+			Category:    auction_entity.LengthBounds{Min: cfg.CategoryMinLength, Max: cfg.CategoryMaxLength},
+			Description: auction_entity.LengthBounds{Min: cfg.DescriptionMinLength, Max: cfg.DescriptionMaxLength},
+			Conditions:  conditions,
+		},
+		descriptionPolicy: sanitize.NewHTMLPolicy(cfg.DescriptionAllowedTags),
+		minBidIncrement:   cfg.MinBidIncrement,
+		startingPrice:     cfg.StartingPrice,
+		firstBidMinimum:   bid_entity.ParseFirstBidPolicy(cfg.FirstBidPolicy).MinimumFirstBid(cfg.StartingPrice, cfg.MinBidIncrement),
+		defaultCondition:  defaultCondition,
+		unfilteredLimit:   cfg.UnfilteredLimit,
+		maxExtensions:     cfg.MaxExtensions,
+		admins:            admins,
+		bidderMasker:      bidder_mask.NewMasker(bidCfg),
+		clock:             clk,
 	}
 }
 
 func (au *AuctionUseCase) CreateAuction(ctx context.Context, auctionInput AuctionInputDTO) *internal_error.InternalError {
-	auction, err := auction_entity.CreateAuctionBody(auctionInput.ProductName, auctionInput.Category, auctionInput.Description, auction_entity.ProductCondition(auctionInput.Condition))
+	ctx, span := tracing.Start(ctx, "AuctionUseCase.CreateAuction")
+	defer span.End()
+
+	// Operação protegida - o dono do leilão é o usuário autenticado
+	ownerId, err := auth_context.RequireUserID(ctx)
+	if err != nil {
+		return err
+	}
+
+	// Default aplicado antes da validação: um valor explícito inválido continua sendo rejeitado pela entidade
+	condition := au.defaultCondition
+	if auctionInput.Condition != nil {
+		condition = *auctionInput.Condition
+	}
+
+	auction, err := auction_entity.CreateAuctionBody(auctionInput.ProductName, auctionInput.Category, auctionInput.Description, auction_entity.ProductCondition(condition), au.fieldBounds, au.descriptionPolicy, au.clock.Now())
 	if err != nil {
 		return err
 	}
+	auction.OwnerId = ownerId
+	auction.Sealed = auctionInput.Sealed
+	auction.AllowGuestBids = auctionInput.AllowGuestBids
+
+	if auctionInput.Featured {
+		if !au.isAdmin(ownerId) {
+			return internal_error.NewForbiddenError("only admins can feature an auction")
+		}
+		auction.Featured = true
+	}
+
+	if auction_entity.AuctionType(auctionInput.Type) == auction_entity.Dutch {
+		if err := configureDutchAuction(auction, auctionInput); err != nil {
+			return err
+		}
+	} else if auction_entity.AuctionType(auctionInput.Type) != auction_entity.English {
+		return internal_error.NewBadRequestError("invalid auction type",
+			internal_error.Cause{Field: "type", Message: "type must be 0 (english) or 1 (dutch)"})
+	}
+
+	if err := auction.ConfigureIncrementSchedule(toEntityIncrementSchedule(auctionInput.IncrementSchedule)); err != nil {
+		return err
+	}
 
 	err = au.auctionRepositoryInterface.CreateAuction(ctx, auction)
 	if err != nil {
@@ -66,3 +250,43 @@ func (au *AuctionUseCase) CreateAuction(ctx context.Context, auctionInput Auctio
 	}
 	return nil
 }
+
+// toEntityIncrementSchedule converte a tabela recebida - a validação das faixas fica na entidade
+func toEntityIncrementSchedule(tiers []IncrementTierDTO) auction_entity.IncrementSchedule {
+	if len(tiers) == 0 {
+		return nil
+	}
+	schedule := make(auction_entity.IncrementSchedule, 0, len(tiers))
+	for _, tier := range tiers {
+		schedule = append(schedule, auction_entity.IncrementTier{Threshold: tier.Threshold, Increment: tier.Increment})
+	}
+	return schedule
+}
+
+// toIncrementScheduleDTO converte a tabela do leilão para a resposta (nil = campo omitido)
+func toIncrementScheduleDTO(schedule auction_entity.IncrementSchedule) []IncrementTierDTO {
+	if len(schedule) == 0 {
+		return nil
+	}
+	tiers := make([]IncrementTierDTO, 0, len(schedule))
+	for _, tier := range schedule {
+		tiers = append(tiers, IncrementTierDTO{Threshold: tier.Threshold, Increment: tier.Increment})
+	}
+	return tiers
+}
+
+// configureDutchAuction converte a tabela de preços recebida e a valida na entidade
+func configureDutchAuction(auction *auction_entity.Auction, auctionInput AuctionInputDTO) *internal_error.InternalError {
+	// Intervalo inválido vira zero - a entidade devolve a causa junto com as demais
+	interval, errParse := time.ParseDuration(auctionInput.DecrementInterval)
+	if errParse != nil {
+		interval = 0
+	}
+
+	return auction.ConfigureDutch(auction_entity.DutchSchedule{
+		StartPrice:        auctionInput.StartPrice,
+		FloorPrice:        auctionInput.FloorPrice,
+		PriceDecrement:    auctionInput.PriceDecrement,
+		DecrementInterval: interval,
+	})
+}
@@ -0,0 +1,98 @@
+package auction_usecase
+
+import (
+	"context"
+	"testing"
+
+	"github.com/Guilherme-G-Cadilhe/Go-AuctionHouse_Concurrency-Server-Api/internal/auth_context"
+	"github.com/Guilherme-G-Cadilhe/Go-AuctionHouse_Concurrency-Server-Api/internal/entity/auction_entity"
+)
+
+// newAuctionInput é uma criação válida; a condição fica a cargo de cada teste
+func newAuctionInput(condition *ProductCondition) AuctionInputDTO {
+	return AuctionInputDTO{
+		ProductName: "Phone X",
+		Category:    "Electronics",
+		Description: "a nice phone here ok",
+		Condition:   condition,
+	}
+}
+
+func TestCreateAuctionDefaultCondition(t *testing.T) {
+	cfg := testAuctionConfig()
+	cfg.DefaultCondition = 2
+	env := newTestEnv(cfg)
+	ctx := auth_context.WithUserID(context.Background(), testBidderId)
+
+	if err := env.useCase.CreateAuction(ctx, newAuctionInput(nil)); err != nil {
+		t.Fatalf("CreateAuction without condition: %v", err)
+	}
+	explicitNew := ProductCondition(0)
+	if err := env.useCase.CreateAuction(ctx, newAuctionInput(&explicitNew)); err != nil {
+		t.Fatalf("CreateAuction with condition 0: %v", err)
+	}
+
+	auctions, err := env.useCase.FindAllAuctions(context.Background(), AuctionListInputDTO{})
+	if err != nil {
+		t.Fatalf("FindAllAuctions: %v", err)
+	}
+	if len(auctions) != 2 {
+		t.Fatalf("got %d auctions, want 2", len(auctions))
+	}
+	if auctions[0].Condition != 2 {
+		t.Fatalf("omitted condition = %d, want the configured default 2", auctions[0].Condition)
+	}
+	// 0 explícito não é confundido com "omitida"
+	if auctions[1].Condition != 0 {
+		t.Fatalf("explicit condition 0 = %d, want 0", auctions[1].Condition)
+	}
+}
+
+func TestCreateAuctionRejectsInvalidExplicitCondition(t *testing.T) {
+	env := newTestEnv(testAuctionConfig())
+	ctx := auth_context.WithUserID(context.Background(), testBidderId)
+
+	invalid := ProductCondition(9)
+	err := env.useCase.CreateAuction(ctx, newAuctionInput(&invalid))
+	if err == nil || err.Err != "bad_request" {
+		t.Fatalf("CreateAuction with condition 9: got %v, want bad_request", err)
+	}
+}
+
+// Criação, EndTime e o evento "created" seguem o Clock injetado - o relógio falso está em 2024
+func TestCreateAuctionUsesInjectedClock(t *testing.T) {
+	cfg := testAuctionConfig()
+	env := newTestEnv(cfg)
+	ctx := auth_context.WithUserID(context.Background(), testBidderId)
+	now := env.clock.Now()
+
+	if err := env.useCase.CreateAuction(ctx, newAuctionInput(nil)); err != nil {
+		t.Fatalf("CreateAuction: %v", err)
+	}
+	auctions, err := env.auctions.FindAllAuctions(context.Background(), auction_entity.AuctionListFilter{})
+	if err != nil || len(auctions) != 1 {
+		t.Fatalf("FindAllAuctions: %v (%d auctions)", err, len(auctions))
+	}
+	auction := auctions[0]
+	if !auction.Timestamp.Equal(now) || !auction.UpdatedAt.Equal(now) {
+		t.Fatalf("timestamp = %v, updated_at = %v, want the fake clock %v", auction.Timestamp, auction.UpdatedAt, now)
+	}
+	if want := now.Add(cfg.Interval); !auction.EndTime.Equal(want) {
+		t.Fatalf("end time = %v, want %v", auction.EndTime, want)
+	}
+
+	events, err := env.auctions.FindAuctionEventsByAuctionId(context.Background(), auction.Id)
+	if err != nil || len(events) != 1 {
+		t.Fatalf("FindAuctionEventsByAuctionId: %v (%d events)", err, len(events))
+	}
+	if !events[0].Timestamp.Equal(now) {
+		t.Fatalf("created event timestamp = %v, want %v", events[0].Timestamp, now)
+	}
+
+	// O fechamento pelo timer também registra o evento no horário do relógio falso
+	env.clock.Advance(cfg.Interval)
+	events, _ = env.auctions.FindAuctionEventsByAuctionId(context.Background(), auction.Id)
+	if len(events) != 2 || !events[1].Timestamp.Equal(now.Add(cfg.Interval)) {
+		t.Fatalf("events = %+v, want a closed event at %v", events, now.Add(cfg.Interval))
+	}
+}
@@ -0,0 +1,52 @@
+package auction_usecase
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Guilherme-G-Cadilhe/Go-AuctionHouse_Concurrency-Server-Api/configuration/logger"
+	"github.com/Guilherme-G-Cadilhe/Go-AuctionHouse_Concurrency-Server-Api/configuration/tracing"
+	"github.com/Guilherme-G-Cadilhe/Go-AuctionHouse_Concurrency-Server-Api/internal/auth_context"
+	"github.com/Guilherme-G-Cadilhe/Go-AuctionHouse_Concurrency-Server-Api/internal/entity/auction_entity"
+	"github.com/Guilherme-G-Cadilhe/Go-AuctionHouse_Concurrency-Server-Api/internal/internal_error"
+	"go.uber.org/zap"
+)
+
+// DeleteAuction remove um leilão fechado e todos os seus lances
+// Sem usuário autenticado: 401; quem não é o dono nem admin: 403
+// Leilão inexistente: 404; leilão ativo: 409 - os participantes ainda estão dando lances
+func (au *AuctionUseCase) DeleteAuction(ctx context.Context, auctionId string) *internal_error.InternalError {
+	ctx, span := tracing.Start(ctx, "AuctionUseCase.DeleteAuction")
+	defer span.End()
+
+	userId, err := auth_context.RequireUserID(ctx)
+	if err != nil {
+		return err
+	}
+
+	auction, err := au.auctionRepositoryInterface.FindAuctionById(ctx, auctionId)
+	if err != nil {
+		return err
+	}
+	if auction.OwnerId != userId && !au.isAdmin(userId) {
+		return internal_error.NewForbiddenError("only the auction owner or an admin can delete an auction")
+	}
+	if auction.Status != auction_entity.Completed {
+		return internal_error.NewConflictError(fmt.Sprintf("auction %s is still active and cannot be deleted", auctionId))
+	}
+
+	// O repositório repete a checagem do status na remoção: uma reabertura concorrente vira 409
+	if err := au.auctionRepositoryInterface.DeleteAuction(ctx, auctionId); err != nil {
+		return err
+	}
+
+	// Status e fim em cache do leilão removido não servem mais para nada
+	au.bidRepositoryInterface.InvalidateAuctionCache(auctionId)
+
+	// Auditoria: a remoção não deixa histórico no banco - o log é o único registro
+	logger.Info("auction deleted",
+		zap.String("auction_id", auctionId),
+		zap.String("owner_id", auction.OwnerId),
+		zap.String("actor_id", userId))
+	return nil
+}
@@ -0,0 +1,70 @@
+package auction_usecase
+
+import (
+	"context"
+	"testing"
+
+	"github.com/Guilherme-G-Cadilhe/Go-AuctionHouse_Concurrency-Server-Api/internal/auth_context"
+	"github.com/Guilherme-G-Cadilhe/Go-AuctionHouse_Concurrency-Server-Api/internal/entity/auction_entity"
+	"github.com/Guilherme-G-Cadilhe/Go-AuctionHouse_Concurrency-Server-Api/internal/entity/bid_entity"
+)
+
+const testOwnerId = "55555555-5555-5555-5555-555555555555"
+
+func TestDeleteAuction(t *testing.T) {
+	tests := []struct {
+		name    string
+		userId  string
+		close   bool
+		missing bool
+		wantErr string // "" = removido
+	}{
+		{"owner", testOwnerId, true, false, ""},
+		{"admin", testAdminId, true, false, ""},
+		{"without user", "", true, false, "unauthorized"},
+		{"other user", testBidderId, true, false, "forbidden"},
+		{"active auction", testOwnerId, false, false, "conflict"},
+		{"missing auction", testOwnerId, true, true, "not_found"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			env := newTestEnv(testAuctionConfig())
+			auction := env.createAuction(t, func(a *auction_entity.Auction) { a.OwnerId = testOwnerId })
+			env.storeBids(t, auction.Id, 10)
+			if tt.close {
+				env.closeByTimer(t, auction.Id)
+			}
+
+			ctx := context.Background()
+			if tt.userId != "" {
+				ctx = auth_context.WithUserID(ctx, tt.userId)
+			}
+			auctionId := auction.Id
+			if tt.missing {
+				auctionId = "66666666-6666-6666-6666-666666666666"
+			}
+
+			err := env.useCase.DeleteAuction(ctx, auctionId)
+			if tt.wantErr != "" {
+				if err == nil || err.Err != tt.wantErr {
+					t.Fatalf("err = %v, want %s", err, tt.wantErr)
+				}
+				if _, errFind := env.auctions.FindAuctionById(context.Background(), auction.Id); errFind != nil {
+					t.Fatalf("refused delete removed the auction: %v", errFind)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("DeleteAuction: %v", err)
+			}
+
+			if _, errFind := env.auctions.FindAuctionById(context.Background(), auction.Id); errFind == nil || errFind.Err != "not_found" {
+				t.Fatalf("FindAuctionById after delete: err = %v, want not_found", errFind)
+			}
+			bids, errBids := env.bids.FindBidByAuctionId(context.Background(), auction.Id, bid_entity.BidFilter{})
+			if errBids != nil || len(bids) != 0 {
+				t.Fatalf("bids after delete = %v (%v), want none", bids, errBids)
+			}
+		})
+	}
+}
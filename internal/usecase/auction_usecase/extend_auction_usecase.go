@@ -0,0 +1,64 @@
+package auction_usecase
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/Guilherme-G-Cadilhe/Go-AuctionHouse_Concurrency-Server-Api/configuration/logger"
+	"github.com/Guilherme-G-Cadilhe/Go-AuctionHouse_Concurrency-Server-Api/configuration/tracing"
+	"github.com/Guilherme-G-Cadilhe/Go-AuctionHouse_Concurrency-Server-Api/internal/entity/auction_entity"
+	"github.com/Guilherme-G-Cadilhe/Go-AuctionHouse_Concurrency-Server-Api/internal/internal_error"
+	"go.uber.org/zap"
+)
+
+// AuctionExtendInputDTO recebe o tempo extra a ser somado ao fim do leilão
+// Duration usa o formato do time.ParseDuration (ex: "30m", "1h30m")
+type AuctionExtendInputDTO struct {
+	Duration string `json:"duration" binding:"required"`
+}
+
+// ExtendAuction soma a duração ao fim efetivo de um leilão ativo
+// Atualiza persistência + reagenda fechamento e invalida o cache de lances
+func (au *AuctionUseCase) ExtendAuction(ctx context.Context, auctionId string, extendInput AuctionExtendInputDTO) *internal_error.InternalError {
+	ctx, span := tracing.Start(ctx, "AuctionUseCase.ExtendAuction")
+	defer span.End()
+
+	duration, errParse := time.ParseDuration(extendInput.Duration)
+	if errParse != nil || duration <= 0 {
+		return internal_error.NewBadRequestError("duration must be a positive duration (e.g. 30m, 1h)")
+	}
+
+	auction, err := au.auctionRepositoryInterface.FindAuctionById(ctx, auctionId)
+	if err != nil {
+		return err
+	}
+
+	if auction.Status != auction_entity.Active {
+		return internal_error.NewConflictError(fmt.Sprintf("auction %s is not active", auctionId))
+	}
+
+	// Limite de extensões: o leilão continua aceitando lances até o fim atual, só não é mais estendido
+	// O repositório repete a checagem de forma atômica para extensões simultâneas
+	if au.maxExtensions > 0 && auction.ExtensionCount >= au.maxExtensions {
+		logger.Info("auction extension cap reached",
+			zap.String("auction_id", auctionId),
+			zap.Int("extensions", auction.ExtensionCount),
+			zap.Int("max_extensions", au.maxExtensions))
+		return internal_error.NewConflictError(fmt.Sprintf("auction %s reached the maximum of %d extensions", auctionId, au.maxExtensions))
+	}
+
+	// AUCTION_MAX_DURATION vale para a vida toda do leilão: criação até o novo fim
+	newEndTime := auction.EndTime.Add(duration)
+	if cause := au.durationBounds.Check("duration", newEndTime.Sub(auction.Timestamp)); cause != nil {
+		return internal_error.NewBadRequestError("invalid fields", *cause)
+	}
+
+	if err := au.auctionRepositoryInterface.UpdateAuctionEndTime(ctx, auctionId, newEndTime, au.maxExtensions); err != nil {
+		return err
+	}
+
+	// Sem invalidar, o BidRepository continuaria rejeitando lances pelo fim antigo em cache
+	au.bidRepositoryInterface.InvalidateAuctionCache(auctionId)
+	return nil
+}
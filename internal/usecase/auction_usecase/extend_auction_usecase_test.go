@@ -0,0 +1,79 @@
+package auction_usecase
+
+import (
+	"context"
+	"testing"
+	"time"
+)
+
+func TestExtendAuctionStopsAtMaxExtensions(t *testing.T) {
+	cfg := testAuctionConfig()
+	cfg.MaxExtensions = 2
+	env := newTestEnv(cfg)
+	auction := env.createAuction(t, nil)
+	ctx := context.Background()
+	extend := AuctionExtendInputDTO{Duration: "1m"}
+
+	for i := 1; i <= cfg.MaxExtensions; i++ {
+		if err := env.useCase.ExtendAuction(ctx, auction.Id, extend); err != nil {
+			t.Fatalf("extension %d: %v", i, err)
+		}
+	}
+
+	err := env.useCase.ExtendAuction(ctx, auction.Id, extend)
+	if err == nil || err.Err != "conflict" {
+		t.Fatalf("extension past the cap: got %v, want conflict", err)
+	}
+
+	stored, _ := env.auctions.FindAuctionById(ctx, auction.Id)
+	if stored.ExtensionCount != cfg.MaxExtensions {
+		t.Fatalf("extension count = %d, want %d", stored.ExtensionCount, cfg.MaxExtensions)
+	}
+	if want := auction.EndTime.Add(2 * time.Minute); !stored.EndTime.Equal(want) {
+		t.Fatalf("end time = %v, want %v (the refused extension must not move it)", stored.EndTime, want)
+	}
+}
+
+func TestExtendAuctionUnlimitedWhenCapIsZero(t *testing.T) {
+	env := newTestEnv(testAuctionConfig())
+	auction := env.createAuction(t, nil)
+
+	for i := 1; i <= 5; i++ {
+		if err := env.useCase.ExtendAuction(context.Background(), auction.Id, AuctionExtendInputDTO{Duration: "1m"}); err != nil {
+			t.Fatalf("extension %d: %v", i, err)
+		}
+	}
+}
+
+// O repositório repete a checagem: uma extensão concorrente que passou pelo caso de uso não fura o limite
+func TestUpdateAuctionEndTimeEnforcesCap(t *testing.T) {
+	env := newTestEnv(testAuctionConfig())
+	auction := env.createAuction(t, nil)
+	ctx := context.Background()
+
+	if err := env.auctions.UpdateAuctionEndTime(ctx, auction.Id, auction.EndTime.Add(time.Minute), 1); err != nil {
+		t.Fatalf("first extension: %v", err)
+	}
+	if err := env.auctions.UpdateAuctionEndTime(ctx, auction.Id, auction.EndTime.Add(2*time.Minute), 1); err == nil || err.Err != "conflict" {
+		t.Fatalf("second extension: got %v, want conflict", err)
+	}
+}
+
+// AUCTION_MAX_DURATION vale para a vida toda do leilão: criação até o novo fim
+func TestExtendAuctionMaxDurationBoundary(t *testing.T) {
+	cfg := testAuctionConfig()
+	cfg.MaxDuration = 10 * time.Minute
+	env := newTestEnv(cfg)
+	ctx := context.Background()
+
+	atLimit := env.createAuction(t, nil)
+	if err := env.useCase.ExtendAuction(ctx, atLimit.Id, AuctionExtendInputDTO{Duration: "5m"}); err != nil {
+		t.Fatalf("extension up to exactly the maximum: %v", err)
+	}
+
+	overLimit := env.createAuction(t, nil)
+	err := env.useCase.ExtendAuction(ctx, overLimit.Id, AuctionExtendInputDTO{Duration: "5m1s"})
+	if err == nil || err.Err != "bad_request" || len(err.Causes) != 1 || err.Causes[0].Field != "duration" {
+		t.Fatalf("extension past the maximum: got %+v, want bad_request on duration", err)
+	}
+}
@@ -0,0 +1,38 @@
+package auction_usecase
+
+import (
+	"context"
+
+	"github.com/Guilherme-G-Cadilhe/Go-AuctionHouse_Concurrency-Server-Api/configuration/tracing"
+	"github.com/Guilherme-G-Cadilhe/Go-AuctionHouse_Concurrency-Server-Api/internal/auth_context"
+	"github.com/Guilherme-G-Cadilhe/Go-AuctionHouse_Concurrency-Server-Api/internal/internal_error"
+)
+
+// AuctionFeaturedInputDTO marca (true) ou desmarca (false) o destaque de um leilão
+// Ponteiro para o binding "required" aceitar false - com bool puro, false seria tratado como ausente
+type AuctionFeaturedInputDTO struct {
+	Featured *bool `json:"featured" binding:"required"`
+}
+
+// SetAuctionFeatured altera o destaque de um leilão - operação exclusiva de administradores
+// Sem usuário autenticado: 401; usuário que não é admin: 403
+func (au *AuctionUseCase) SetAuctionFeatured(ctx context.Context, auctionId string, featuredInput AuctionFeaturedInputDTO) *internal_error.InternalError {
+	ctx, span := tracing.Start(ctx, "AuctionUseCase.SetAuctionFeatured")
+	defer span.End()
+
+	userId, err := auth_context.RequireUserID(ctx)
+	if err != nil {
+		return err
+	}
+	if !au.isAdmin(userId) {
+		return internal_error.NewForbiddenError("only admins can feature an auction")
+	}
+
+	return au.auctionRepositoryInterface.SetAuctionFeatured(ctx, auctionId, *featuredInput.Featured)
+}
+
+// isAdmin indica se o usuário está em ADMIN_USER_IDS
+func (au *AuctionUseCase) isAdmin(userId string) bool {
+	_, ok := au.admins[userId]
+	return ok
+}
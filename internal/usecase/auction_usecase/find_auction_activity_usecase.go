@@ -0,0 +1,86 @@
+package auction_usecase
+
+import (
+	"context"
+	"sort"
+
+	"github.com/Guilherme-G-Cadilhe/Go-AuctionHouse_Concurrency-Server-Api/configuration/tracing"
+	"github.com/Guilherme-G-Cadilhe/Go-AuctionHouse_Concurrency-Server-Api/internal/entity/bid_entity"
+	"github.com/Guilherme-G-Cadilhe/Go-AuctionHouse_Concurrency-Server-Api/internal/internal_error"
+	"github.com/Guilherme-G-Cadilhe/Go-AuctionHouse_Concurrency-Server-Api/internal/jsontime"
+	"github.com/Guilherme-G-Cadilhe/Go-AuctionHouse_Concurrency-Server-Api/internal/usecase/bid_usecase"
+)
+
+// Tipos de item do activity feed
+const (
+	ActivityTypeBid    = "bid"
+	ActivityTypeStatus = "status"
+)
+
+// ActivityOutputDTO é um item do histórico do leilão
+// Type "bid" preenche Bid; Type "status" preenche Event (created, closed, cancelled, reopened, extended, winner_overridden, winner_recomputed)
+type ActivityOutputDTO struct {
+	Type      string                    `json:"type"`
+	Event     string                    `json:"event,omitempty"`
+	Bid       *bid_usecase.BidOutputDTO `json:"bid,omitempty"`
+	Timestamp jsontime.Time             `json:"timestamp"`
+}
+
+// FindAuctionActivity monta o histórico cronológico do leilão
+// combinando lances (coleção "bids") e transições de status (coleção "auction_events")
+func (au *AuctionUseCase) FindAuctionActivity(ctx context.Context, auctionId string) ([]ActivityOutputDTO, *internal_error.InternalError) {
+	ctx, span := tracing.Start(ctx, "AuctionUseCase.FindAuctionActivity")
+	defer span.End()
+
+	// Garante 404 para leilão inexistente em vez de um feed vazio
+	auction, err := au.auctionRepositoryInterface.FindAuctionById(ctx, auctionId)
+	if err != nil {
+		return nil, err
+	}
+
+	events, err := au.auctionRepositoryInterface.FindAuctionEventsByAuctionId(ctx, auctionId)
+	if err != nil {
+		return nil, err
+	}
+
+	// Sealed-bid: enquanto ativo, o feed mostra apenas as mudanças de status
+	var bids []bid_entity.Bid
+	if !auction.BidsAreHidden() {
+		bids, err = au.bidRepositoryInterface.FindBidByAuctionId(ctx, auctionId, bid_entity.BidFilter{})
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	activity := make([]ActivityOutputDTO, 0, len(events)+len(bids))
+	for _, event := range events {
+		activity = append(activity, ActivityOutputDTO{
+			Type:      ActivityTypeStatus,
+			Event:     string(event.Type),
+			Timestamp: jsontime.New(event.Timestamp),
+		})
+	}
+
+	for _, bid := range bids {
+		activity = append(activity, ActivityOutputDTO{
+			Type: ActivityTypeBid,
+			Bid: &bid_usecase.BidOutputDTO{
+				Id:        bid.Id,
+				UserId:    au.bidderMasker.VisibleUserId(ctx, auction, bid.UserId),
+				Handle:    au.bidderMasker.VisibleHandle(ctx, auction, bid.Handle),
+				AuctionId: bid.AuctionId,
+				Amount:    bid.Amount,
+				Timestamp: jsontime.New(bid.Timestamp),
+				Sequence:  bid.Sequence,
+			},
+			Timestamp: jsontime.New(bid.Timestamp),
+		})
+	}
+
+	// SliceStable mantém eventos de status antes de lances com o mesmo timestamp
+	sort.SliceStable(activity, func(i, j int) bool {
+		return activity[i].Timestamp.Before(activity[j].Timestamp.Time)
+	})
+
+	return activity, nil
+}
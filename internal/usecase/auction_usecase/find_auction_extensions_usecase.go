@@ -0,0 +1,48 @@
+package auction_usecase
+
+import (
+	"context"
+
+	"github.com/Guilherme-G-Cadilhe/Go-AuctionHouse_Concurrency-Server-Api/configuration/tracing"
+	"github.com/Guilherme-G-Cadilhe/Go-AuctionHouse_Concurrency-Server-Api/internal/entity/auction_entity"
+	"github.com/Guilherme-G-Cadilhe/Go-AuctionHouse_Concurrency-Server-Api/internal/internal_error"
+	"github.com/Guilherme-G-Cadilhe/Go-AuctionHouse_Concurrency-Server-Api/internal/jsontime"
+)
+
+// ExtensionOutputDTO é um item do relatório de extensões do leilão
+type ExtensionOutputDTO struct {
+	AuctionId  string        `json:"auction_id"`
+	Timestamp  jsontime.Time `json:"timestamp"`
+	NewEndTime jsontime.Time `json:"new_end_time"`
+}
+
+// FindAuctionExtensions lista, em ordem cronológica, as extensões de fim do leilão
+// Relatório somente leitura montado a partir dos eventos "extended"
+func (au *AuctionUseCase) FindAuctionExtensions(ctx context.Context, auctionId string) ([]ExtensionOutputDTO, *internal_error.InternalError) {
+	ctx, span := tracing.Start(ctx, "AuctionUseCase.FindAuctionExtensions")
+	defer span.End()
+
+	// Garante 404 para leilão inexistente em vez de uma lista vazia
+	if _, err := au.auctionRepositoryInterface.FindAuctionById(ctx, auctionId); err != nil {
+		return nil, err
+	}
+
+	events, err := au.auctionRepositoryInterface.FindAuctionEventsByAuctionId(ctx, auctionId)
+	if err != nil {
+		return nil, err
+	}
+
+	extensions := make([]ExtensionOutputDTO, 0)
+	for _, event := range events {
+		if event.Type != auction_entity.AuctionExtendedEvent {
+			continue
+		}
+		extensions = append(extensions, ExtensionOutputDTO{
+			AuctionId:  event.AuctionId,
+			Timestamp:  jsontime.New(event.Timestamp),
+			NewEndTime: jsontime.New(event.EndTime),
+		})
+	}
+
+	return extensions, nil
+}
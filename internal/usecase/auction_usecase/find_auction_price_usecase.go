@@ -0,0 +1,54 @@
+package auction_usecase
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Guilherme-G-Cadilhe/Go-AuctionHouse_Concurrency-Server-Api/configuration/tracing"
+	"github.com/Guilherme-G-Cadilhe/Go-AuctionHouse_Concurrency-Server-Api/internal/entity/auction_entity"
+	"github.com/Guilherme-G-Cadilhe/Go-AuctionHouse_Concurrency-Server-Api/internal/internal_error"
+)
+
+// AuctionPriceOutputDTO é a resposta enxuta do GET /auctions/:auctionId/price
+// Para clientes que só exibem o valor - sem o leilão e o lance vencedor completos
+type AuctionPriceOutputDTO struct {
+	CurrentPrice float64 `json:"currentPrice"`
+	BidCount     int64   `json:"bidCount"`
+}
+
+// FindAuctionPrice retorna o preço atual e a quantidade de lances do leilão
+// Preço atual: preço da tabela (holandês ativo), vencedor gravado no fechamento,
+// maior lance ou AUCTION_STARTING_PRICE quando ainda não há lances
+func (au *AuctionUseCase) FindAuctionPrice(ctx context.Context, auctionId string) (*AuctionPriceOutputDTO, *internal_error.InternalError) {
+	ctx, span := tracing.Start(ctx, "AuctionUseCase.FindAuctionPrice")
+	defer span.End()
+
+	auction, err := au.auctionRepositoryInterface.FindAuctionById(ctx, auctionId)
+	if err != nil {
+		return nil, err
+	}
+
+	// Sealed-bid: o preço revelaria o maior lance antes do fechamento
+	if auction.BidsAreHidden() {
+		return nil, internal_error.NewForbiddenError(fmt.Sprintf("bids of sealed auction %s are hidden until it closes", auctionId))
+	}
+
+	summary, err := au.bidRepositoryInterface.FindBidPriceSummary(ctx, auctionId)
+	if err != nil {
+		return nil, err
+	}
+
+	output := &AuctionPriceOutputDTO{BidCount: summary.BidCount}
+	switch {
+	case auction.IsDutch() && auction.Status == auction_entity.Active:
+		output.CurrentPrice = *au.currentPrice(auction)
+	case auction.Status == auction_entity.Completed && auction.WinningBidId != "":
+		output.CurrentPrice = auction.WinningAmount
+	case summary.BidCount > 0:
+		output.CurrentPrice = summary.HighestAmount
+	default:
+		output.CurrentPrice = au.startingPrice
+	}
+
+	return output, nil
+}
@@ -2,72 +2,249 @@ package auction_usecase
 
 import (
 	"context"
+	"fmt"
 
+	"github.com/Guilherme-G-Cadilhe/Go-AuctionHouse_Concurrency-Server-Api/configuration/tracing"
 	"github.com/Guilherme-G-Cadilhe/Go-AuctionHouse_Concurrency-Server-Api/internal/entity/auction_entity"
+	"github.com/Guilherme-G-Cadilhe/Go-AuctionHouse_Concurrency-Server-Api/internal/entity/bid_entity"
 	"github.com/Guilherme-G-Cadilhe/Go-AuctionHouse_Concurrency-Server-Api/internal/internal_error"
+	"github.com/Guilherme-G-Cadilhe/Go-AuctionHouse_Concurrency-Server-Api/internal/jsontime"
 	"github.com/Guilherme-G-Cadilhe/Go-AuctionHouse_Concurrency-Server-Api/internal/usecase/bid_usecase"
 )
 
 func (au *AuctionUseCase) FindAuctionById(ctx context.Context, id string) (*AuctionOutputDTO, *internal_error.InternalError) {
+	ctx, span := tracing.Start(ctx, "AuctionUseCase.FindAuctionById")
+	defer span.End()
+
 	auctionEntity, err := au.auctionRepositoryInterface.FindAuctionById(ctx, id)
 	if err != nil {
 		return nil, err
 	}
 
+	nextMinimumBid, err := au.nextMinimumBid(ctx, auctionEntity)
+	if err != nil {
+		return nil, err
+	}
+
 	return &AuctionOutputDTO{
-		Id:          auctionEntity.Id,
-		ProductName: auctionEntity.ProductName,
-		Category:    auctionEntity.Category,
-		Description: auctionEntity.Description,
-		Condition:   ProductCondition(auctionEntity.Condition),
-		Status:      AuctionStatus(auctionEntity.Status),
-		Timestamp:   auctionEntity.Timestamp,
+		Id:             auctionEntity.Id,
+		ProductName:    auctionEntity.ProductName,
+		Category:       auctionEntity.Category,
+		Description:    auctionEntity.Description,
+		Condition:      ProductCondition(auctionEntity.Condition),
+		Status:         AuctionStatus(auctionEntity.Status),
+		OwnerId:        auctionEntity.OwnerId,
+		Sealed:         auctionEntity.Sealed,
+		Featured:       auctionEntity.Featured,
+		AllowGuestBids: auctionEntity.AllowGuestBids,
+		Timestamp:      jsontime.New(auctionEntity.Timestamp),
+		CreatedAt:      jsontime.New(auctionEntity.Timestamp),
+		UpdatedAt:      jsontime.New(auctionEntity.UpdatedAt),
+		EndTime:        jsontime.New(auctionEntity.EndTime),
+		Type:           AuctionType(auctionEntity.Type),
+		CurrentPrice:   au.currentPrice(auctionEntity),
+		NextMinimumBid: nextMinimumBid,
+
+		IncrementSchedule: toIncrementScheduleDTO(auctionEntity.IncrementSchedule),
 	}, nil
 }
 
+// currentPrice calcula o preço atual de um leilão holandês ativo a partir da tabela
+// nil para leilões comuns e fechados
+func (au *AuctionUseCase) currentPrice(auction *auction_entity.Auction) *float64 {
+	if !auction.IsDutch() || auction.Status != auction_entity.Active {
+		return nil
+	}
+	price := auction.DutchPriceAt(au.clock.Now())
+	return &price
+}
+
+// nextMinimumBid calcula o valor para a UI pré-preencher o próximo lance
+// Lance vencedor + incremento (da tabela do leilão ou AUCTION_MIN_BID_INCREMENT), ou AUCTION_STARTING_PRICE se ainda não houver lances
+// Retorna nil quando o leilão não aceita lances ou quando os lances estão ocultos (sealed-bid)
+func (au *AuctionUseCase) nextMinimumBid(ctx context.Context, auction *auction_entity.Auction) (*float64, *internal_error.InternalError) {
+	if auction.Status != auction_entity.Active || auction.Sealed {
+		return nil, nil
+	}
+
+	// Holandês: qualquer lance a partir do preço atual arremata o leilão
+	if auction.IsDutch() {
+		return au.currentPrice(auction), nil
+	}
+
+	winningBid, err := au.bidRepositoryInterface.FindWinningBidByAuctionId(ctx, auction.Id)
+	if err != nil {
+		// not_found = leilão sem lances; qualquer outro erro é propagado
+		if err.Err != "not_found" {
+			return nil, err
+		}
+		// Com FIRST_BID_POLICY=meet_start_plus_increment o primeiro lance precisa ser maior que o preço inicial
+		winningBid = nil
+	}
+
+	// Mesma regra do POST /bid/quote - o incremento é o da faixa do lance vencedor
+	increment := au.minBidIncrement
+	if winningBid != nil {
+		increment = auction.BidIncrementAt(winningBid.Amount, au.minBidIncrement)
+	}
+	next := bid_entity.NextMinimumBid(winningBid, au.startingPrice, increment, au.firstBidMinimum)
+	return &next, nil
+}
+
+// AuctionListInputDTO reúne os filtros do GET /auctions
+// FeaturedOnly (?featured=true) lista só os destaques; FeaturedFirst (?featured_first=true) os ordena primeiro
+// Status nil (?status ausente) lista todos os status; 0 lista só os ativos
+type AuctionListInputDTO struct {
+	Status        *AuctionStatus
+	Category      string
+	ProductName   string
+	FeaturedOnly  bool
+	FeaturedFirst bool
+}
+
 func (au *AuctionUseCase) FindAllAuctions(
 	ctx context.Context,
-	status AuctionStatus,
-	category, productName string) ([]AuctionOutputDTO, *internal_error.InternalError) {
+	input AuctionListInputDTO) ([]AuctionOutputDTO, *internal_error.InternalError) {
+	ctx, span := tracing.Start(ctx, "AuctionUseCase.FindAllAuctions")
+	defer span.End()
 
-	auctionEntities, err := au.auctionRepositoryInterface.FindAllAuctions(ctx, auction_entity.AuctionStatus(status), category, productName)
+	auctionEntities, err := au.findAuctionList(ctx, input, false)
 	if err != nil {
 		return nil, err
 	}
 
+	// Respeita o deadline/cancelamento do cliente também na conversão - listagens grandes param no meio
 	var auctionsOutputs []AuctionOutputDTO
-	for _, auctionEntity := range auctionEntities {
+	for i, auctionEntity := range auctionEntities {
+		if err := checkContextEvery(ctx, i, "error trying to list auctions"); err != nil {
+			return nil, err
+		}
 		auctionsOutputs = append(auctionsOutputs, AuctionOutputDTO{
-			Id:          auctionEntity.Id,
-			ProductName: auctionEntity.ProductName,
-			Category:    auctionEntity.Category,
-			Description: auctionEntity.Description,
-			Condition:   ProductCondition(auctionEntity.Condition),
-			Status:      AuctionStatus(auctionEntity.Status),
-			Timestamp:   auctionEntity.Timestamp,
+			Id:             auctionEntity.Id,
+			ProductName:    auctionEntity.ProductName,
+			Category:       auctionEntity.Category,
+			Description:    auctionEntity.Description,
+			Condition:      ProductCondition(auctionEntity.Condition),
+			Status:         AuctionStatus(auctionEntity.Status),
+			OwnerId:        auctionEntity.OwnerId,
+			Sealed:         auctionEntity.Sealed,
+			Featured:       auctionEntity.Featured,
+			AllowGuestBids: auctionEntity.AllowGuestBids,
+			Timestamp:      jsontime.New(auctionEntity.Timestamp),
+			CreatedAt:      jsontime.New(auctionEntity.Timestamp),
+			UpdatedAt:      jsontime.New(auctionEntity.UpdatedAt),
+			EndTime:        jsontime.New(auctionEntity.EndTime),
+			Type:           AuctionType(auctionEntity.Type),
+			CurrentPrice:   au.currentPrice(&auctionEntity),
+
+			IncrementSchedule: toIncrementScheduleDTO(auctionEntity.IncrementSchedule),
 		})
 	}
 	return auctionsOutputs, nil
 }
 
+// FindAuctionSummaries é a listagem resumida (GET /auctions?view=summary): mesmos filtros do FindAllAuctions,
+// mas o repositório só lê os campos da visão resumida - payload e leitura no banco menores
+func (au *AuctionUseCase) FindAuctionSummaries(
+	ctx context.Context,
+	input AuctionListInputDTO) ([]AuctionSummaryOutputDTO, *internal_error.InternalError) {
+	ctx, span := tracing.Start(ctx, "AuctionUseCase.FindAuctionSummaries")
+	defer span.End()
+
+	auctionEntities, err := au.findAuctionList(ctx, input, true)
+	if err != nil {
+		return nil, err
+	}
+
+	var summaries []AuctionSummaryOutputDTO
+	for i, auctionEntity := range auctionEntities {
+		if err := checkContextEvery(ctx, i, "error trying to list auctions"); err != nil {
+			return nil, err
+		}
+		summaries = append(summaries, AuctionSummaryOutputDTO{
+			Id:           auctionEntity.Id,
+			ProductName:  auctionEntity.ProductName,
+			Status:       AuctionStatus(auctionEntity.Status),
+			CurrentPrice: au.currentPrice(&auctionEntity),
+		})
+	}
+	return summaries, nil
+}
+
+// findAuctionList aplica os filtros e a proteção contra full scan comuns às duas visões da listagem
+func (au *AuctionUseCase) findAuctionList(
+	ctx context.Context,
+	input AuctionListInputDTO,
+	summaryOnly bool) ([]auction_entity.Auction, *internal_error.InternalError) {
+	filter := auction_entity.AuctionListFilter{
+		Status:        toEntityStatus(input.Status),
+		Category:      input.Category,
+		ProductName:   input.ProductName,
+		FeaturedOnly:  input.FeaturedOnly,
+		FeaturedFirst: input.FeaturedFirst,
+		SummaryOnly:   summaryOnly,
+	}
+
+	// Proteção contra full scan: sem filtros o banco lê no máximo unfilteredLimit + 1 leilões
+	// O leilão extra só indica que o limite foi ultrapassado - nesse caso o cliente precisa filtrar
+	guarded := au.unfilteredLimit > 0 && filter.IsUnfiltered()
+	if guarded {
+		filter.Limit = au.unfilteredLimit + 1
+	}
+
+	auctionEntities, err := au.auctionRepositoryInterface.FindAllAuctions(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+	if guarded && len(auctionEntities) > au.unfilteredLimit {
+		return nil, internal_error.NewBadRequestError(
+			fmt.Sprintf("more than %d auctions match an unfiltered listing, please narrow it with status, category, productName or featured", au.unfilteredLimit))
+	}
+	return auctionEntities, nil
+}
+
 func (au *AuctionUseCase) FindWinningBidByAuctionId(ctx context.Context, auctionId string) (*WinningInfoOutputDTO, *internal_error.InternalError) {
+	ctx, span := tracing.Start(ctx, "AuctionUseCase.FindWinningBidByAuctionId")
+	defer span.End()
+
 	auction, err := au.auctionRepositoryInterface.FindAuctionById(ctx, auctionId)
 	if err != nil {
 		return nil, err
 	}
 
 	auctionOutputDTO := AuctionOutputDTO{
-		Id:          auction.Id,
-		ProductName: auction.ProductName,
-		Category:    auction.Category,
-		Description: auction.Description,
-		Condition:   ProductCondition(auction.Condition),
-		Status:      AuctionStatus(auction.Status),
-		Timestamp:   auction.Timestamp,
+		Id:             auction.Id,
+		ProductName:    auction.ProductName,
+		Category:       auction.Category,
+		Description:    auction.Description,
+		Condition:      ProductCondition(auction.Condition),
+		Status:         AuctionStatus(auction.Status),
+		OwnerId:        auction.OwnerId,
+		Sealed:         auction.Sealed,
+		Featured:       auction.Featured,
+		AllowGuestBids: auction.AllowGuestBids,
+		Timestamp:      jsontime.New(auction.Timestamp),
+		CreatedAt:      jsontime.New(auction.Timestamp),
+		UpdatedAt:      jsontime.New(auction.UpdatedAt),
+		EndTime:        jsontime.New(auction.EndTime),
+		Type:           AuctionType(auction.Type),
+	}
+
+	// Sealed-bid: o vencedor só é revelado após o fechamento
+	if auction.BidsAreHidden() {
+		return nil, internal_error.NewForbiddenError(fmt.Sprintf("bids of sealed auction %s are hidden until it closes", auctionId))
 	}
 
 	bidWinning, err := au.bidRepositoryInterface.FindWinningBidByAuctionId(ctx, auctionId)
+	closeReason := closeReasonOf(auction, bidWinning, err)
 	if err != nil {
+		// Leilão fechado sem vencedor não é erro: o resultado é o próprio motivo do fechamento
+		if closeReason == auction_entity.CloseReasonNoBids {
+			return &WinningInfoOutputDTO{
+				Auction:     auctionOutputDTO,
+				CloseReason: string(closeReason),
+			}, nil
+		}
 		return &WinningInfoOutputDTO{
 			Auction: auctionOutputDTO,
 			Bid:     nil,
@@ -76,15 +253,40 @@ func (au *AuctionUseCase) FindWinningBidByAuctionId(ctx context.Context, auction
 
 	bidOutputDto := &bid_usecase.BidOutputDTO{
 		Id:        bidWinning.Id,
-		UserId:    bidWinning.UserId,
+		UserId:    au.bidderMasker.VisibleUserId(ctx, auction, bidWinning.UserId),
+		Handle:    au.bidderMasker.VisibleHandle(ctx, auction, bidWinning.Handle),
 		AuctionId: bidWinning.AuctionId,
 		Amount:    bidWinning.Amount,
-		Timestamp: bidWinning.Timestamp,
+		Timestamp: jsontime.New(bidWinning.Timestamp),
+		Sequence:  bidWinning.Sequence,
 	}
 
 	return &WinningInfoOutputDTO{
-		Auction: auctionOutputDTO,
-		Bid:     bidOutputDto,
+		Auction:     auctionOutputDTO,
+		Bid:         bidOutputDto,
+		CloseReason: string(closeReason),
 	}, nil
 
 }
+
+// closeReasonOf usa o motivo gravado no fechamento
+// Leilões fechados antes do campo existir têm o motivo derivado da busca do vencedor
+// Leilões ativos não têm motivo - o lance vencedor ainda pode mudar
+func closeReasonOf(auction *auction_entity.Auction, winningBid *bid_entity.Bid, err *internal_error.InternalError) auction_entity.CloseReason {
+	if auction.Status != auction_entity.Completed {
+		return ""
+	}
+	if auction.CloseReason != "" {
+		return auction.CloseReason
+	}
+	return auction_entity.CloseReasonFor(winningBid, err)
+}
+
+// toEntityStatus converte o filtro de status do DTO preservando o nil (sem filtro)
+func toEntityStatus(status *AuctionStatus) *auction_entity.AuctionStatus {
+	if status == nil {
+		return nil
+	}
+	entityStatus := auction_entity.AuctionStatus(*status)
+	return &entityStatus
+}
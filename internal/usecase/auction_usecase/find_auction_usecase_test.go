@@ -0,0 +1,44 @@
+package auction_usecase
+
+import (
+	"context"
+	"testing"
+)
+
+func TestFindAllAuctionsStatusFilter(t *testing.T) {
+	env := newTestEnv(testAuctionConfig())
+	env.createAuction(t, nil)
+	env.closeByTimer(t, env.createAuction(t, nil).Id) // Fecha os dois
+	env.createAuction(t, nil)
+
+	active, completed := AuctionStatus(0), AuctionStatus(1)
+	tests := []struct {
+		name   string
+		status *AuctionStatus
+		want   map[AuctionStatus]int
+	}{
+		{"no status lists all", nil, map[AuctionStatus]int{active: 1, completed: 2}},
+		{"status 0 lists only active", &active, map[AuctionStatus]int{active: 1}},
+		{"status 1 lists only completed", &completed, map[AuctionStatus]int{completed: 2}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			auctions, err := env.useCase.FindAllAuctions(context.Background(), AuctionListInputDTO{Status: tt.status})
+			if err != nil {
+				t.Fatalf("FindAllAuctions: %v", err)
+			}
+			got := make(map[AuctionStatus]int)
+			for _, auction := range auctions {
+				got[auction.Status]++
+			}
+			if len(got) != len(tt.want) {
+				t.Fatalf("statuses = %v, want %v", got, tt.want)
+			}
+			for status, count := range tt.want {
+				if got[status] != count {
+					t.Fatalf("statuses = %v, want %v", got, tt.want)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,118 @@
+package auction_usecase
+
+import (
+	"context"
+	"time"
+
+	"github.com/Guilherme-G-Cadilhe/Go-AuctionHouse_Concurrency-Server-Api/configuration/tracing"
+	"github.com/Guilherme-G-Cadilhe/Go-AuctionHouse_Concurrency-Server-Api/internal/entity/auction_entity"
+	"github.com/Guilherme-G-Cadilhe/Go-AuctionHouse_Concurrency-Server-Api/internal/internal_error"
+	"github.com/Guilherme-G-Cadilhe/Go-AuctionHouse_Concurrency-Server-Api/internal/jsontime"
+	"github.com/Guilherme-G-Cadilhe/Go-AuctionHouse_Concurrency-Server-Api/internal/usecase/bid_usecase"
+)
+
+const (
+	DefaultWinnersPageSize = 20
+	MaxWinnersPageSize     = 100
+)
+
+// AuctionWinnersInputDTO são os filtros do relatório de vencedores (já convertidos pelo controller)
+// From/To zero não limitam o período
+type AuctionWinnersInputDTO struct {
+	Category string
+	From     time.Time
+	To       time.Time
+	Page     int
+	PageSize int
+}
+
+// AuctionWinnersOutputDTO é uma página do relatório com o total de leilões que atendem aos filtros
+type AuctionWinnersOutputDTO struct {
+	Items    []WinningInfoOutputDTO `json:"items"`
+	Total    int64                  `json:"total"`
+	Page     int                    `json:"page"`
+	PageSize int                    `json:"page_size"`
+}
+
+// FindAuctionWinners lista leilões fechados com o lance vencedor de cada um
+// Uma única consulta ao repositório - no lugar de chamar FindWinningBidByAuctionId por leilão
+func (au *AuctionUseCase) FindAuctionWinners(ctx context.Context, input AuctionWinnersInputDTO) (*AuctionWinnersOutputDTO, *internal_error.InternalError) {
+	ctx, span := tracing.Start(ctx, "AuctionUseCase.FindAuctionWinners")
+	defer span.End()
+
+	if err := validateAuctionWinnersInput(input); err != nil {
+		return nil, err
+	}
+
+	winners, total, err := au.auctionRepositoryInterface.FindAuctionWinners(ctx, auction_entity.AuctionWinnersFilter{
+		Category:  input.Category,
+		EndedFrom: input.From,
+		EndedTo:   input.To,
+		Offset:    (input.Page - 1) * input.PageSize,
+		Limit:     input.PageSize,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	items := make([]WinningInfoOutputDTO, 0, len(winners))
+	for _, winner := range winners {
+		item := WinningInfoOutputDTO{
+			Auction: AuctionOutputDTO{
+				Id:             winner.Auction.Id,
+				ProductName:    winner.Auction.ProductName,
+				Category:       winner.Auction.Category,
+				Description:    winner.Auction.Description,
+				Condition:      ProductCondition(winner.Auction.Condition),
+				Status:         AuctionStatus(winner.Auction.Status),
+				OwnerId:        winner.Auction.OwnerId,
+				Sealed:         winner.Auction.Sealed,
+				Featured:       winner.Auction.Featured,
+				AllowGuestBids: winner.Auction.AllowGuestBids,
+				Timestamp:      jsontime.New(winner.Auction.Timestamp),
+				CreatedAt:      jsontime.New(winner.Auction.Timestamp),
+				UpdatedAt:      jsontime.New(winner.Auction.UpdatedAt),
+				EndTime:        jsontime.New(winner.Auction.EndTime),
+				Type:           AuctionType(winner.Auction.Type),
+			},
+		}
+		// Leilão fechado sem lances: bid fica ausente no JSON
+		if winner.WinningBid != nil {
+			item.Bid = &bid_usecase.BidOutputDTO{
+				Id:        winner.WinningBid.Id,
+				UserId:    au.bidderMasker.VisibleUserId(ctx, &winner.Auction, winner.WinningBid.UserId),
+				Handle:    au.bidderMasker.VisibleHandle(ctx, &winner.Auction, winner.WinningBid.Handle),
+				AuctionId: winner.WinningBid.AuctionId,
+				Amount:    winner.WinningBid.Amount,
+				Timestamp: jsontime.New(winner.WinningBid.Timestamp),
+				Sequence:  winner.WinningBid.Sequence,
+			}
+		}
+		items = append(items, item)
+	}
+
+	return &AuctionWinnersOutputDTO{
+		Items:    items,
+		Total:    total,
+		Page:     input.Page,
+		PageSize: input.PageSize,
+	}, nil
+}
+
+func validateAuctionWinnersInput(input AuctionWinnersInputDTO) *internal_error.InternalError {
+	var causes []internal_error.Cause
+	if input.Page < 1 {
+		causes = append(causes, internal_error.Cause{Field: "page", Message: "page must be greater than or equal to 1"})
+	}
+	if input.PageSize < 1 || input.PageSize > MaxWinnersPageSize {
+		causes = append(causes, internal_error.Cause{Field: "page_size", Message: "page_size must be between 1 and 100"})
+	}
+	if !input.From.IsZero() && !input.To.IsZero() && input.From.After(input.To) {
+		causes = append(causes, internal_error.Cause{Field: "from", Message: "from must not be after to"})
+	}
+
+	if len(causes) > 0 {
+		return internal_error.NewBadRequestError("invalid winners filter", causes...)
+	}
+	return nil
+}
@@ -0,0 +1,92 @@
+package auction_usecase
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Guilherme-G-Cadilhe/Go-AuctionHouse_Concurrency-Server-Api/configuration/tracing"
+	"github.com/Guilherme-G-Cadilhe/Go-AuctionHouse_Concurrency-Server-Api/internal/internal_error"
+	"github.com/Guilherme-G-Cadilhe/Go-AuctionHouse_Concurrency-Server-Api/internal/jsontime"
+	"github.com/google/uuid"
+)
+
+// AuctionBatchInputDTO é o corpo do POST /auctions/batch-get: {"ids": ["<uuid>", ...]}
+type AuctionBatchInputDTO struct {
+	Ids []string `json:"ids" binding:"required"`
+}
+
+// FindAuctionsByIds busca o estado atual de vários leilões em uma única query (ex: watchlist)
+// O status vem do banco, então leilões fechados automaticamente já aparecem como Completed
+// Ids inexistentes são omitidos; a resposta segue a ordem dos ids enviados, sem repetições
+func (au *AuctionUseCase) FindAuctionsByIds(ctx context.Context, input AuctionBatchInputDTO) ([]AuctionOutputDTO, *internal_error.InternalError) {
+	ctx, span := tracing.Start(ctx, "AuctionUseCase.FindAuctionsByIds")
+	defer span.End()
+
+	if err := validateAuctionBatch(input.Ids); err != nil {
+		return nil, err
+	}
+
+	auctions, err := au.auctionRepositoryInterface.FindAuctionsByIds(ctx, input.Ids)
+	if err != nil {
+		return nil, err
+	}
+
+	// O $in não garante ordem - indexa por id para responder na ordem pedida
+	auctionsById := make(map[string]AuctionOutputDTO, len(auctions))
+	for _, auction := range auctions {
+		auctionsById[auction.Id] = AuctionOutputDTO{
+			Id:             auction.Id,
+			ProductName:    auction.ProductName,
+			Category:       auction.Category,
+			Description:    auction.Description,
+			Condition:      ProductCondition(auction.Condition),
+			Status:         AuctionStatus(auction.Status),
+			OwnerId:        auction.OwnerId,
+			Sealed:         auction.Sealed,
+			Featured:       auction.Featured,
+			AllowGuestBids: auction.AllowGuestBids,
+			Timestamp:      jsontime.New(auction.Timestamp),
+			CreatedAt:      jsontime.New(auction.Timestamp),
+			UpdatedAt:      jsontime.New(auction.UpdatedAt),
+			EndTime:        jsontime.New(auction.EndTime),
+			Type:           AuctionType(auction.Type),
+			CurrentPrice:   au.currentPrice(&auction),
+		}
+	}
+
+	output := make([]AuctionOutputDTO, 0, len(auctionsById))
+	for _, id := range input.Ids {
+		if auction, ok := auctionsById[id]; ok {
+			output = append(output, auction)
+			delete(auctionsById, id) // Ids repetidos aparecem uma única vez
+		}
+	}
+	return output, nil
+}
+
+// validateAuctionBatch exige ao menos 1 id, todos UUIDs válidos
+// O máximo (BATCH_MAX_ITEMS) é checado no handler, antes de chegar aqui
+// Cada id inválido gera uma causa própria (ex: "ids[3]")
+func validateAuctionBatch(ids []string) *internal_error.InternalError {
+	if len(ids) == 0 {
+		return internal_error.NewBadRequestError("invalid fields", internal_error.Cause{
+			Field:   "ids",
+			Message: "ids must contain at least 1 auction id",
+		})
+	}
+
+	var causes []internal_error.Cause
+	for i, id := range ids {
+		if err := uuid.Validate(id); err != nil {
+			causes = append(causes, internal_error.Cause{
+				Field:   fmt.Sprintf("ids[%d]", i),
+				Message: "Invalid UUID Value",
+			})
+		}
+	}
+
+	if len(causes) > 0 {
+		return internal_error.NewBadRequestError("invalid fields", causes...)
+	}
+	return nil
+}
@@ -0,0 +1,36 @@
+package auction_usecase
+
+import (
+	"context"
+
+	"github.com/Guilherme-G-Cadilhe/Go-AuctionHouse_Concurrency-Server-Api/configuration/tracing"
+	"github.com/Guilherme-G-Cadilhe/Go-AuctionHouse_Concurrency-Server-Api/internal/entity/auction_entity"
+	"github.com/Guilherme-G-Cadilhe/Go-AuctionHouse_Concurrency-Server-Api/internal/internal_error"
+)
+
+type CategoryCountOutputDTO struct {
+	Category string `json:"category"`
+	Count    int64  `json:"count"`
+}
+
+// FindCategoryCounts retorna as categorias com a quantidade de leilões ativos
+// Ordenado pela quantidade (maior primeiro) - usado para montar filtros na UI
+func (au *AuctionUseCase) FindCategoryCounts(ctx context.Context) ([]CategoryCountOutputDTO, *internal_error.InternalError) {
+	ctx, span := tracing.Start(ctx, "AuctionUseCase.FindCategoryCounts")
+	defer span.End()
+
+	categoryCounts, err := au.auctionRepositoryInterface.AggregateCategoryCounts(ctx, auction_entity.Active)
+	if err != nil {
+		return nil, err
+	}
+
+	categoryCountsOutput := make([]CategoryCountOutputDTO, len(categoryCounts))
+	for i, categoryCount := range categoryCounts {
+		categoryCountsOutput[i] = CategoryCountOutputDTO{
+			Category: categoryCount.Category,
+			Count:    categoryCount.Count,
+		}
+	}
+
+	return categoryCountsOutput, nil
+}
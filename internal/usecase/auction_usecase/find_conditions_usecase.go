@@ -0,0 +1,50 @@
+package auction_usecase
+
+import (
+	"context"
+
+	"github.com/Guilherme-G-Cadilhe/Go-AuctionHouse_Concurrency-Server-Api/configuration/logger"
+	"github.com/Guilherme-G-Cadilhe/Go-AuctionHouse_Concurrency-Server-Api/internal/entity/auction_entity"
+	"go.uber.org/zap"
+)
+
+// ConditionOutputDTO é uma condição aceita na criação - value é o que vai no campo "condition"
+type ConditionOutputDTO struct {
+	Value ProductCondition `json:"value"`
+	Name  string           `json:"name"`
+	// Default marca a condição usada quando a criação omite "condition" (AUCTION_DEFAULT_CONDITION)
+	Default bool `json:"default"`
+}
+
+// FindConditions lista as condições configuradas (AUCTION_CONDITIONS) na ordem da configuração
+// Não consulta o banco - a UI monta o seletor de condição a partir daqui
+func (au *AuctionUseCase) FindConditions(ctx context.Context) []ConditionOutputDTO {
+	conditions := make([]ConditionOutputDTO, len(au.fieldBounds.Conditions))
+	for i, definition := range au.fieldBounds.Conditions {
+		conditions[i] = ConditionOutputDTO{
+			Value:   ProductCondition(definition.Value),
+			Name:    definition.Name,
+			Default: ProductCondition(definition.Value) == au.defaultCondition,
+		}
+	}
+	return conditions
+}
+
+// newProductConditions resolve o AUCTION_CONDITIONS e o AUCTION_DEFAULT_CONDITION
+// Configuração inválida é erro de deployment: loga e segue com as condições padrão, como o AUCTION_INTERVAL
+func newProductConditions(entries []string, defaultCondition int) (auction_entity.ProductConditions, ProductCondition) {
+	conditions, err := auction_entity.ParseProductConditions(entries)
+	if err != nil {
+		logger.Warn("invalid AUCTION_CONDITIONS, using the default conditions", zap.String("reason", err.Error()))
+		conditions = auction_entity.DefaultProductConditions()
+	}
+
+	// O default precisa estar no conjunto - senão toda criação sem "condition" seria rejeitada
+	if !conditions.Allows(auction_entity.ProductCondition(defaultCondition)) {
+		logger.Warn("AUCTION_DEFAULT_CONDITION is not in AUCTION_CONDITIONS, using the first condition",
+			zap.Int("default_condition", defaultCondition),
+			zap.String("first_condition", conditions[0].Name))
+		return conditions, ProductCondition(conditions[0].Value)
+	}
+	return conditions, ProductCondition(defaultCondition)
+}
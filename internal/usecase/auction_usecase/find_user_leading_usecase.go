@@ -0,0 +1,87 @@
+package auction_usecase
+
+import (
+	"context"
+
+	"github.com/Guilherme-G-Cadilhe/Go-AuctionHouse_Concurrency-Server-Api/configuration/tracing"
+	"github.com/Guilherme-G-Cadilhe/Go-AuctionHouse_Concurrency-Server-Api/internal/auth_context"
+	"github.com/Guilherme-G-Cadilhe/Go-AuctionHouse_Concurrency-Server-Api/internal/entity/auction_entity"
+	"github.com/Guilherme-G-Cadilhe/Go-AuctionHouse_Concurrency-Server-Api/internal/internal_error"
+	"github.com/Guilherme-G-Cadilhe/Go-AuctionHouse_Concurrency-Server-Api/internal/jsontime"
+	"github.com/Guilherme-G-Cadilhe/Go-AuctionHouse_Concurrency-Server-Api/internal/usecase/bid_usecase"
+)
+
+// UserLeadingInputDTO é a paginação do GET /user/:userId/winning (mesmos limites do relatório de vencedores)
+type UserLeadingInputDTO struct {
+	Page     int
+	PageSize int
+}
+
+// FindUserLeadingAuctions lista os leilões ativos em que o usuário tem o maior lance no momento
+// Diferente do relatório de vencedores (leilões já fechados) - alimenta alertas de "lance superado"
+// Só o próprio usuário (ou um administrador) consulta: a lista expõe a atividade de lances dele
+func (au *AuctionUseCase) FindUserLeadingAuctions(ctx context.Context, userId string, input UserLeadingInputDTO) ([]WinningInfoOutputDTO, *internal_error.InternalError) {
+	ctx, span := tracing.Start(ctx, "AuctionUseCase.FindUserLeadingAuctions")
+	defer span.End()
+
+	requesterId, err := auth_context.RequireUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if requesterId != userId && !au.isAdmin(requesterId) {
+		return nil, internal_error.NewForbiddenError("only the user itself can list the auctions it is winning")
+	}
+
+	var causes []internal_error.Cause
+	if input.Page < 1 {
+		causes = append(causes, internal_error.Cause{Field: "page", Message: "page must be greater than or equal to 1"})
+	}
+	if input.PageSize < 1 || input.PageSize > MaxWinnersPageSize {
+		causes = append(causes, internal_error.Cause{Field: "page_size", Message: "page_size must be between 1 and 100"})
+	}
+	if len(causes) > 0 {
+		return nil, internal_error.NewBadRequestError("invalid pagination", causes...)
+	}
+
+	leading, err := au.auctionRepositoryInterface.FindAuctionsLeadByUser(ctx, auction_entity.UserLeadingFilter{
+		UserId: userId,
+		Offset: (input.Page - 1) * input.PageSize,
+		Limit:  input.PageSize,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	items := make([]WinningInfoOutputDTO, 0, len(leading))
+	for _, item := range leading {
+		items = append(items, WinningInfoOutputDTO{
+			Auction: AuctionOutputDTO{
+				Id:             item.Auction.Id,
+				ProductName:    item.Auction.ProductName,
+				Category:       item.Auction.Category,
+				Description:    item.Auction.Description,
+				Condition:      ProductCondition(item.Auction.Condition),
+				Status:         AuctionStatus(item.Auction.Status),
+				OwnerId:        item.Auction.OwnerId,
+				Sealed:         item.Auction.Sealed,
+				Featured:       item.Auction.Featured,
+				AllowGuestBids: item.Auction.AllowGuestBids,
+				Timestamp:      jsontime.New(item.Auction.Timestamp),
+				CreatedAt:      jsontime.New(item.Auction.Timestamp),
+				UpdatedAt:      jsontime.New(item.Auction.UpdatedAt),
+				EndTime:        jsontime.New(item.Auction.EndTime),
+				Type:           AuctionType(item.Auction.Type),
+			},
+			Bid: &bid_usecase.BidOutputDTO{
+				Id:        item.WinningBid.Id,
+				UserId:    au.bidderMasker.VisibleUserId(ctx, &item.Auction, item.WinningBid.UserId),
+				Handle:    au.bidderMasker.VisibleHandle(ctx, &item.Auction, item.WinningBid.Handle),
+				AuctionId: item.WinningBid.AuctionId,
+				Amount:    item.WinningBid.Amount,
+				Timestamp: jsontime.New(item.WinningBid.Timestamp),
+				Sequence:  item.WinningBid.Sequence,
+			},
+		})
+	}
+	return items, nil
+}
@@ -0,0 +1,59 @@
+package auction_usecase
+
+import (
+	"context"
+	"testing"
+
+	"github.com/Guilherme-G-Cadilhe/Go-AuctionHouse_Concurrency-Server-Api/internal/entity/auction_entity"
+)
+
+func TestFindWinningBidCloseReason(t *testing.T) {
+	tests := []struct {
+		name    string
+		bids    []float64
+		want    auction_entity.CloseReason
+		wantBid bool
+	}{
+		{"sold", []float64{10, 20}, auction_entity.CloseReasonSold, true},
+		{"no bids", nil, auction_entity.CloseReasonNoBids, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			env := newTestEnv(testAuctionConfig())
+			auction := env.createAuction(t, nil)
+			env.storeBids(t, auction.Id, tt.bids...)
+			env.closeByTimer(t, auction.Id)
+
+			// O motivo é gravado no fechamento
+			stored, _ := env.auctions.FindAuctionById(context.Background(), auction.Id)
+			if stored.CloseReason != tt.want {
+				t.Fatalf("persisted close reason = %q, want %q", stored.CloseReason, tt.want)
+			}
+
+			winning, err := env.useCase.FindWinningBidByAuctionId(context.Background(), auction.Id)
+			if err != nil {
+				t.Fatalf("FindWinningBidByAuctionId: %v", err)
+			}
+			if winning.CloseReason != string(tt.want) {
+				t.Fatalf("close reason = %q, want %q", winning.CloseReason, tt.want)
+			}
+			if (winning.Bid != nil) != tt.wantBid {
+				t.Fatalf("winning bid = %+v, want present %v", winning.Bid, tt.wantBid)
+			}
+		})
+	}
+}
+
+// Leilão ativo ainda não tem resultado: sem lances a consulta continua sendo 404
+func TestFindWinningBidActiveAuctionHasNoCloseReason(t *testing.T) {
+	env := newTestEnv(testAuctionConfig())
+	auction := env.createAuction(t, nil)
+
+	winning, err := env.useCase.FindWinningBidByAuctionId(context.Background(), auction.Id)
+	if err == nil || err.Err != "not_found" {
+		t.Fatalf("FindWinningBidByAuctionId: got %v, want not_found", err)
+	}
+	if winning.CloseReason != "" {
+		t.Fatalf("close reason of an active auction = %q, want empty", winning.CloseReason)
+	}
+}
@@ -0,0 +1,39 @@
+package auction_usecase
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/Guilherme-G-Cadilhe/Go-AuctionHouse_Concurrency-Server-Api/internal/entity/auction_entity"
+)
+
+// O next_minimum_bid usa a faixa do lance vencedor, a mesma regra do flush
+func TestNextMinimumBidFollowsIncrementTiers(t *testing.T) {
+	schedule := auction_entity.IncrementSchedule{{Threshold: 0, Increment: 1}, {Threshold: 100, Increment: 5}, {Threshold: 500, Increment: 25}}
+
+	tests := []struct {
+		winning float64
+		want    float64
+	}{
+		{99.5, 100.5},
+		{100, 105},
+		{499, 504},
+		{500, 525},
+	}
+	for _, tt := range tests {
+		t.Run(fmt.Sprint(tt.winning), func(t *testing.T) {
+			env := newTestEnv(testAuctionConfig())
+			auction := env.createAuction(t, func(a *auction_entity.Auction) { a.IncrementSchedule = schedule })
+			env.storeBids(t, auction.Id, tt.winning)
+
+			output, err := env.useCase.FindAuctionById(context.Background(), auction.Id)
+			if err != nil {
+				t.Fatalf("FindAuctionById: %v", err)
+			}
+			if output.NextMinimumBid == nil || *output.NextMinimumBid != tt.want {
+				t.Fatalf("next minimum bid = %v, want %v", output.NextMinimumBid, tt.want)
+			}
+		})
+	}
+}
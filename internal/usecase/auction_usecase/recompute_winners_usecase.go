@@ -0,0 +1,186 @@
+package auction_usecase
+
+import (
+	"context"
+
+	"github.com/Guilherme-G-Cadilhe/Go-AuctionHouse_Concurrency-Server-Api/configuration/logger"
+	"github.com/Guilherme-G-Cadilhe/Go-AuctionHouse_Concurrency-Server-Api/configuration/tracing"
+	"github.com/Guilherme-G-Cadilhe/Go-AuctionHouse_Concurrency-Server-Api/internal/auth_context"
+	"github.com/Guilherme-G-Cadilhe/Go-AuctionHouse_Concurrency-Server-Api/internal/entity/auction_entity"
+	"github.com/Guilherme-G-Cadilhe/Go-AuctionHouse_Concurrency-Server-Api/internal/entity/bid_entity"
+	"github.com/Guilherme-G-Cadilhe/Go-AuctionHouse_Concurrency-Server-Api/internal/internal_error"
+	"go.uber.org/zap"
+)
+
+// Resultado da reconciliação de cada leilão com vencedor alterado (ou que não pôde ser reconciliado)
+const (
+	RecomputeUpdated = "updated" // Vencedor gravado trocado pelo recalculado
+	RecomputeCleared = "cleared" // Leilão ficou sem lances - vencedor removido (no_bids)
+	RecomputeSkipped = "skipped" // Vencedor definido por override de administrador - mantido
+	RecomputeFailed  = "failed"  // Erro ao calcular ou gravar - os demais leilões seguem
+)
+
+// RecomputeWinnersInputDTO filtra os leilões fechados reconciliados (category vazia = todos)
+type RecomputeWinnersInputDTO struct {
+	Category string
+}
+
+// RecomputeWinnerChangeDTO descreve um leilão cujo vencedor recalculado difere do gravado
+type RecomputeWinnerChangeDTO struct {
+	AuctionId      string  `json:"auction_id"`
+	Action         string  `json:"action"`
+	PreviousBidId  string  `json:"previous_bid_id,omitempty"`
+	PreviousAmount float64 `json:"previous_amount,omitempty"`
+	BidId          string  `json:"bid_id,omitempty"`
+	Amount         float64 `json:"amount,omitempty"`
+	Reason         string  `json:"reason,omitempty"` // Motivo de skipped/failed
+}
+
+// RecomputeWinnersOutputDTO resume a reconciliação - só os leilões alterados aparecem em changes
+type RecomputeWinnersOutputDTO struct {
+	Category  string                     `json:"category,omitempty"`
+	Scanned   int                        `json:"scanned"`
+	Unchanged int                        `json:"unchanged"`
+	Updated   int                        `json:"updated"`
+	Cleared   int                        `json:"cleared"`
+	Skipped   int                        `json:"skipped"`
+	Failed    int                        `json:"failed"`
+	Changes   []RecomputeWinnerChangeDTO `json:"changes"`
+}
+
+// RecomputeWinners recalcula e grava o vencedor dos leilões fechados (manutenção após correções em massa)
+// O cálculo é o do fechamento (ComputeWinningBidByAuctionId); só leilões com resultado diferente são gravados,
+// então rodar de novo não altera nada - a operação é idempotente e pode ser interrompida e repetida
+func (au *AuctionUseCase) RecomputeWinners(ctx context.Context, input RecomputeWinnersInputDTO) (*RecomputeWinnersOutputDTO, *internal_error.InternalError) {
+	ctx, span := tracing.Start(ctx, "AuctionUseCase.RecomputeWinners")
+	defer span.End()
+
+	// A rota já exige admin (middleware.RequireAdmin) - aqui o usuário só entra na auditoria
+	actorId, _ := auth_context.UserIDFromContext(ctx)
+
+	completed := auction_entity.Completed
+	auctions, err := au.auctionRepositoryInterface.FindAllAuctions(ctx, auction_entity.AuctionListFilter{
+		Status:   &completed,
+		Category: input.Category,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	output := &RecomputeWinnersOutputDTO{Category: input.Category, Changes: []RecomputeWinnerChangeDTO{}}
+	for _, auction := range auctions {
+		// Cada leilão faz consultas ao banco: o ctx é verificado a cada um
+		// O que já foi gravado fica gravado - rodar de novo continua de onde parou
+		if err := checkContextEvery(ctx, 0, "error trying to recompute auction winners"); err != nil {
+			return nil, err
+		}
+		output.Scanned++
+
+		change, changed := au.recomputeWinner(ctx, auction)
+		if !changed {
+			output.Unchanged++
+			continue
+		}
+		switch change.Action {
+		case RecomputeUpdated:
+			output.Updated++
+		case RecomputeCleared:
+			output.Cleared++
+		case RecomputeSkipped:
+			output.Skipped++
+		case RecomputeFailed:
+			output.Failed++
+		}
+		output.Changes = append(output.Changes, change)
+	}
+
+	logger.Info("auction winners recomputed",
+		zap.String("category", input.Category),
+		zap.Int("scanned", output.Scanned),
+		zap.Int("updated", output.Updated),
+		zap.Int("cleared", output.Cleared),
+		zap.Int("skipped", output.Skipped),
+		zap.Int("failed", output.Failed),
+		zap.String("actor_id", actorId))
+
+	return output, nil
+}
+
+// recomputeWinner compara o vencedor recalculado com o gravado e grava a diferença
+// false = nada a fazer (vencedor já correto)
+func (au *AuctionUseCase) recomputeWinner(ctx context.Context, auction auction_entity.Auction) (RecomputeWinnerChangeDTO, bool) {
+	change := RecomputeWinnerChangeDTO{
+		AuctionId:      auction.Id,
+		PreviousBidId:  auction.WinningBidId,
+		PreviousAmount: auction.WinningAmount,
+	}
+
+	winningBid, err := au.bidRepositoryInterface.ComputeWinningBidByAuctionId(ctx, auction.Id)
+	if err != nil && err.Err != "not_found" {
+		change.Action, change.Reason = RecomputeFailed, err.Message
+		return change, true
+	}
+	if err != nil {
+		winningBid = nil // not_found = leilão sem lances
+	}
+
+	if winnerMatches(auction, winningBid) {
+		return change, false
+	}
+
+	if au.winnerOverridden(ctx, auction) {
+		change.Action, change.Reason = RecomputeSkipped, "winner was set by an admin override"
+		return change, true
+	}
+
+	if err := au.auctionRepositoryInterface.UpdateAuctionWinner(ctx, auction.Id, winningBid); err != nil {
+		change.Action, change.Reason = RecomputeFailed, err.Message
+		return change, true
+	}
+
+	change.Action = RecomputeCleared
+	if winningBid != nil {
+		change.Action = RecomputeUpdated
+		change.BidId = winningBid.Id
+		change.Amount = winningBid.Amount
+	}
+	return change, true
+}
+
+// winnerMatches indica se o leilão já tem gravado exatamente o resultado recalculado
+func winnerMatches(auction auction_entity.Auction, winningBid *bid_entity.Bid) bool {
+	if winningBid == nil {
+		return auction.WinningBidId == "" && auction.CloseReason == auction_entity.CloseReasonNoBids
+	}
+	return auction.WinningBidId == winningBid.Id &&
+		bid_entity.CompareAmounts(auction.WinningAmount, winningBid.Amount) == 0 &&
+		auction.CloseReason == auction_entity.CloseReasonSold
+}
+
+// winnerOverridden indica se o vencedor gravado foi escolhido por um administrador depois do último fechamento
+// A decisão manual prevalece enquanto o lance escolhido existir; lance removido volta a ser recalculado
+func (au *AuctionUseCase) winnerOverridden(ctx context.Context, auction auction_entity.Auction) bool {
+	if auction.WinningBidId == "" {
+		return false
+	}
+
+	events, err := au.auctionRepositoryInterface.FindAuctionEventsByAuctionId(ctx, auction.Id)
+	if err != nil {
+		return false
+	}
+	overridden := false
+	for _, event := range events {
+		switch event.Type {
+		case auction_entity.AuctionWinnerOverriddenEvent:
+			overridden = true
+		case auction_entity.AuctionClosedEvent, auction_entity.AuctionReopenedEvent, auction_entity.AuctionWinnerRecomputedEvent:
+			overridden = false
+		}
+	}
+	if !overridden {
+		return false
+	}
+
+	_, errBid := au.bidRepositoryInterface.FindBidById(ctx, auction.WinningBidId)
+	return errBid == nil
+}
@@ -0,0 +1,83 @@
+package auction_usecase
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/Guilherme-G-Cadilhe/Go-AuctionHouse_Concurrency-Server-Api/configuration/tracing"
+	"github.com/Guilherme-G-Cadilhe/Go-AuctionHouse_Concurrency-Server-Api/internal/entity/auction_entity"
+	"github.com/Guilherme-G-Cadilhe/Go-AuctionHouse_Concurrency-Server-Api/internal/internal_error"
+)
+
+// AuctionReopenInputDTO recebe a nova duração do leilão reaberto, contada a partir de agora
+// Duration usa o formato do time.ParseDuration (ex: "30m", "1h30m")
+type AuctionReopenInputDTO struct {
+	Duration string `json:"duration" binding:"required"`
+}
+
+// ReopenAuction reabre um leilão fechado por engano
+// Só é permitido para leilões Completed dentro de AUCTION_REOPEN_GRACE após o fechamento
+func (au *AuctionUseCase) ReopenAuction(ctx context.Context, auctionId string, reopenInput AuctionReopenInputDTO) *internal_error.InternalError {
+	ctx, span := tracing.Start(ctx, "AuctionUseCase.ReopenAuction")
+	defer span.End()
+
+	duration, errParse := time.ParseDuration(reopenInput.Duration)
+	if errParse != nil || duration <= 0 {
+		return internal_error.NewBadRequestError("duration must be a positive duration (e.g. 30m, 1h)")
+	}
+	// A reabertura é um novo período de lances: a duração pedida precisa respeitar os dois limites
+	if cause := au.durationBounds.Check("duration", duration); cause != nil {
+		return internal_error.NewBadRequestError("invalid fields", *cause)
+	}
+
+	auction, err := au.auctionRepositoryInterface.FindAuctionById(ctx, auctionId)
+	if err != nil {
+		return err
+	}
+
+	if auction.Status != auction_entity.Completed {
+		return internal_error.NewConflictError(fmt.Sprintf("auction %s is not closed", auctionId))
+	}
+
+	// O arremate de um leilão holandês é definitivo - reabrir devolveria o preço já no piso
+	if auction.IsDutch() {
+		return internal_error.NewConflictError(fmt.Sprintf("dutch auction %s cannot be reopened", auctionId))
+	}
+
+	closedAt, err := au.findClosedAt(ctx, auction)
+	if err != nil {
+		return err
+	}
+
+	now := au.clock.Now()
+	if now.Sub(closedAt) > au.reopenGraceWindow {
+		return internal_error.NewConflictError(fmt.Sprintf("auction %s was closed more than %s ago and can no longer be reopened", auctionId, au.reopenGraceWindow))
+	}
+
+	if err := au.auctionRepositoryInterface.ReopenAuction(ctx, auctionId, now.Add(duration)); err != nil {
+		return err
+	}
+
+	// O cache ainda guarda o status Completed - sem invalidar os lances continuariam rejeitados
+	au.bidRepositoryInterface.InvalidateAuctionCache(auctionId)
+	return nil
+}
+
+// findClosedAt retorna o momento do último fechamento registrado no histórico
+// Leilões sem evento "closed" (dados antigos) usam o fim efetivo como referência
+func (au *AuctionUseCase) findClosedAt(ctx context.Context, auction *auction_entity.Auction) (time.Time, *internal_error.InternalError) {
+	events, err := au.auctionRepositoryInterface.FindAuctionEventsByAuctionId(ctx, auction.Id)
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	// Eventos vêm em ordem cronológica - percorre de trás para frente
+	for i := len(events) - 1; i >= 0; i-- {
+		if events[i].Type == auction_entity.AuctionClosedEvent {
+			return events[i].Timestamp, nil
+		}
+	}
+
+	return auction.EndTime, nil
+}
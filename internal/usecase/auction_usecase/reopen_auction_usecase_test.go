@@ -0,0 +1,26 @@
+package auction_usecase
+
+import (
+	"context"
+	"testing"
+	"time"
+)
+
+func TestReopenAuctionDurationBoundary(t *testing.T) {
+	cfg := testAuctionConfig()
+	cfg.MinDuration = time.Minute
+	cfg.ReopenGraceWindow = time.Hour
+	env := newTestEnv(cfg)
+	auction := env.createAuction(t, nil)
+	env.closeByTimer(t, auction.Id)
+	ctx := context.Background()
+
+	err := env.useCase.ReopenAuction(ctx, auction.Id, AuctionReopenInputDTO{Duration: "59s"})
+	if err == nil || err.Err != "bad_request" || len(err.Causes) != 1 || err.Causes[0].Field != "duration" {
+		t.Fatalf("reopen below the minimum: got %+v, want bad_request on duration", err)
+	}
+
+	if err := env.useCase.ReopenAuction(ctx, auction.Id, AuctionReopenInputDTO{Duration: "1m"}); err != nil {
+		t.Fatalf("reopen with exactly the minimum: %v", err)
+	}
+}
@@ -0,0 +1,71 @@
+package auction_usecase
+
+import (
+	"context"
+	"testing"
+
+	"github.com/Guilherme-G-Cadilhe/Go-AuctionHouse_Concurrency-Server-Api/internal/entity/auction_entity"
+)
+
+// O vencedor, o preço e o feed de atividade (o "leaderboard" do leilão) seguem a mesma regra dos lances
+func TestSealedAuctionLeaderboardHiddenWhileActive(t *testing.T) {
+	env := newTestEnv(testAuctionConfig())
+	auction := env.createAuction(t, func(a *auction_entity.Auction) { a.Sealed = true })
+	env.storeBids(t, auction.Id, 10, 20)
+	ctx := context.Background()
+
+	if _, err := env.useCase.FindWinningBidByAuctionId(ctx, auction.Id); err == nil || err.Err != "forbidden" {
+		t.Fatalf("FindWinningBidByAuctionId: got %v, want forbidden", err)
+	}
+	if _, err := env.useCase.FindAuctionPrice(ctx, auction.Id); err == nil || err.Err != "forbidden" {
+		t.Fatalf("FindAuctionPrice: got %v, want forbidden", err)
+	}
+
+	activity, err := env.useCase.FindAuctionActivity(ctx, auction.Id)
+	if err != nil {
+		t.Fatalf("FindAuctionActivity: %v", err)
+	}
+	for _, item := range activity {
+		if item.Type == ActivityTypeBid {
+			t.Fatalf("activity of an active sealed auction exposes bid %s", item.Bid.Id)
+		}
+	}
+}
+
+func TestSealedAuctionLeaderboardRevealedAfterClose(t *testing.T) {
+	env := newTestEnv(testAuctionConfig())
+	auction := env.createAuction(t, func(a *auction_entity.Auction) { a.Sealed = true })
+	env.storeBids(t, auction.Id, 10, 20)
+	env.closeByTimer(t, auction.Id)
+	ctx := context.Background()
+
+	winning, err := env.useCase.FindWinningBidByAuctionId(ctx, auction.Id)
+	if err != nil {
+		t.Fatalf("FindWinningBidByAuctionId: %v", err)
+	}
+	if winning.Bid == nil || winning.Bid.Amount != 20 {
+		t.Fatalf("winning bid = %+v, want amount 20", winning.Bid)
+	}
+
+	price, err := env.useCase.FindAuctionPrice(ctx, auction.Id)
+	if err != nil {
+		t.Fatalf("FindAuctionPrice: %v", err)
+	}
+	if price.CurrentPrice != 20 || price.BidCount != 2 {
+		t.Fatalf("price = %+v, want 20 with 2 bids", price)
+	}
+
+	activity, err := env.useCase.FindAuctionActivity(ctx, auction.Id)
+	if err != nil {
+		t.Fatalf("FindAuctionActivity: %v", err)
+	}
+	bids := 0
+	for _, item := range activity {
+		if item.Type == ActivityTypeBid {
+			bids++
+		}
+	}
+	if bids != 2 {
+		t.Fatalf("activity has %d bids after close, want 2", bids)
+	}
+}
@@ -0,0 +1,78 @@
+package auction_usecase
+
+import (
+	"context"
+
+	"github.com/Guilherme-G-Cadilhe/Go-AuctionHouse_Concurrency-Server-Api/configuration/tracing"
+	"github.com/Guilherme-G-Cadilhe/Go-AuctionHouse_Concurrency-Server-Api/internal/auth_context"
+	"github.com/Guilherme-G-Cadilhe/Go-AuctionHouse_Concurrency-Server-Api/internal/internal_error"
+)
+
+// AuctionWatchersOutputDTO é a resposta das rotas /auctions/:auctionId/watch ("N watching")
+// Watching só vem no POST/DELETE: indica se o usuário autenticado ficou observando
+type AuctionWatchersOutputDTO struct {
+	AuctionId string `json:"auction_id"`
+	Watchers  int64  `json:"watchers"`
+	Watching  *bool  `json:"watching,omitempty"`
+}
+
+// WatchAuction inclui o usuário autenticado nos observadores do leilão
+// Idempotente: observar de novo não altera a contagem
+func (au *AuctionUseCase) WatchAuction(ctx context.Context, auctionId string) (*AuctionWatchersOutputDTO, *internal_error.InternalError) {
+	ctx, span := tracing.Start(ctx, "AuctionUseCase.WatchAuction")
+	defer span.End()
+
+	userId, err := auth_context.RequireUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	// Leilão inexistente: 404 em vez de criar observadores órfãos
+	if _, err := au.auctionRepositoryInterface.FindAuctionById(ctx, auctionId); err != nil {
+		return nil, err
+	}
+
+	watchers, err := au.auctionRepositoryInterface.AddAuctionWatcher(ctx, auctionId, userId)
+	if err != nil {
+		return nil, err
+	}
+	watching := true
+	return &AuctionWatchersOutputDTO{AuctionId: auctionId, Watchers: watchers, Watching: &watching}, nil
+}
+
+// UnwatchAuction retira o usuário autenticado dos observadores
+// Idempotente: deixar de observar um leilão que não observava apenas retorna a contagem
+func (au *AuctionUseCase) UnwatchAuction(ctx context.Context, auctionId string) (*AuctionWatchersOutputDTO, *internal_error.InternalError) {
+	ctx, span := tracing.Start(ctx, "AuctionUseCase.UnwatchAuction")
+	defer span.End()
+
+	userId, err := auth_context.RequireUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := au.auctionRepositoryInterface.FindAuctionById(ctx, auctionId); err != nil {
+		return nil, err
+	}
+
+	watchers, err := au.auctionRepositoryInterface.RemoveAuctionWatcher(ctx, auctionId, userId)
+	if err != nil {
+		return nil, err
+	}
+	watching := false
+	return &AuctionWatchersOutputDTO{AuctionId: auctionId, Watchers: watchers, Watching: &watching}, nil
+}
+
+// FindAuctionWatchers retorna quantos usuários observam o leilão - rota pública, só a contagem
+func (au *AuctionUseCase) FindAuctionWatchers(ctx context.Context, auctionId string) (*AuctionWatchersOutputDTO, *internal_error.InternalError) {
+	ctx, span := tracing.Start(ctx, "AuctionUseCase.FindAuctionWatchers")
+	defer span.End()
+
+	if _, err := au.auctionRepositoryInterface.FindAuctionById(ctx, auctionId); err != nil {
+		return nil, err
+	}
+
+	watchers, err := au.auctionRepositoryInterface.CountAuctionWatchers(ctx, auctionId)
+	if err != nil {
+		return nil, err
+	}
+	return &AuctionWatchersOutputDTO{AuctionId: auctionId, Watchers: watchers}, nil
+}
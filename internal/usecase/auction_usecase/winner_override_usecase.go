@@ -0,0 +1,63 @@
+package auction_usecase
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Guilherme-G-Cadilhe/Go-AuctionHouse_Concurrency-Server-Api/configuration/logger"
+	"github.com/Guilherme-G-Cadilhe/Go-AuctionHouse_Concurrency-Server-Api/configuration/tracing"
+	"github.com/Guilherme-G-Cadilhe/Go-AuctionHouse_Concurrency-Server-Api/internal/auth_context"
+	"github.com/Guilherme-G-Cadilhe/Go-AuctionHouse_Concurrency-Server-Api/internal/internal_error"
+	"go.uber.org/zap"
+)
+
+// AuctionWinnerInputDTO é o lance escolhido pelo administrador como vencedor
+type AuctionWinnerInputDTO struct {
+	BidId string `json:"bid_id" binding:"required,uuid"`
+}
+
+// OverrideAuctionWinner define manualmente o vencedor de um leilão fechado (resolução de disputas)
+// Sem usuário autenticado: 401; usuário que não é admin: 403
+// O lance precisa ser do próprio leilão (400) e o leilão precisa estar fechado (409)
+func (au *AuctionUseCase) OverrideAuctionWinner(ctx context.Context, auctionId string, input AuctionWinnerInputDTO) (*WinningInfoOutputDTO, *internal_error.InternalError) {
+	ctx, span := tracing.Start(ctx, "AuctionUseCase.OverrideAuctionWinner")
+	defer span.End()
+
+	userId, err := auth_context.RequireUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if !au.isAdmin(userId) {
+		return nil, internal_error.NewForbiddenError("only admins can override the winner of an auction")
+	}
+
+	auction, err := au.auctionRepositoryInterface.FindAuctionById(ctx, auctionId)
+	if err != nil {
+		return nil, err
+	}
+
+	bid, err := au.bidRepositoryInterface.FindBidById(ctx, input.BidId)
+	if err != nil && err.Err != "not_found" {
+		return nil, err
+	}
+	// Lance inexistente e lance de outro leilão recebem a mesma resposta - o id informado não serve
+	if bid == nil || bid.AuctionId != auctionId {
+		return nil, internal_error.NewBadRequestError("invalid fields", internal_error.Cause{
+			Field:   "bid_id",
+			Message: fmt.Sprintf("bid %s does not belong to auction %s", input.BidId, auctionId),
+		})
+	}
+
+	if err := au.auctionRepositoryInterface.SetAuctionWinner(ctx, auctionId, *bid); err != nil {
+		return nil, err
+	}
+
+	// Auditoria: quem trocou o vencedor, e qual era o anterior (vazio se não havia vencedor gravado)
+	logger.Info("auction winner overridden",
+		zap.String("auction_id", auctionId),
+		zap.String("bid_id", bid.Id),
+		zap.String("previous_bid_id", auction.WinningBidId),
+		zap.String("actor_id", userId))
+
+	return au.FindWinningBidByAuctionId(ctx, auctionId)
+}
@@ -0,0 +1,84 @@
+package auction_usecase
+
+import (
+	"context"
+	"testing"
+
+	"github.com/Guilherme-G-Cadilhe/Go-AuctionHouse_Concurrency-Server-Api/internal/auth_context"
+)
+
+func TestOverrideAuctionWinner(t *testing.T) {
+	env := newTestEnv(testAuctionConfig())
+	auction := env.createAuction(t, nil)
+	// Lotes separados: no mesmo lote o lance menor seria rejeitado por não bater o vencedor
+	runnerUp := env.storeBids(t, auction.Id, 10)[0]
+	env.storeBids(t, auction.Id, 20)
+	env.closeByTimer(t, auction.Id)
+
+	ctx := auth_context.WithUserID(context.Background(), testAdminId)
+	winning, err := env.useCase.OverrideAuctionWinner(ctx, auction.Id, AuctionWinnerInputDTO{BidId: runnerUp.Id})
+	if err != nil {
+		t.Fatalf("OverrideAuctionWinner: %v", err)
+	}
+	if winning.Bid == nil || winning.Bid.Id != runnerUp.Id || winning.Bid.Amount != 10 {
+		t.Fatalf("winning bid = %+v, want %s with amount 10", winning.Bid, runnerUp.Id)
+	}
+
+	stored, _ := env.auctions.FindAuctionById(context.Background(), auction.Id)
+	if stored.WinningBidId != runnerUp.Id {
+		t.Fatalf("persisted winning bid = %q, want %q", stored.WinningBidId, runnerUp.Id)
+	}
+}
+
+// Lance de outro leilão recebe a mesma resposta de lance inexistente: 400 no campo bid_id
+func TestOverrideAuctionWinnerRejectsBidFromAnotherAuction(t *testing.T) {
+	env := newTestEnv(testAuctionConfig())
+	auction := env.createAuction(t, nil)
+	other := env.createAuction(t, nil)
+	env.storeBids(t, auction.Id, 20)
+	foreign := env.storeBids(t, other.Id, 30)[0]
+	env.closeByTimer(t, auction.Id)
+
+	ctx := auth_context.WithUserID(context.Background(), testAdminId)
+	for _, bidId := range []string{foreign.Id, "44444444-4444-4444-4444-444444444444"} {
+		_, err := env.useCase.OverrideAuctionWinner(ctx, auction.Id, AuctionWinnerInputDTO{BidId: bidId})
+		if err == nil || err.Err != "bad_request" {
+			t.Fatalf("bid %s: err = %v, want bad_request", bidId, err)
+		}
+		if len(err.Causes) != 1 || err.Causes[0].Field != "bid_id" {
+			t.Fatalf("bid %s: causes = %+v, want bid_id", bidId, err.Causes)
+		}
+	}
+}
+
+func TestOverrideAuctionWinnerErrors(t *testing.T) {
+	tests := []struct {
+		name   string
+		userId string
+		close  bool
+		want   string
+	}{
+		{"without user", "", true, "unauthorized"},
+		{"non admin", testBidderId, true, "forbidden"},
+		{"active auction", testAdminId, false, "conflict"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			env := newTestEnv(testAuctionConfig())
+			auction := env.createAuction(t, nil)
+			bid := env.storeBids(t, auction.Id, 20)[0]
+			if tt.close {
+				env.closeByTimer(t, auction.Id)
+			}
+
+			ctx := context.Background()
+			if tt.userId != "" {
+				ctx = auth_context.WithUserID(ctx, tt.userId)
+			}
+			_, err := env.useCase.OverrideAuctionWinner(ctx, auction.Id, AuctionWinnerInputDTO{BidId: bid.Id})
+			if err == nil || err.Err != tt.want {
+				t.Fatalf("err = %v, want %s", err, tt.want)
+			}
+		})
+	}
+}
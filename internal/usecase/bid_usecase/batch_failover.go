@@ -0,0 +1,62 @@
+package bid_usecase
+
+import (
+	"time"
+
+	"github.com/Guilherme-G-Cadilhe/Go-AuctionHouse_Concurrency-Server-Api/configuration/logger"
+	"github.com/Guilherme-G-Cadilhe/Go-AuctionHouse_Concurrency-Server-Api/internal/entity/bid_entity"
+	"go.uber.org/zap"
+)
+
+// requeueFailoverBids decide o batch do próximo ciclo após um flush
+// Lances perdidos no failover voltam ao batch até FAILOVER_MAX_REQUEUES vezes; depois são descartados
+// Havendo failover, os flushes pausam por FAILOVER_BACKOFF para o replica set eleger o novo primário
+// Roda só na goroutine do batch
+func (bu *BidUseCase) requeueFailoverBids(failoverBids []bid_entity.Bid) []bid_entity.Bid {
+	// Todo lance reenviado está no batch que acabou de ser gravado, então o contador
+	// anterior só interessa aos lances que falharam de novo - o resto é descartado com o map antigo
+	previousAttempts := bu.requeueAttempts
+	bu.requeueAttempts = nil
+	if len(failoverBids) == 0 {
+		return nil
+	}
+
+	bu.requeueAttempts = make(map[string]int, len(failoverBids))
+	var requeued []bid_entity.Bid
+	dropped := 0
+	for _, bid := range failoverBids {
+		attempts := previousAttempts[bid.Id] + 1
+		if attempts > bu.failoverMaxRequeues {
+			dropped++
+			logger.Warn("bid dropped: MongoDB failover requeue limit reached",
+				zap.String("bid_id", bid.Id),
+				zap.String("auction_id", bid.AuctionId),
+				zap.Int("max_requeues", bu.failoverMaxRequeues))
+			bu.statuses.reject(bid.Id, bid_entity.RejectFailoverRequeues, bu.clock.Now())
+			continue
+		}
+		bu.requeueAttempts[bid.Id] = attempts
+		requeued = append(requeued, bid)
+	}
+
+	bu.flushPausedUntil = bu.clock.Now().Add(bu.failoverBackoff)
+	logger.Warn("MongoDB failover detected, pausing bid flushes",
+		zap.Int("requeued_bids", len(requeued)),
+		zap.Int("dropped_bids", dropped),
+		zap.Duration("backoff", bu.failoverBackoff))
+
+	return requeued
+}
+
+// flushPaused indica se os flushes estão suspensos pelo backoff de failover
+func (bu *BidUseCase) flushPaused() bool {
+	return bu.clock.Now().Before(bu.flushPausedUntil)
+}
+
+// nextFlushDelay é o intervalo do timer: o restante da pausa de failover ou o BATCH_INSERT_INTERVAL
+func (bu *BidUseCase) nextFlushDelay() time.Duration {
+	if remaining := bu.flushPausedUntil.Sub(bu.clock.Now()); remaining > 0 {
+		return remaining
+	}
+	return bu.batchInsertInterval
+}
@@ -0,0 +1,72 @@
+package bid_usecase
+
+import (
+	"time"
+
+	"github.com/Guilherme-G-Cadilhe/Go-AuctionHouse_Concurrency-Server-Api/internal/clock"
+)
+
+// heartbeatsPerStallTimeout define quantas batidas cabem no BATCH_STALL_TIMEOUT
+// Com folga: uma batida atrasada pelo agendador não marca a goroutine como travada
+const heartbeatsPerStallTimeout = 4
+
+// batchHeartbeat prova que a goroutine do batch continua girando o select (GET /readyz)
+// Sem ele, um batch ocioso (BATCH_IDLE_INTERVAL=0) e um batch travado num flush seriam indistinguíveis:
+// nos dois casos nada acontece. O timer próprio faz o loop acordar mesmo sem lances
+type batchHeartbeat struct {
+	stallTimeout time.Duration // BATCH_STALL_TIMEOUT (0 = sem checagem)
+	interval     time.Duration
+	timer        clock.Timer // nil quando desligado
+}
+
+func newBatchHeartbeat(stallTimeout time.Duration, clk clock.Clock) *batchHeartbeat {
+	heartbeat := &batchHeartbeat{stallTimeout: stallTimeout}
+	if stallTimeout > 0 {
+		heartbeat.interval = stallTimeout / heartbeatsPerStallTimeout
+		heartbeat.timer = clk.NewTimer(heartbeat.interval)
+	}
+	return heartbeat
+}
+
+// timerC é o case do select; channel nil (desligado) nunca fica pronto
+func (h *batchHeartbeat) timerC() <-chan time.Time {
+	if h.timer == nil {
+		return nil
+	}
+	return h.timer.C()
+}
+
+// rearm agenda a próxima batida - só a goroutine do batch chama
+func (h *batchHeartbeat) rearm() {
+	if h.timer != nil {
+		h.timer.Reset(h.interval)
+	}
+}
+
+func (h *batchHeartbeat) stop() {
+	if h.timer != nil {
+		h.timer.Stop()
+	}
+}
+
+// beat registra que a goroutine do batch está viva - chamado a cada volta do select
+func (bu *BidUseCase) beat() {
+	bu.lastHeartbeat.Store(bu.clock.Now().UnixNano())
+}
+
+// IsStalled indica se a goroutine do batch parou de girar: encerrada (Close) ou presa
+// há mais de BATCH_STALL_TIMEOUT em um mesmo evento (ex: flush pendurado no banco)
+// Leitura atômica - segura para ser chamada pelo handler do /readyz
+func (bu *BidUseCase) IsStalled() bool {
+	select {
+	case <-bu.done:
+		return true
+	default:
+	}
+
+	if bu.heartbeat.stallTimeout <= 0 {
+		return false
+	}
+	lastHeartbeat := time.Unix(0, bu.lastHeartbeat.Load())
+	return bu.clock.Now().Sub(lastHeartbeat) > bu.heartbeat.stallTimeout
+}
@@ -0,0 +1,85 @@
+package bid_usecase
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/Guilherme-G-Cadilhe/Go-AuctionHouse_Concurrency-Server-Api/internal/auth_context"
+	"github.com/Guilherme-G-Cadilhe/Go-AuctionHouse_Concurrency-Server-Api/internal/entity/bid_entity"
+	"github.com/google/uuid"
+)
+
+// Dois BidUseCase no mesmo processo: cada um tem o seu batch
+// Juntos eles passam do MaxBatchSize - com um batch compartilhado haveria flush antes do Close
+// Um leilão por lance: nenhum lance do batch é descartado por não superar outro do mesmo leilão
+func TestBidUseCaseInstancesKeepIndependentBatches(t *testing.T) {
+	const bidsPerInstance = 3
+	bidCfg := testBidConfig()
+	bidCfg.MaxBatchSize = 5
+
+	envs := []*testEnv{newTestEnv(t, bidCfg), newTestEnv(t, bidCfg)}
+	auctionIds := make([][]string, len(envs))
+	for i, env := range envs {
+		for n := 0; n < bidsPerInstance; n++ {
+			auctionIds[i] = append(auctionIds[i], env.createAuction(t, nil).Id)
+		}
+	}
+
+	var wg sync.WaitGroup
+	for i, env := range envs {
+		for _, auctionId := range auctionIds[i] {
+			wg.Add(1)
+			go func(env *testEnv, auctionId string) {
+				defer wg.Done()
+				ctx := auth_context.WithUserID(context.Background(), uuid.New().String())
+				if _, err := env.useCase.CreateBid(ctx, BidInputDTO{AuctionId: auctionId, Amount: 10}); err != nil {
+					t.Errorf("CreateBid: %v", err)
+				}
+			}(env, auctionId)
+		}
+	}
+	wg.Wait()
+
+	for i, env := range envs {
+		waitForBatchSize(t, env.useCase, bidsPerInstance)
+		if stored := countStoredBids(t, env, auctionIds[i]); stored != 0 {
+			t.Fatalf("instance %d flushed %d bids before Close, want the batch still pending", i, stored)
+		}
+	}
+
+	for i, env := range envs {
+		if err := env.useCase.Close(context.Background()); err != nil {
+			t.Fatalf("Close: %v", err)
+		}
+		if stored := countStoredBids(t, env, auctionIds[i]); stored != bidsPerInstance {
+			t.Fatalf("instance %d stored %d bids, want %d", i, stored, bidsPerInstance)
+		}
+	}
+}
+
+// waitForBatchSize espera a goroutine do batch consumir o channel
+func waitForBatchSize(t *testing.T, useCase *BidUseCase, want int) {
+	t.Helper()
+	deadline := time.Now().Add(time.Second)
+	for useCase.QueueStats().BatchSize != want {
+		if time.Now().After(deadline) {
+			t.Fatalf("batch size = %d, want %d", useCase.QueueStats().BatchSize, want)
+		}
+		time.Sleep(time.Millisecond)
+	}
+}
+
+func countStoredBids(t *testing.T, env *testEnv, auctionIds []string) int {
+	t.Helper()
+	stored := 0
+	for _, auctionId := range auctionIds {
+		bids, err := env.bids.FindBidByAuctionId(context.Background(), auctionId, bid_entity.BidFilter{})
+		if err != nil {
+			t.Fatalf("FindBidByAuctionId: %v", err)
+		}
+		stored += len(bids)
+	}
+	return stored
+}
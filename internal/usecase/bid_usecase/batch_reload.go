@@ -0,0 +1,65 @@
+package bid_usecase
+
+import (
+	"time"
+
+	"github.com/Guilherme-G-Cadilhe/Go-AuctionHouse_Concurrency-Server-Api/configuration/config"
+	"github.com/Guilherme-G-Cadilhe/Go-AuctionHouse_Concurrency-Server-Api/configuration/logger"
+	"go.uber.org/zap"
+)
+
+// batchSettings são os parâmetros do batch que podem mudar sem restart (SIGHUP)
+// O buffer do bidChannel fica de fora: a capacidade de um channel é fixa na criação
+type batchSettings struct {
+	maxBatchSize          int
+	batchInsertInterval   time.Duration
+	batchIdleInterval     time.Duration
+	flushFailureThreshold int
+	slowFlushThreshold    time.Duration
+}
+
+func newBatchSettings(cfg config.BidConfig) batchSettings {
+	return batchSettings{
+		maxBatchSize:          cfg.MaxBatchSize,
+		batchInsertInterval:   cfg.BatchInsertInterval,
+		batchIdleInterval:     cfg.BatchIdleInterval,
+		flushFailureThreshold: cfg.BatchFailureThreshold,
+		slowFlushThreshold:    cfg.SlowFlushThreshold,
+	}
+}
+
+// ReloadBatchConfig entrega os novos parâmetros à goroutine do batch - só ela lê e escreve esses campos,
+// então a troca acontece entre dois eventos do select, sem mutex e sem perder lances do batch atual
+// Depois do Close não há goroutine para aplicar: a chamada apenas retorna
+func (bu *BidUseCase) ReloadBatchConfig(cfg config.BidConfig) {
+	select {
+	case bu.reloads <- newBatchSettings(cfg):
+	case <-bu.done:
+	}
+}
+
+// applyBatchSettings roda na goroutine do batch
+// Os lances já no batch ficam nele: com o tamanho máximo reduzido, o próximo lance (ou o timer) faz o flush
+// pending é o tamanho do batch local da goroutine, só para o log
+func (bu *BidUseCase) applyBatchSettings(settings batchSettings, pending int) {
+	intervalChanged := settings.batchInsertInterval != bu.batchInsertInterval
+
+	bu.maxBatchSize = settings.maxBatchSize
+	bu.batchInsertInterval = settings.batchInsertInterval
+	bu.batchIdleInterval = settings.batchIdleInterval
+	bu.flushFailureThreshold = settings.flushFailureThreshold
+	bu.slowFlushThreshold = settings.slowFlushThreshold
+
+	// Sem isso um intervalo reduzido (ex: 3m -> 5s) só valeria depois do disparo já armado
+	if intervalChanged && !bu.idle {
+		bu.timer.Reset(bu.nextFlushDelay())
+	}
+
+	logger.Info("bid batch config reloaded",
+		zap.Int("max_batch_size", bu.maxBatchSize),
+		zap.Duration("batch_insert_interval", bu.batchInsertInterval),
+		zap.Duration("batch_idle_interval", bu.batchIdleInterval),
+		zap.Int("batch_failure_threshold", bu.flushFailureThreshold),
+		zap.Duration("slow_flush_threshold", bu.slowFlushThreshold),
+		zap.Int("pending", pending))
+}
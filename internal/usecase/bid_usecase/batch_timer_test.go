@@ -0,0 +1,64 @@
+package bid_usecase
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/Guilherme-G-Cadilhe/Go-AuctionHouse_Concurrency-Server-Api/internal/auth_context"
+)
+
+// O batch não cheio só é gravado quando o BATCH_INSERT_INTERVAL passa - sem sleeps reais
+func TestBatchFlushesOnTimer(t *testing.T) {
+	env := newTestEnv(t, testBidConfig())
+	auctionId := env.createAuction(t, nil).Id
+
+	ctx := auth_context.WithUserID(context.Background(), testBidderId)
+	if _, err := env.useCase.CreateBid(ctx, BidInputDTO{AuctionId: auctionId, Amount: 10}); err != nil {
+		t.Fatalf("CreateBid: %v", err)
+	}
+	waitForBatchSize(t, env.useCase, 1)
+
+	env.clock.Advance(testBidConfig().BatchInsertInterval - time.Second)
+	if stored := countStoredBids(t, env, []string{auctionId}); stored != 0 {
+		t.Fatalf("batch flushed %d bids before the interval", stored)
+	}
+
+	env.clock.Advance(time.Second)
+	waitForBatchSize(t, env.useCase, 0)
+	if stored := countStoredBids(t, env, []string{auctionId}); stored != 1 {
+		t.Fatalf("got %d stored bids after the interval, want 1", stored)
+	}
+}
+
+// Lance aceito antes do fim, mas gravado depois do fechamento: o flush descarta
+func TestBidFlushedAfterAuctionEndIsDiscarded(t *testing.T) {
+	bidCfg := testBidConfig()
+	bidCfg.StatusTTL = time.Hour
+	env := newTestEnv(t, bidCfg)
+	auctionId := env.createAuction(t, nil).Id
+
+	ctx := auth_context.WithUserID(context.Background(), testBidderId)
+	output, err := env.useCase.CreateBid(ctx, BidInputDTO{AuctionId: auctionId, Amount: 10})
+	if err != nil {
+		t.Fatalf("CreateBid: %v", err)
+	}
+	waitForBatchSize(t, env.useCase, 1)
+
+	// Fecha o leilão antes do BATCH_INSERT_INTERVAL
+	env.clock.Advance(testAuctionConfig().Interval + time.Second)
+	if err := env.useCase.Close(context.Background()); err != nil {
+		t.Fatalf("Close: %v", err)
+	}
+
+	if stored := countStoredBids(t, env, []string{auctionId}); stored != 0 {
+		t.Fatalf("got %d stored bids for a closed auction, want 0", stored)
+	}
+	status, err := env.useCase.FindBidStatus(context.Background(), output.SubmissionId)
+	if err != nil {
+		t.Fatalf("FindBidStatus: %v", err)
+	}
+	if status.Status != BidRejected {
+		t.Fatalf("bid status = %q, want rejected", status.Status)
+	}
+}
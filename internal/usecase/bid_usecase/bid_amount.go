@@ -0,0 +1,37 @@
+package bid_usecase
+
+import (
+	"bytes"
+	"encoding/json"
+
+	"github.com/Guilherme-G-Cadilhe/Go-AuctionHouse_Concurrency-Server-Api/internal/entity/bid_entity"
+)
+
+// BidAmount é o valor do lance recebido no JSON
+// Aceita número (10.5, 1e3) ou string ("10.50") - alguns clientes serializam valores monetários como texto
+// Similar a um preprocess do Zod no Node.js antes do z.number()
+type BidAmount float64
+
+// UnmarshalJSON é chamado pelo encoding/json (e pelo ShouldBindJSON do Gin) ao decodificar "amount"
+// O texto original chega aqui antes de virar float64 - as regras de bid_entity.ParseAmount usam esse texto
+// O erro devolvido é um *internal_error.InternalError, convertido em 400 por validation.ValidateErr
+func (a *BidAmount) UnmarshalJSON(data []byte) error {
+	// null mantém o zero value - o Validate da entidade responde "amount must be greater than 0"
+	if bytes.Equal(data, []byte("null")) {
+		return nil
+	}
+
+	literal := string(data)
+	if len(data) > 0 && data[0] == '"' {
+		if err := json.Unmarshal(data, &literal); err != nil {
+			return err
+		}
+	}
+
+	amount, err := bid_entity.ParseAmount(literal)
+	if err != nil {
+		return err
+	}
+	*a = BidAmount(amount)
+	return nil
+}
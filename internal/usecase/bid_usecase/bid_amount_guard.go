@@ -0,0 +1,78 @@
+package bid_usecase
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Guilherme-G-Cadilhe/Go-AuctionHouse_Concurrency-Server-Api/internal/entity/auction_entity"
+	"github.com/Guilherme-G-Cadilhe/Go-AuctionHouse_Concurrency-Server-Api/internal/entity/bid_entity"
+	"github.com/Guilherme-G-Cadilhe/Go-AuctionHouse_Concurrency-Server-Api/internal/internal_error"
+)
+
+// bidAmountGuard protege contra lances digitados errado ("fat finger"): um valor acima de
+// N vezes o preço de referência só é aceito com confirm=true no corpo do lance
+// A verificação roda na request, antes do batch - o cliente recebe o 400 na hora
+type bidAmountGuard struct {
+	maxMultiplier float64 // BID_MAX_AMOUNT_MULTIPLIER (<= 1 = desabilitado)
+	startingPrice float64 // AUCTION_STARTING_PRICE - referência de leilões sem lances
+}
+
+func newBidAmountGuard(maxMultiplier, startingPrice float64) bidAmountGuard {
+	return bidAmountGuard{maxMultiplier: maxMultiplier, startingPrice: startingPrice}
+}
+
+func (g bidAmountGuard) enabled() bool {
+	return g.maxMultiplier > 1
+}
+
+// checkAmountGuard devolve o 400 quando o lance passa do limite e não foi confirmado
+// A referência é o lance vencedor atual ou, sem lances, o AUCTION_STARTING_PRICE
+// Holandês usa o preço corrente; sealed-bid ativo usa sempre o preço inicial - o erro não pode revelar o vencedor
+func (bu *BidUseCase) checkAmountGuard(ctx context.Context, bid *bid_entity.Bid, confirmed bool) *internal_error.InternalError {
+	if !bu.amountGuard.enabled() || confirmed {
+		return nil
+	}
+
+	reference, err := bu.amountGuardReference(ctx, bid)
+	if err != nil {
+		return err
+	}
+	if reference <= 0 {
+		return nil
+	}
+
+	limit := reference * bu.amountGuard.maxMultiplier
+	if bid_entity.CompareAmounts(bid.Amount, limit) <= 0 {
+		return nil
+	}
+
+	return internal_error.NewBadRequestError(
+		fmt.Sprintf("amount exceeds %v times the reference price of %v, resend with confirm=true to place it", bu.amountGuard.maxMultiplier, reference),
+		internal_error.Cause{
+			Field:   "amount",
+			Message: fmt.Sprintf("amount is above the limit of %v", limit),
+		})
+}
+
+func (bu *BidUseCase) amountGuardReference(ctx context.Context, bid *bid_entity.Bid) (float64, *internal_error.InternalError) {
+	auction, err := bu.AuctionRepository.FindAuctionById(ctx, bid.AuctionId)
+	if err != nil {
+		return 0, err
+	}
+	if auction.IsDutch() {
+		return auction.DutchPriceAt(bid.Timestamp), nil
+	}
+	if auction.Sealed && auction.Status == auction_entity.Active {
+		return bu.amountGuard.startingPrice, nil
+	}
+
+	winningBid, err := bu.BidRepository.FindWinningBidByAuctionId(ctx, bid.AuctionId)
+	if err != nil {
+		// not_found = leilão sem lances; qualquer outro erro é propagado
+		if err.Err != "not_found" {
+			return 0, err
+		}
+		return bu.amountGuard.startingPrice, nil
+	}
+	return winningBid.Amount, nil
+}
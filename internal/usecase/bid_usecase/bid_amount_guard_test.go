@@ -0,0 +1,72 @@
+package bid_usecase
+
+import (
+	"context"
+	"testing"
+
+	"github.com/Guilherme-G-Cadilhe/Go-AuctionHouse_Concurrency-Server-Api/internal/auth_context"
+)
+
+func TestCreateBidAmountGuard(t *testing.T) {
+	tests := []struct {
+		name    string
+		amount  BidAmount
+		confirm bool
+		wantErr bool
+	}{
+		{"within limit", 500, false, false},
+		{"over limit", 501, false, true},
+		{"over limit with confirm", 501, true, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			bidCfg := testBidConfig()
+			bidCfg.MaxAmountMultiplier = 10
+			env := newTestEnv(t, bidCfg)
+			auctionId := env.createAuction(t, nil).Id
+			env.storeBids(t, auctionId, 50)
+			ctx := auth_context.WithUserID(context.Background(), testBidderId)
+
+			_, err := env.useCase.CreateBid(ctx, BidInputDTO{AuctionId: auctionId, Amount: tt.amount, Confirm: tt.confirm})
+			if !tt.wantErr {
+				if err != nil {
+					t.Fatalf("CreateBid: %v", err)
+				}
+				return
+			}
+			if err == nil || err.Err != "bad_request" {
+				t.Fatalf("err = %v, want bad_request", err)
+			}
+			if len(err.Causes) != 1 || err.Causes[0].Field != "amount" {
+				t.Fatalf("causes = %+v, want amount", err.Causes)
+			}
+		})
+	}
+}
+
+// Sem lances a referência é o AUCTION_STARTING_PRICE (1 nos testes)
+func TestCreateBidAmountGuardUsesStartingPriceWithoutBids(t *testing.T) {
+	bidCfg := testBidConfig()
+	bidCfg.MaxAmountMultiplier = 10
+	env := newTestEnv(t, bidCfg)
+	auctionId := env.createAuction(t, nil).Id
+	ctx := auth_context.WithUserID(context.Background(), testBidderId)
+
+	if _, err := env.useCase.CreateBid(ctx, BidInputDTO{AuctionId: auctionId, Amount: 11}); err == nil || err.Err != "bad_request" {
+		t.Fatalf("err = %v, want bad_request", err)
+	}
+	if _, err := env.useCase.CreateBid(ctx, BidInputDTO{AuctionId: auctionId, Amount: 10}); err != nil {
+		t.Fatalf("CreateBid at the limit: %v", err)
+	}
+}
+
+func TestCreateBidAmountGuardDisabled(t *testing.T) {
+	env := newTestEnv(t, testBidConfig())
+	auctionId := env.createAuction(t, nil).Id
+	env.storeBids(t, auctionId, 50)
+	ctx := auth_context.WithUserID(context.Background(), testBidderId)
+
+	if _, err := env.useCase.CreateBid(ctx, BidInputDTO{AuctionId: auctionId, Amount: 1000000}); err != nil {
+		t.Fatalf("CreateBid with the guard disabled: %v", err)
+	}
+}
@@ -0,0 +1,41 @@
+package bid_usecase
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestBidAmountUnmarshalJSON(t *testing.T) {
+	tests := []struct {
+		body    string
+		want    BidAmount
+		wantErr bool
+	}{
+		{body: `{"amount": 10.5}`, want: 10.5},
+		{body: `{"amount": "10.50"}`, want: 10.5},
+		{body: `{"amount": 1e3}`, want: 1000},
+		{body: `{"amount": "1e3"}`, want: 1000},
+		{body: `{"amount": null}`, want: 0},
+		{body: `{"amount": "NaN"}`, wantErr: true},
+		{body: `{"amount": "Infinity"}`, wantErr: true},
+		{body: `{"amount": "abc"}`, wantErr: true},
+		{body: `{"amount": 1e400}`, wantErr: true},
+	}
+	for _, tt := range tests {
+		var input BidInputDTO
+		err := json.Unmarshal([]byte(tt.body), &input)
+		if tt.wantErr {
+			if err == nil {
+				t.Errorf("Unmarshal(%s) = %v, want error", tt.body, input.Amount)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("Unmarshal(%s) returned error %v", tt.body, err)
+			continue
+		}
+		if input.Amount != tt.want {
+			t.Errorf("Unmarshal(%s) amount = %v, want %v", tt.body, input.Amount, tt.want)
+		}
+	}
+}
@@ -0,0 +1,83 @@
+package bid_usecase
+
+import (
+	"sync"
+	"time"
+)
+
+// bidCooldown exige um intervalo mínimo entre lances consecutivos do mesmo usuário no mesmo leilão
+// Diferente do bidRateLimiter (N lances por janela), aqui cada lance abre uma espera fixa -
+// desestimula o spam de lances com incrementos mínimos
+type bidCooldown struct {
+	interval time.Duration // BID_COOLDOWN (0 = desabilitado)
+
+	// lastBids guarda o último lance por chave "userId:auctionId"
+	// Entradas mais antigas que o intervalo já não bloqueiam nada e são removidas pelo sweep (TTL)
+	lastBids  map[string]time.Time
+	lastSweep time.Time
+	mutex     *sync.Mutex
+}
+
+func newBidCooldown(interval time.Duration, now time.Time) *bidCooldown {
+	return &bidCooldown{
+		interval:  interval,
+		lastBids:  make(map[string]time.Time),
+		lastSweep: now,
+		mutex:     &sync.Mutex{},
+	}
+}
+
+// reserve registra o lance se o intervalo desde o último já passou
+// Caso contrário retorna quanto falta (o Retry-After) e false
+// Verificação e registro acontecem sob o mesmo lock: dois lances simultâneos não passam juntos
+func (bc *bidCooldown) reserve(userId, auctionId string, now time.Time) (time.Duration, bool) {
+	if bc.interval <= 0 {
+		return 0, true
+	}
+
+	bc.mutex.Lock()
+	defer bc.mutex.Unlock()
+
+	key := userId + ":" + auctionId
+	if last, ok := bc.lastBids[key]; ok {
+		if wait := last.Add(bc.interval).Sub(now); wait > 0 {
+			return wait, false
+		}
+	}
+
+	bc.lastBids[key] = now
+	bc.sweep(now)
+	return 0, true
+}
+
+// remaining retorna quanto falta para o usuário poder dar lance no leilão, sem registrar nada
+// Usado pela cotação (POST /bid/quote), que não pode consumir o intervalo
+func (bc *bidCooldown) remaining(userId, auctionId string, now time.Time) time.Duration {
+	if bc.interval <= 0 {
+		return 0
+	}
+
+	bc.mutex.Lock()
+	defer bc.mutex.Unlock()
+
+	last, ok := bc.lastBids[userId+":"+auctionId]
+	if !ok {
+		return 0
+	}
+	return max(last.Add(bc.interval).Sub(now), 0)
+}
+
+// sweep remove entradas cujo intervalo já expirou - o map não cresce com usuários inativos
+// Executa no máximo uma vez por intervalo
+func (bc *bidCooldown) sweep(now time.Time) {
+	if now.Sub(bc.lastSweep) < bc.interval {
+		return
+	}
+	bc.lastSweep = now
+
+	for key, last := range bc.lastBids {
+		if !now.Before(last.Add(bc.interval)) {
+			delete(bc.lastBids, key)
+		}
+	}
+}
@@ -0,0 +1,83 @@
+package bid_usecase
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/Guilherme-G-Cadilhe/Go-AuctionHouse_Concurrency-Server-Api/internal/auth_context"
+)
+
+func TestBidCooldownReserve(t *testing.T) {
+	start := time.Date(2024, 1, 2, 15, 0, 0, 0, time.UTC)
+	cooldown := newBidCooldown(10*time.Second, start)
+
+	if _, ok := cooldown.reserve("user", "auction", start); !ok {
+		t.Fatal("first bid should be accepted")
+	}
+
+	wait, ok := cooldown.reserve("user", "auction", start.Add(4*time.Second))
+	if ok || wait != 6*time.Second {
+		t.Fatalf("bid within the cooldown: wait %v ok %v, want 6s and false", wait, ok)
+	}
+	if _, ok := cooldown.reserve("user", "other-auction", start.Add(4*time.Second)); !ok {
+		t.Fatal("the cooldown is per auction - another auction should accept the bid")
+	}
+	if _, ok := cooldown.reserve("other-user", "auction", start.Add(4*time.Second)); !ok {
+		t.Fatal("the cooldown is per user - another user should accept the bid")
+	}
+
+	// O limite exato do intervalo já libera o lance
+	if _, ok := cooldown.reserve("user", "auction", start.Add(10*time.Second)); !ok {
+		t.Fatal("bid right after the cooldown should be accepted")
+	}
+}
+
+func TestBidCooldownDisabled(t *testing.T) {
+	start := time.Date(2024, 1, 2, 15, 0, 0, 0, time.UTC)
+	cooldown := newBidCooldown(0, start)
+
+	for i := 0; i < 3; i++ {
+		if _, ok := cooldown.reserve("user", "auction", start); !ok {
+			t.Fatal("a disabled cooldown should accept every bid")
+		}
+	}
+}
+
+func TestBidCooldownSweepEvictsExpiredEntries(t *testing.T) {
+	start := time.Date(2024, 1, 2, 15, 0, 0, 0, time.UTC)
+	cooldown := newBidCooldown(10*time.Second, start)
+
+	cooldown.reserve("user", "auction", start)
+	cooldown.reserve("other-user", "auction", start.Add(20*time.Second))
+
+	if _, ok := cooldown.lastBids["user:auction"]; ok {
+		t.Fatal("expired entry should be evicted by the sweep")
+	}
+	if len(cooldown.lastBids) != 1 {
+		t.Fatalf("got %d entries, want only the fresh one", len(cooldown.lastBids))
+	}
+}
+
+func TestCreateBidCooldownRetryAfter(t *testing.T) {
+	bidCfg := testBidConfig()
+	bidCfg.Cooldown = 10 * time.Second
+	env := newTestEnv(t, bidCfg)
+	auctionId := env.createAuction(t, nil).Id
+	ctx := auth_context.WithUserID(context.Background(), testBidderId)
+
+	if _, err := env.useCase.CreateBid(ctx, BidInputDTO{AuctionId: auctionId, Amount: 10}); err != nil {
+		t.Fatalf("first CreateBid: %v", err)
+	}
+
+	env.clock.Advance(3 * time.Second)
+	_, err := env.useCase.CreateBid(ctx, BidInputDTO{AuctionId: auctionId, Amount: 11})
+	if err == nil || err.Err != "too_many_requests" || err.RetryAfter != 7*time.Second {
+		t.Fatalf("CreateBid within the cooldown: got %+v, want too_many_requests with Retry-After 7s", err)
+	}
+
+	env.clock.Advance(7 * time.Second)
+	if _, err := env.useCase.CreateBid(ctx, BidInputDTO{AuctionId: auctionId, Amount: 12}); err != nil {
+		t.Fatalf("CreateBid after the cooldown: %v", err)
+	}
+}
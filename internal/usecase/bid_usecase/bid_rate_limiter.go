@@ -0,0 +1,76 @@
+package bid_usecase
+
+import (
+	"sync"
+	"time"
+)
+
+// bidRateLimiter é uma regra de DOMÍNIO: limita quantos lances um usuário
+// pode enviar para o mesmo leilão dentro de uma janela de tempo
+// Complementa o rate limit HTTP (por IP), que automações conseguem contornar
+type bidRateLimiter struct {
+	maxBids int           // Máximo de lances por janela (0 = desabilitado)
+	window  time.Duration // Tamanho da janela deslizante
+
+	// recentBids guarda os timestamps dos lances recentes por chave "userId:auctionId"
+	recentBids map[string][]time.Time
+	lastSweep  time.Time
+	mutex      *sync.Mutex
+}
+
+func newBidRateLimiter(maxBids int, window time.Duration, now time.Time) *bidRateLimiter {
+	return &bidRateLimiter{
+		maxBids:    maxBids,
+		window:     window,
+		recentBids: make(map[string][]time.Time),
+		lastSweep:  now,
+		mutex:      &sync.Mutex{},
+	}
+}
+
+// allow registra o lance e indica se ele está dentro do limite
+func (rl *bidRateLimiter) allow(userId, auctionId string, now time.Time) bool {
+	if rl.maxBids <= 0 {
+		return true
+	}
+
+	rl.mutex.Lock()
+	defer rl.mutex.Unlock()
+
+	key := userId + ":" + auctionId
+	recent := rl.prune(rl.recentBids[key], now)
+
+	if len(recent) >= rl.maxBids {
+		rl.recentBids[key] = recent
+		return false
+	}
+
+	rl.recentBids[key] = append(recent, now)
+	rl.sweep(now)
+	return true
+}
+
+// prune descarta timestamps fora da janela (slice está em ordem cronológica)
+func (rl *bidRateLimiter) prune(timestamps []time.Time, now time.Time) []time.Time {
+	cutoff := now.Add(-rl.window)
+	i := 0
+	for i < len(timestamps) && !timestamps[i].After(cutoff) {
+		i++
+	}
+	return timestamps[i:]
+}
+
+// sweep remove chaves sem lances recentes para o map não crescer indefinidamente
+// Executa no máximo uma vez por janela
+func (rl *bidRateLimiter) sweep(now time.Time) {
+	if now.Sub(rl.lastSweep) < rl.window {
+		return
+	}
+	rl.lastSweep = now
+
+	for key, timestamps := range rl.recentBids {
+		if len(rl.prune(timestamps, now)) == 0 {
+			delete(rl.recentBids, key)
+		}
+	}
+}
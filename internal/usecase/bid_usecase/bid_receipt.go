@@ -0,0 +1,59 @@
+package bid_usecase
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/Guilherme-G-Cadilhe/Go-AuctionHouse_Concurrency-Server-Api/internal/entity/bid_entity"
+)
+
+// BidReceiptDTO é o comprovante de submissão do lance
+// ServerTimestamp é o horário autoritativo do servidor (usado em desempates)
+// Signature é um HMAC-SHA256 dos campos do lance com o segredo do servidor
+type BidReceiptDTO struct {
+	ServerTimestamp time.Time `json:"server_timestamp"`
+	Signature       string    `json:"signature"`
+}
+
+// newBidReceipt assina os campos do lance
+// Retorna nil quando BID_RECEIPT_SECRET não está configurado
+func newBidReceipt(secret string, bid *bid_entity.Bid) *BidReceiptDTO {
+	if secret == "" {
+		return nil
+	}
+
+	return &BidReceiptDTO{
+		ServerTimestamp: bid.Timestamp,
+		Signature:       signBidReceipt(secret, bid.Id, bid.AuctionId, bid.Amount, bid.Timestamp),
+	}
+}
+
+// VerifyBidReceipt confere se o comprovante foi emitido por este servidor para o lance informado
+// hmac.Equal compara em tempo constante (evita timing attacks)
+func VerifyBidReceipt(secret string, bid BidOutputDTO) bool {
+	if secret == "" || bid.Receipt == nil {
+		return false
+	}
+
+	expected := signBidReceipt(secret, bid.Id, bid.AuctionId, bid.Amount, bid.Receipt.ServerTimestamp)
+	return hmac.Equal([]byte(expected), []byte(bid.Receipt.Signature))
+}
+
+// signBidReceipt gera o HMAC sobre "id|auctionId|amount|timestamp"
+// O timestamp usa RFC3339Nano para preservar a precisão usada em desempates
+func signBidReceipt(secret, bidId, auctionId string, amount float64, timestamp time.Time) string {
+	payload := strings.Join([]string{
+		bidId,
+		auctionId,
+		strconv.FormatFloat(amount, 'f', -1, 64),
+		timestamp.UTC().Format(time.RFC3339Nano),
+	}, "|")
+
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(payload))
+	return hex.EncodeToString(mac.Sum(nil))
+}
@@ -0,0 +1,26 @@
+package bid_usecase
+
+import (
+	"sync/atomic"
+	"time"
+)
+
+// bidSequence gera a ordem de submissão dos lances (Bid.Sequence)
+// Atribuída em CreateBid, antes do channel - a ordem não depende do batch nem das goroutines de insert
+// Parte do relógio em nanossegundos: após um restart os números continuam maiores que os já gravados
+// Dentro do processo é estritamente crescente, mesmo com duas requests no mesmo nanossegundo
+type bidSequence struct {
+	last atomic.Int64
+}
+
+// next retorna max(último + 1, agora) - CompareAndSwap evita mutex no caminho de toda request
+// No Node.js (single thread) bastaria "return ++counter"; em Go várias goroutines chamam ao mesmo tempo
+func (s *bidSequence) next(now time.Time) int64 {
+	for {
+		last := s.last.Load()
+		next := max(last+1, now.UnixNano())
+		if s.last.CompareAndSwap(last, next) {
+			return next
+		}
+	}
+}
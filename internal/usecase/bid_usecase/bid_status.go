@@ -0,0 +1,157 @@
+package bid_usecase
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/Guilherme-G-Cadilhe/Go-AuctionHouse_Concurrency-Server-Api/configuration/tracing"
+	"github.com/Guilherme-G-Cadilhe/Go-AuctionHouse_Concurrency-Server-Api/internal/entity/bid_entity"
+	"github.com/Guilherme-G-Cadilhe/Go-AuctionHouse_Concurrency-Server-Api/internal/internal_error"
+	"github.com/Guilherme-G-Cadilhe/Go-AuctionHouse_Concurrency-Server-Api/internal/jsontime"
+)
+
+// BidSubmissionStatus é o destino de um lance depois do 201 do POST /bid
+type BidSubmissionStatus string
+
+const (
+	BidPending  BidSubmissionStatus = "pending"  // Ainda no channel/batch (ou aguardando reenvio após failover)
+	BidAccepted BidSubmissionStatus = "accepted" // Gravado pelo flush
+	BidRejected BidSubmissionStatus = "rejected" // Descartado pelo flush - Reason explica
+)
+
+// BidStatusOutputDTO é a resposta do GET /bid/status/:submissionId
+type BidStatusOutputDTO struct {
+	SubmissionId string              `json:"submission_id"`
+	Status       BidSubmissionStatus `json:"status"`
+	Reason       string              `json:"reason,omitempty"`
+	UpdatedAt    jsontime.Time       `json:"updated_at"`
+}
+
+type bidStatusEntry struct {
+	status    BidSubmissionStatus
+	reason    string
+	updatedAt time.Time
+}
+
+// bidStatusTracker guarda por pouco tempo o destino de cada lance submetido (id do lance -> resultado)
+// O POST /bid responde antes do flush: é o caminho de polling para saber se o lance foi gravado
+// Entradas mais antigas que o ttl (BID_STATUS_TTL) são removidas pelo sweep, como no bidCooldown
+type bidStatusTracker struct {
+	ttl       time.Duration // 0 = desabilitado
+	entries   map[string]bidStatusEntry
+	lastSweep time.Time
+	mutex     *sync.Mutex // Atualizado pela request (pending), pelas goroutines do batch (rejected) e pelo flush (accepted)
+}
+
+func newBidStatusTracker(ttl time.Duration, now time.Time) *bidStatusTracker {
+	return &bidStatusTracker{
+		ttl:       ttl,
+		entries:   make(map[string]bidStatusEntry),
+		lastSweep: now,
+		mutex:     &sync.Mutex{},
+	}
+}
+
+func (bt *bidStatusTracker) enabled() bool {
+	return bt.ttl > 0
+}
+
+// pending registra o lance ao entrar no channel - antes do envio, para o flush sempre encontrá-lo
+func (bt *bidStatusTracker) pending(bidId string, now time.Time) {
+	bt.set(bidId, BidPending, "", now)
+}
+
+// reject marca o lance como descartado com o motivo
+func (bt *bidStatusTracker) reject(bidId, reason string, now time.Time) {
+	bt.set(bidId, BidRejected, reason, now)
+}
+
+// settle fecha o resultado do batch: lances ainda pendentes foram gravados, exceto os devolvidos
+// por failover, que continuam pendentes até o reenvio
+// As rejeições já chegaram pelo OnBidRejected durante o CreateBidBatch
+func (bt *bidStatusTracker) settle(batch, failoverBids []bid_entity.Bid, now time.Time) {
+	if !bt.enabled() {
+		return
+	}
+
+	requeued := make(map[string]struct{}, len(failoverBids))
+	for _, bid := range failoverBids {
+		requeued[bid.Id] = struct{}{}
+	}
+
+	bt.mutex.Lock()
+	defer bt.mutex.Unlock()
+	for _, bid := range batch {
+		if _, ok := requeued[bid.Id]; ok {
+			continue
+		}
+		if entry, ok := bt.entries[bid.Id]; ok && entry.status == BidPending {
+			bt.entries[bid.Id] = bidStatusEntry{status: BidAccepted, updatedAt: now}
+		}
+	}
+	bt.sweep(now)
+}
+
+func (bt *bidStatusTracker) set(bidId string, status BidSubmissionStatus, reason string, now time.Time) {
+	if !bt.enabled() {
+		return
+	}
+
+	bt.mutex.Lock()
+	defer bt.mutex.Unlock()
+	bt.entries[bidId] = bidStatusEntry{status: status, reason: reason, updatedAt: now}
+	bt.sweep(now)
+}
+
+// get retorna o resultado ainda dentro do ttl
+func (bt *bidStatusTracker) get(bidId string, now time.Time) (bidStatusEntry, bool) {
+	bt.mutex.Lock()
+	defer bt.mutex.Unlock()
+
+	entry, ok := bt.entries[bidId]
+	if !ok || !now.Before(entry.updatedAt.Add(bt.ttl)) {
+		return bidStatusEntry{}, false
+	}
+	return entry, true
+}
+
+// sweep remove entradas expiradas - executa no máximo uma vez por ttl
+// Deve ser chamado com o mutex travado
+func (bt *bidStatusTracker) sweep(now time.Time) {
+	if now.Sub(bt.lastSweep) < bt.ttl {
+		return
+	}
+	bt.lastSweep = now
+
+	for bidId, entry := range bt.entries {
+		if !now.Before(entry.updatedAt.Add(bt.ttl)) {
+			delete(bt.entries, bidId)
+		}
+	}
+}
+
+// FindBidStatus retorna o destino de um lance submetido (pending, accepted ou rejected com o motivo)
+// O submissionId é o id do lance devolvido pelo POST /bid
+// Desconhecido, expirado (BID_STATUS_TTL) ou com o acompanhamento desabilitado: 404
+func (bu *BidUseCase) FindBidStatus(ctx context.Context, submissionId string) (*BidStatusOutputDTO, *internal_error.InternalError) {
+	_, span := tracing.Start(ctx, "BidUseCase.FindBidStatus")
+	defer span.End()
+
+	if !bu.statuses.enabled() {
+		return nil, internal_error.NewNotFoundError("bid status tracking is disabled")
+	}
+
+	entry, ok := bu.statuses.get(submissionId, bu.clock.Now())
+	if !ok {
+		return nil, internal_error.NewNotFoundError(fmt.Sprintf("bid submission %s not found or expired", submissionId))
+	}
+
+	return &BidStatusOutputDTO{
+		SubmissionId: submissionId,
+		Status:       entry.status,
+		Reason:       entry.reason,
+		UpdatedAt:    jsontime.New(entry.updatedAt),
+	}, nil
+}
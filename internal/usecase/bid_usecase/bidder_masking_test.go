@@ -0,0 +1,104 @@
+package bid_usecase
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/Guilherme-G-Cadilhe/Go-AuctionHouse_Concurrency-Server-Api/internal/auth_context"
+	"github.com/Guilherme-G-Cadilhe/Go-AuctionHouse_Concurrency-Server-Api/internal/bidder_mask"
+	"github.com/Guilherme-G-Cadilhe/Go-AuctionHouse_Concurrency-Server-Api/internal/entity/auction_entity"
+)
+
+const (
+	testAdminId = "33333333-3333-3333-3333-333333333333"
+	testOtherId = "44444444-4444-4444-4444-444444444444"
+)
+
+// listBidUserIds fecha o leilão (sealed só lista depois do fechamento) e devolve o user_id visível para o viewer
+func listBidUserIds(t *testing.T, env *testEnv, auctionId, viewerId string) string {
+	t.Helper()
+	ctx := context.Background()
+	if viewerId != "" {
+		ctx = auth_context.WithUserID(ctx, viewerId)
+	}
+
+	page, err := env.useCase.FindBidByAuctionId(ctx, auctionId, BidListInputDTO{})
+	if err != nil {
+		t.Fatalf("FindBidByAuctionId: %v", err)
+	}
+	if len(page.Bids) != 1 {
+		t.Fatalf("got %d bids, want 1", len(page.Bids))
+	}
+	return page.Bids[0].UserId
+}
+
+func TestBidderMasking(t *testing.T) {
+	tests := []struct {
+		name       string
+		sealed     bool
+		maskAll    bool
+		viewerId   string
+		wantMasked bool
+	}{
+		{"open auction without global mask", false, false, testOtherId, false},
+		{"sealed auction", true, false, testOtherId, true},
+		{"sealed auction anonymous viewer", true, false, "", true},
+		{"global mask", false, true, testOtherId, true},
+		{"bid owner sees the real id", true, true, testBidderId, false},
+		{"admin sees the real id", true, true, testAdminId, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			bidCfg := testBidConfig()
+			bidCfg.MaskBidderIds = tt.maskAll
+			bidCfg.BidderMaskSecret = "secret"
+			bidCfg.AdminUserIds = []string{testAdminId}
+			env := newTestEnv(t, bidCfg)
+			auctionId := env.createAuction(t, func(a *auction_entity.Auction) { a.Sealed = tt.sealed }).Id
+			env.storeBids(t, auctionId, 10)
+			env.clock.Advance(testAuctionConfig().Interval + 1)
+
+			got := listBidUserIds(t, env, auctionId, tt.viewerId)
+			if tt.wantMasked {
+				if !strings.HasPrefix(got, bidder_mask.MaskedUserIdPrefix) || strings.Contains(got, testBidderId) {
+					t.Fatalf("user_id = %q, want a masked id", got)
+				}
+				return
+			}
+			if got != testBidderId {
+				t.Fatalf("user_id = %q, want the real id %q", got, testBidderId)
+			}
+		})
+	}
+}
+
+// O pseudônimo é estável no leilão - entre chamadas e entre a listagem e o vencedor - e muda entre leilões
+func TestBidderMaskingIsStable(t *testing.T) {
+	bidCfg := testBidConfig()
+	bidCfg.MaskBidderIds = true
+	bidCfg.BidderMaskSecret = "secret"
+	env := newTestEnv(t, bidCfg)
+	auctionId := env.createAuction(t, nil).Id
+	otherAuctionId := env.createAuction(t, nil).Id
+	env.storeBids(t, auctionId, 10)
+	env.storeBids(t, otherAuctionId, 10)
+
+	first := listBidUserIds(t, env, auctionId, testOtherId)
+	if second := listBidUserIds(t, env, auctionId, testOtherId); second != first {
+		t.Fatalf("masked id changed between calls: %q then %q", first, second)
+	}
+
+	ctx := auth_context.WithUserID(context.Background(), testOtherId)
+	winning, err := env.useCase.FindWinningBidByAuctionId(ctx, auctionId)
+	if err != nil {
+		t.Fatalf("FindWinningBidByAuctionId: %v", err)
+	}
+	if winning.UserId != first {
+		t.Fatalf("winning bid user_id = %q, want the listing pseudonym %q", winning.UserId, first)
+	}
+
+	if other := listBidUserIds(t, env, otherAuctionId, testOtherId); other == first {
+		t.Fatalf("same pseudonym %q in two auctions - users could be linked across auctions", other)
+	}
+}
@@ -0,0 +1,63 @@
+package bid_usecase
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/Guilherme-G-Cadilhe/Go-AuctionHouse_Concurrency-Server-Api/internal/auth_context"
+	"github.com/Guilherme-G-Cadilhe/Go-AuctionHouse_Concurrency-Server-Api/internal/clock"
+	"github.com/Guilherme-G-Cadilhe/Go-AuctionHouse_Concurrency-Server-Api/internal/entity/bid_entity"
+	"github.com/Guilherme-G-Cadilhe/Go-AuctionHouse_Concurrency-Server-Api/internal/infra/database/memory"
+	"go.uber.org/goleak"
+)
+
+// newRealClockUseCase usa o relógio real: o vazamento que importa é o dos timers do runtime
+func newRealClockUseCase(t *testing.T, interval time.Duration) (*BidUseCase, *memory.BidRepository, string) {
+	t.Helper()
+	auctionCfg := testAuctionConfig()
+	clk := clock.New()
+	auctionRepository := memory.NewAuctionRepository(auctionCfg, clk)
+	bidRepository := memory.NewBidRepository(auctionRepository, auctionCfg, clk)
+	auction := createTestAuction(t, auctionRepository, clk, nil)
+
+	bidCfg := testBidConfig()
+	bidCfg.BatchInsertInterval = interval
+	useCase := NewBidUseCase(bidRepository, auctionRepository, bidCfg, auctionCfg, clk).(*BidUseCase)
+	return useCase, bidRepository, auction.Id
+}
+
+func TestCloseFlushesPendingBidsWithoutLeakingGoroutines(t *testing.T) {
+	defer goleak.VerifyNone(t, goleak.IgnoreCurrent())
+
+	useCase, bidRepository, auctionId := newRealClockUseCase(t, time.Hour)
+	ctx := auth_context.WithUserID(context.Background(), testBidderId)
+	if _, err := useCase.CreateBid(ctx, BidInputDTO{AuctionId: auctionId, Amount: 10}); err != nil {
+		t.Fatalf("CreateBid: %v", err)
+	}
+
+	if err := useCase.Close(context.Background()); err != nil {
+		t.Fatalf("Close: %v", err)
+	}
+
+	bids, _ := bidRepository.FindBidByAuctionId(context.Background(), auctionId, bid_entity.BidFilter{})
+	if len(bids) != 1 {
+		t.Fatalf("got %d stored bids after Close, want the pending bid flushed", len(bids))
+	}
+}
+
+// O timer já disparou e ninguém leu o channel: o Close descarta o valor e a goroutine termina
+func TestCloseAfterTimerFiredLeavesNoGoroutines(t *testing.T) {
+	defer goleak.VerifyNone(t, goleak.IgnoreCurrent())
+
+	useCase, _, _ := newRealClockUseCase(t, time.Millisecond)
+	time.Sleep(20 * time.Millisecond)
+
+	if err := useCase.Close(context.Background()); err != nil {
+		t.Fatalf("Close: %v", err)
+	}
+	// Close é idempotente - a segunda chamada só confirma que a goroutine já terminou
+	if err := useCase.Close(context.Background()); err != nil {
+		t.Fatalf("second Close: %v", err)
+	}
+}
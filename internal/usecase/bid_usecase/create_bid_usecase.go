@@ -2,49 +2,148 @@ package bid_usecase
 
 import (
 	"context"
-	"os"
-	"strconv"
+	"fmt"
+	"sync"
+	"sync/atomic"
 	"time"
 
+	"github.com/Guilherme-G-Cadilhe/Go-AuctionHouse_Concurrency-Server-Api/configuration/config"
 	"github.com/Guilherme-G-Cadilhe/Go-AuctionHouse_Concurrency-Server-Api/configuration/logger"
+	"github.com/Guilherme-G-Cadilhe/Go-AuctionHouse_Concurrency-Server-Api/configuration/tracing"
+	"github.com/Guilherme-G-Cadilhe/Go-AuctionHouse_Concurrency-Server-Api/internal/bidder_mask"
+	"github.com/Guilherme-G-Cadilhe/Go-AuctionHouse_Concurrency-Server-Api/internal/clock"
+	"github.com/Guilherme-G-Cadilhe/Go-AuctionHouse_Concurrency-Server-Api/internal/entity/auction_entity"
 	"github.com/Guilherme-G-Cadilhe/Go-AuctionHouse_Concurrency-Server-Api/internal/entity/bid_entity"
 	"github.com/Guilherme-G-Cadilhe/Go-AuctionHouse_Concurrency-Server-Api/internal/internal_error"
+	"github.com/Guilherme-G-Cadilhe/Go-AuctionHouse_Concurrency-Server-Api/internal/jsontime"
+	"go.uber.org/zap"
 )
 
 type BidInputDTO struct {
-	UserId    string  `json:"user_id"`
-	AuctionId string  `json:"auction_id"`
-	Amount    float64 `json:"amount"`
+	Id        string    `json:"id"`      // Opcional - UUID gerado pelo cliente; reenviar o mesmo id não duplica o lance
+	UserId    string    `json:"user_id"` // Opcional - se enviado deve ser o usuário autenticado
+	Handle    string    `json:"handle"`  // Lance de convidado (sem X-User-Id) - exige GUEST_BIDS_ENABLED e leilão com allow_guest_bids
+	AuctionId string    `json:"auction_id"`
+	Amount    BidAmount `json:"amount"`  // Número ou string ("10.50"); em AMOUNT_MODE=cents deve ser um inteiro (centavos)
+	Confirm   bool      `json:"confirm"` // Confirma um valor acima do BID_MAX_AMOUNT_MULTIPLIER
 }
 type BidOutputDTO struct {
-	Id        string    `json:"id"`
-	UserId    string    `json:"user_id"`
-	AuctionId string    `json:"auction_id"`
-	Amount    float64   `json:"amount"`
-	Timestamp time.Time `json:"timestamp" time_format:"2006-01-02 15:04:05"`
+	Id        string        `json:"id"`
+	UserId    string        `json:"user_id"`
+	AuctionId string        `json:"auction_id"`
+	Amount    float64       `json:"amount"`
+	Timestamp jsontime.Time `json:"timestamp"`
+	Sequence  int64         `json:"sequence,omitempty"` // Ordem de submissão - desempata lances de mesmo valor
+	Handle    string        `json:"handle,omitempty"`   // Apelido dos lances de convidados (user_id vazio)
+
+	SubmissionId string `json:"submission_id,omitempty"` // Preenchido apenas na criação - consulta em GET /bid/status/:submissionId
+
+	Receipt *BidReceiptDTO `json:"receipt,omitempty"` // Preenchido apenas na criação do lance
 }
 
 // BidUseCase implementa BATCH PROCESSING com CHANNELS
 type BidUseCase struct {
 	BidRepository       bid_entity.BidEntityRepository
-	timer               *time.Timer         // Timer para flush periódico
-	maxBatchSize        int                 // Tamanho máximo do batch
-	batchInsertInterval time.Duration       // Intervalo entre flushes
-	bidChannel          chan bid_entity.Bid // CHANNEL para comunicação entre goroutines
+	AuctionRepository   auction_entity.AuctionRepositoryInterface // Consultado para regras de visibilidade (sealed-bid)
+	clock               clock.Clock                               // Relógio dos timestamps e do timer (fake em testes)
+	timer               clock.Timer                               // Timer para flush periódico
+	maxBatchSize        int                                       // Tamanho máximo do batch
+	batchInsertInterval time.Duration                             // Intervalo entre flushes
+	batchIdleInterval   time.Duration                             // Intervalo após flush vazio (0 = timer parado)
+	idle                bool                                      // Último disparo do timer encontrou o batch vazio (só a goroutine do batch acessa)
+	bidChannel          chan bid_entity.Bid                       // CHANNEL para comunicação entre goroutines
+	rateLimiter         *bidRateLimiter                           // Limite de lances por usuário/leilão
+	guestBidsEnabled    bool                                      // GUEST_BIDS_ENABLED - lances de convidados nos leilões que os aceitam
+	cooldown            *bidCooldown                              // Intervalo mínimo entre lances do usuário no leilão
+	receiptSecret       string                                    // Segredo do HMAC dos comprovantes
+	bidderMasker        *bidder_mask.Masker                       // Anonimização do user_id nas listagens
+	sequence            bidSequence                               // Ordem de submissão dos lances (desempate do vencedor)
+	quoteRules          bidQuoteRules                             // Regras do flush repetidas pelo POST /bid/quote
+	statuses            *bidStatusTracker                         // Destino de cada lance submetido (GET /bid/status)
+	amountGuard         bidAmountGuard                            // Lances muito acima da referência exigem confirm (BID_MAX_AMOUNT_MULTIPLIER)
+	nearEnd             *nearEndFlush                             // Flush antecipado de lances de leilões prestes a fechar (BATCH_NEAR_END_FLUSH)
+	reloads             chan batchSettings                        // Novos parâmetros do batch recebidos no SIGHUP (ver batch_reload.go)
+
+	// Escalonamento de falhas do batch: após N flushes seguidos com erro o serviço fica "degraded"
+	// consecutiveFlushFailures só é acessado pela goroutine do batch; degraded é lido pelo /health
+	consecutiveFlushFailures int
+	flushFailureThreshold    int
+	degraded                 atomic.Bool
+	slowFlushThreshold       time.Duration // Flushes acima disso geram warning (0 = desligado)
+
+	// Failover do MongoDB: lances perdidos voltam ao batch e os flushes pausam por failoverBackoff
+	// Só a goroutine do batch acessa (ver batch_failover.go)
+	failoverBackoff     time.Duration
+	failoverMaxRequeues int
+	requeueAttempts     map[string]int // Id do lance -> vezes que já voltou ao batch
+	flushPausedUntil    time.Time
+
+	// Espelhos atômicos do estado do batch para o GET /internal/queue
+	// Escritos só pela goroutine do batch; lidos pelos handlers HTTP
+	batchSize   atomic.Int64
+	lastFlushAt atomic.Int64 // UnixNano do último flush (0 = nenhum flush ainda)
+
+	// Batida da goroutine do batch para o GET /readyz (ver batch_heartbeat.go)
+	heartbeat     *batchHeartbeat
+	lastHeartbeat atomic.Int64 // UnixNano da última volta do select
+
+	// Encerramento: Close fecha stop e a goroutine do batch fecha done ao terminar
+	// closeMu protege closed - CreateBid envia ao channel sob RLock, então nenhum envio acontece após o Close
+	closeMu   sync.RWMutex
+	closed    bool
+	closeOnce sync.Once
+	stop      chan struct{}
+	done      chan struct{}
 }
 
-func NewBidUseCase(bidRepository bid_entity.BidEntityRepository) BidUseCaseInterface {
-	maxSizeInterval := getMaxBatchSizeInterval()
-	maxBatchSize := getMaxBatchSize()
+// auctionCfg traz as regras de leilão que a cotação (POST /bid/quote) repete de forma síncrona
+func NewBidUseCase(bidRepository bid_entity.BidEntityRepository, auctionRepository auction_entity.AuctionRepositoryInterface, cfg config.BidConfig, auctionCfg config.AuctionConfig, clk clock.Clock) BidUseCaseInterface {
+	// Lances esperam até BATCH_INSERT_INTERVAL no buffer - próximo da duração do leilão, os últimos lances
+	// chegariam ao flush com o leilão já fechado. O BATCH_NEAR_END_FLUSH cobre isso; o warning aponta a configuração
+	if cfg.BatchInsertInterval*2 > auctionCfg.Interval {
+		logger.Warn("BATCH_INSERT_INTERVAL is not comfortably smaller than AUCTION_INTERVAL, late bids may reach the flush after the auction closed",
+			zap.Duration("batch_insert_interval", cfg.BatchInsertInterval),
+			zap.Duration("auction_interval", auctionCfg.Interval),
+			zap.Bool("near_end_flush", cfg.NearEndFlushWindow > 0))
+	}
 
 	bidUseCase := &BidUseCase{
+		clock:               clk,
 		BidRepository:       bidRepository,
-		maxBatchSize:        maxBatchSize,
-		batchInsertInterval: maxSizeInterval,
-		timer:               time.NewTimer(maxSizeInterval),
+		AuctionRepository:   auctionRepository,
+		maxBatchSize:        cfg.MaxBatchSize,
+		batchInsertInterval: cfg.BatchInsertInterval,
+		batchIdleInterval:   cfg.BatchIdleInterval,
+		timer:               clk.NewTimer(cfg.BatchInsertInterval),
 		// BUFFERED CHANNEL - pode armazenar N elementos sem bloquear
 		// Similar a uma queue com capacidade limitada
-		bidChannel: make(chan bid_entity.Bid, maxBatchSize),
+		bidChannel:       make(chan bid_entity.Bid, cfg.MaxBatchSize),
+		rateLimiter:      newBidRateLimiter(cfg.MaxBidsPerWindow, cfg.RateWindow, clk.Now()),
+		cooldown:         newBidCooldown(cfg.Cooldown, clk.Now()),
+		guestBidsEnabled: cfg.GuestBidsEnabled,
+		receiptSecret:    cfg.ReceiptSecret,
+		bidderMasker:     bidder_mask.NewMasker(cfg),
+		quoteRules:       newBidQuoteRules(auctionCfg),
+		statuses:         newBidStatusTracker(cfg.StatusTTL, clk.Now()),
+		amountGuard:      newBidAmountGuard(cfg.MaxAmountMultiplier, auctionCfg.StartingPrice),
+		nearEnd:          newNearEndFlush(cfg.NearEndFlushWindow, clk),
+		reloads:          make(chan batchSettings),
+		heartbeat:        newBatchHeartbeat(cfg.BatchStallTimeout, clk),
+
+		flushFailureThreshold: cfg.BatchFailureThreshold,
+		slowFlushThreshold:    cfg.SlowFlushThreshold,
+		failoverBackoff:       cfg.FailoverBackoff,
+		failoverMaxRequeues:   cfg.FailoverMaxRequeues,
+
+		stop: make(chan struct{}),
+		done: make(chan struct{}),
+	}
+
+	// Lances descartados pelo flush viram "rejected" no GET /bid/status
+	if bidUseCase.statuses.enabled() {
+		bidRepository.OnBidRejected(func(bid bid_entity.Bid, reason string) {
+			bidUseCase.statuses.reject(bid.Id, reason, clk.Now())
+		})
 	}
 
 	// Inicia goroutine de processamento em background
@@ -54,82 +153,330 @@ func NewBidUseCase(bidRepository bid_entity.BidEntityRepository) BidUseCaseInter
 }
 
 type BidUseCaseInterface interface {
-	CreateBid(ctx context.Context, bidInputDto BidInputDTO) *internal_error.InternalError
-	FindBidByAuctionId(ctx context.Context, auctionId string) ([]BidOutputDTO, *internal_error.InternalError)
+	CreateBid(ctx context.Context, bidInputDto BidInputDTO) (*BidOutputDTO, *internal_error.InternalError)
+	QuoteBid(ctx context.Context, bidInputDto BidInputDTO) (*BidQuoteOutputDTO, *internal_error.InternalError)
+	FindBidByAuctionId(ctx context.Context, auctionId string, input BidListInputDTO) (*BidPageOutputDTO, *internal_error.InternalError)
 	FindWinningBidByAuctionId(ctx context.Context, auctionId string) (*BidOutputDTO, *internal_error.InternalError)
+	FindBidStatus(ctx context.Context, submissionId string) (*BidStatusOutputDTO, *internal_error.InternalError)
+	IsDegraded() bool
+	IsStalled() bool
+	QueueStats() QueueStatsOutputDTO
+	Close(ctx context.Context) *internal_error.InternalError
+	// ReloadBatchConfig aplica os parâmetros do batch que mudam sem restart (SIGHUP)
+	ReloadBatchConfig(cfg config.BidConfig)
 }
 
-// Variável GLOBAL para batch atual (shared entre goroutines)
-var bidBatch []bid_entity.Bid
-
 // triggerCreateRoutine roda em background processando lances em batches
 // Esta é uma GOROUTINE DE LONGA DURAÇÃO (long-running goroutine)
 func (bu *BidUseCase) triggerCreateRoutine(ctx context.Context) {
-	// defer close() garante que channel seja fechado ao sair
+	// defer close(done) avisa o Close que o flush final terminou
+	// O bidChannel não é fechado: um CreateBid concorrente causaria panic ao enviar para channel fechado
 	go func() {
-		defer close(bu.bidChannel)
+		defer close(bu.done)
+
+		// Batch atual - variável LOCAL da goroutine, que é a única a ler e escrever nele
+		// Sem lock: cada BidUseCase tem seu próprio batch (antes era global e compartilhado entre instâncias)
+		// Quem precisa do tamanho fora daqui lê o batchSize atômico
+		var bidBatch []bid_entity.Bid
 
 		// LOOP INFINITO processando eventos
 		for {
+			// Cada volta do loop é uma batida: um evento preso (flush travado) para de atualizá-la
+			bu.beat()
+
 			// SELECT - similar ao switch, mas para channels
 			// Espera até um dos cases estar pronto
 			select {
 			// CASE 1: Recebeu novo lance do channel
-			case bidEntity, ok := <-bu.bidChannel:
-				// ok = false significa que channel foi fechado
-				if !ok {
-					// Flush final dos lances restantes
-					if len(bidBatch) > 0 {
-						if err := bu.BidRepository.CreateBidBatch(ctx, bidBatch); err != nil {
-							logger.Error("[A] error trying to create bid batch on goroutine", err)
-						}
-					}
-					return // Termina goroutine
-				}
-
+			case bidEntity := <-bu.bidChannel:
 				// Adiciona lance ao batch atual
 				bidBatch = append(bidBatch, bidEntity)
+				bu.batchSize.Store(int64(len(bidBatch)))
+
+				// Saindo do modo ocioso: volta ao intervalo normal para manter a latência de flush
+				if bu.idle {
+					bu.idle = false
+					bu.timer.Reset(bu.batchInsertInterval)
+				}
 
-				// Se batch atingiu tamanho máximo, processa imediatamente
-				if len(bidBatch) >= bu.maxBatchSize {
-					if err := bu.BidRepository.CreateBidBatch(ctx, bidBatch); err != nil {
+				// Leilão do lance dentro da janela do BATCH_NEAR_END_FLUSH: grava agora (fora dela, só arma o timer)
+				nearEnd := bu.nearEnd.armFor(bidEntity, bu.clock.Now())
+
+				// Se batch atingiu tamanho máximo (ou o leilão está para fechar), processa imediatamente
+				// Durante a pausa de failover o batch só acumula - o timer faz o flush quando ela acabar
+				if (len(bidBatch) >= bu.maxBatchSize || nearEnd) && !bu.flushPaused() {
+					failoverBids, err := bu.flushBatch(ctx, bidBatch)
+					if err != nil {
 						logger.Error("[B] error trying to create bid batch on goroutine", err)
 					}
+					bu.recordFlushResult(err)
 					// bidBatch = []bid_entity.Bid{}
 					// Limpa batch (bidBatch = nil é mais eficiente que slice vazio)
-					bidBatch = nil
+					// mantendo apenas os lances perdidos no failover
+					bidBatch = bu.requeueFailoverBids(failoverBids)
+					bu.batchSize.Store(int64(len(bidBatch)))
 					// Reset timer para próximo intervalo
-					bu.timer.Reset(bu.batchInsertInterval)
+					bu.timer.Reset(bu.nextFlushDelay())
 				}
 
 				// CASE 2: Timer expirou (intervalo de tempo passou)
-			case <-bu.timer.C:
+			case <-bu.timer.C():
 				// Processa batch atual mesmo que não esteja cheio
-				if err := bu.BidRepository.CreateBidBatch(ctx, bidBatch); err != nil {
+				// Batch vazio não conta como flush bem-sucedido (não zera o contador de falhas)
+				if len(bidBatch) == 0 {
+					// Sistema ocioso: evita acordar a cada batchInsertInterval sem nada a fazer
+					// Com BATCH_IDLE_INTERVAL=0 o timer só volta a ser armado quando chegar um lance
+					bu.idle = true
+					if bu.batchIdleInterval > 0 {
+						bu.timer.Reset(bu.batchIdleInterval)
+					}
+					continue
+				}
+				if bu.flushPaused() {
+					bu.timer.Reset(bu.nextFlushDelay())
+					continue
+				}
+
+				failoverBids, err := bu.flushBatch(ctx, bidBatch)
+				if err != nil {
 					logger.Error("[C] error trying to create bid batch on goroutine", err)
 				}
+				bu.recordFlushResult(err)
 				// bidBatch = []bid_entity.Bid{}
-				bidBatch = nil
-				bu.timer.Reset(bu.batchInsertInterval)
+				bidBatch = bu.requeueFailoverBids(failoverBids)
+				bu.batchSize.Store(int64(len(bidBatch)))
+				bu.timer.Reset(bu.nextFlushDelay())
+
+				// CASE 3: algum lance do batch é de um leilão que entrou na janela do BATCH_NEAR_END_FLUSH
+				// Não reseta o timer do batch: o próximo flush regular segue no horário
+			case <-bu.nearEnd.timerC():
+				bu.nearEnd.fired()
+				if len(bidBatch) == 0 || bu.flushPaused() {
+					continue
+				}
+
+				failoverBids, err := bu.flushBatch(ctx, bidBatch)
+				if err != nil {
+					logger.Error("[D] error trying to create bid batch on near-end flush", err)
+				}
+				bu.recordFlushResult(err)
+				bidBatch = bu.requeueFailoverBids(failoverBids)
+				bu.batchSize.Store(int64(len(bidBatch)))
+
+				// CASE 4: SIGHUP recarregou a configuração - troca os parâmetros entre dois eventos
+			case settings := <-bu.reloads:
+				bu.applyBatchSettings(settings, len(bidBatch))
+
+				// CASE 5: batida periódica - acorda o loop ocioso só para provar que ele está vivo
+			case <-bu.heartbeat.timerC():
+				bu.heartbeat.rearm()
+
+				// CASE 6: Close foi chamado - flush final e fim da goroutine
+			case <-bu.stop:
+				bu.shutdownBatch(ctx, bidBatch)
+				return // Termina goroutine
 			}
 		}
 
 	}()
 }
 
+// shutdownBatch para o timer e grava o que ainda estiver pendente
+// Roda na goroutine do batch, que é a única dona do bidBatch (recebido aqui) e do timer
+func (bu *BidUseCase) shutdownBatch(ctx context.Context, bidBatch []bid_entity.Bid) {
+	// Stop retorna false quando o timer já disparou: o valor fica parado no channel do timer
+	// e é descartado aqui - nada fica esperando um disparo que ninguém mais vai ler
+	if !bu.timer.Stop() {
+		select {
+		case <-bu.timer.C():
+		default:
+		}
+	}
+	bu.nearEnd.stop()
+	bu.heartbeat.stop()
+
+	// Lances aceitos antes do Close que ainda estão no buffer do channel
+	// Sem bloquear: após o Close nenhum CreateBid envia mais nada
+	drainStart := bu.clock.Now()
+	drained := 0
+	for pending := true; pending; {
+		select {
+		case bidEntity := <-bu.bidChannel:
+			bidBatch = append(bidBatch, bidEntity)
+			drained++
+		default:
+			pending = false
+		}
+	}
+	logger.ShutdownPhase("draining bid channel", bu.clock.Now().Sub(drainStart), nil,
+		zap.Int("drained", drained),
+		zap.Int("pending", len(bidBatch)))
+
+	// No encerramento não há próximo ciclo: a pausa de failover é ignorada e lances perdidos não voltam ao batch
+	if len(bidBatch) > 0 {
+		flushStart := bu.clock.Now()
+		failoverBids, err := bu.flushBatch(ctx, bidBatch)
+		var flushErr error
+		if err != nil {
+			flushErr = err
+		}
+		logger.ShutdownPhase("flushing final batch", bu.clock.Now().Sub(flushStart), flushErr,
+			zap.Int("batch_size", len(bidBatch)),
+			zap.Int("failover_bids", len(failoverBids)))
+		if err != nil {
+			logger.Error("[A] error trying to create bid batch on shutdown", err)
+		}
+		if len(failoverBids) > 0 {
+			logger.Warn("bids lost during MongoDB failover on shutdown", zap.Int("bids", len(failoverBids)))
+			for _, bid := range failoverBids {
+				bu.statuses.reject(bid.Id, bid_entity.RejectStorageFailure, bu.clock.Now())
+			}
+		}
+		bu.recordFlushResult(err)
+		bu.batchSize.Store(0)
+	}
+}
+
+// Close encerra a goroutine do batch: recusa novos lances, para o timer e grava os lances pendentes
+// Pode ser chamado mais de uma vez; espera o flush final até o deadline do ctx
+func (bu *BidUseCase) Close(ctx context.Context) *internal_error.InternalError {
+	bu.closeOnce.Do(func() {
+		// Write lock espera os CreateBid que já estão enviando ao channel
+		bu.closeMu.Lock()
+		bu.closed = true
+		bu.closeMu.Unlock()
+		close(bu.stop)
+	})
+
+	select {
+	case <-bu.done:
+		return nil
+	case <-ctx.Done():
+		return internal_error.NewTimeoutError("timed out waiting for pending bids to be flushed")
+	}
+}
+
+// flushBatch grava o batch e mede a duração do flush
+// Flushes acima de SLOW_FLUSH_THRESHOLD geram um warning com tamanho e duração,
+// para correlacionar picos de latência com batches grandes ou lentidão do banco
+// Retorna também os lances que o repositório devolveu por failover
+func (bu *BidUseCase) flushBatch(ctx context.Context, batch []bid_entity.Bid) ([]bid_entity.Bid, *internal_error.InternalError) {
+	start := bu.clock.Now()
+	failoverBids, err := bu.BidRepository.CreateBidBatch(ctx, batch)
+	elapsed := bu.clock.Now().Sub(start)
+	bu.statuses.settle(batch, failoverBids, bu.clock.Now())
+
+	if bu.slowFlushThreshold > 0 && elapsed > bu.slowFlushThreshold {
+		logger.Warn("slow bid batch flush",
+			zap.Int("batch_size", len(batch)),
+			zap.Duration("elapsed", elapsed),
+			zap.Duration("threshold", bu.slowFlushThreshold),
+			zap.Bool("failed", err != nil))
+	}
+	return failoverBids, err
+}
+
+// recordFlushResult atualiza o horário do último flush e o contador de falhas consecutivas
+// Ao atingir o limite marca o serviço como degraded; um flush com sucesso restaura
+func (bu *BidUseCase) recordFlushResult(err *internal_error.InternalError) {
+	bu.lastFlushAt.Store(bu.clock.Now().UnixNano())
+
+	if err == nil {
+		bu.consecutiveFlushFailures = 0
+		bu.degraded.Store(false)
+		return
+	}
+
+	bu.consecutiveFlushFailures++
+	if bu.consecutiveFlushFailures >= bu.flushFailureThreshold && !bu.degraded.Load() {
+		bu.degraded.Store(true)
+		logger.Error(fmt.Sprintf("bid batch flush failed %d times in a row, marking service as degraded", bu.consecutiveFlushFailures), err)
+	}
+}
+
+// IsDegraded indica se o batch de lances está falhando persistentemente
+// Leitura atômica - segura para ser chamada pelo handler do /health
+func (bu *BidUseCase) IsDegraded() bool {
+	return bu.degraded.Load()
+}
+
 // CreateBid é ASSÍNCRONO - não espera processamento completar
-func (bu *BidUseCase) CreateBid(ctx context.Context, bidInputDto BidInputDTO) *internal_error.InternalError {
-	// Cria entidade de lance
-	bidEntity, err := bid_entity.CreateBid(bidInputDto.UserId, bidInputDto.AuctionId, bidInputDto.Amount)
+// Retorna o lance aceito com o comprovante (receipt) assinado pelo servidor
+func (bu *BidUseCase) CreateBid(ctx context.Context, bidInputDto BidInputDTO) (*BidOutputDTO, *internal_error.InternalError) {
+	ctx, span := tracing.Start(ctx, "BidUseCase.CreateBid")
+	defer span.End()
+
+	// Cria entidade de lance - de convidado (apelido) ou do usuário autenticado
+	var bidEntity *bid_entity.Bid
+	var err *internal_error.InternalError
+	if bidInputDto.Handle != "" {
+		bidEntity, err = bu.createGuestBid(ctx, bidInputDto)
+	} else {
+		bidEntity, err = bu.createUserBid(ctx, bidInputDto)
+	}
 	if err != nil {
-		return err
+		return nil, err
+	}
+
+	// Regra de domínio: valor muito acima da referência só com confirmação (BID_MAX_AMOUNT_MULTIPLIER)
+	// Antes do cooldown - um lance recusado aqui não consome o intervalo do usuário
+	if err := bu.checkAmountGuard(ctx, bidEntity, bidInputDto.Confirm); err != nil {
+		return nil, err
 	}
 
+	// Regra de domínio: espera mínima entre lances do mesmo usuário no mesmo leilão (BID_COOLDOWN)
+	if retryAfter, ok := bu.cooldown.reserve(bidEntity.BidderKey(), bidEntity.AuctionId, bidEntity.Timestamp); !ok {
+		return nil, internal_error.NewTooManyRequestsErrorRetryAfter(
+			fmt.Sprintf("bid cooldown: wait %s before bidding again on this auction", retryAfter.Round(time.Millisecond)),
+			retryAfter)
+	}
+
+	// Regra de domínio: bloqueia rajadas do mesmo usuário no mesmo leilão
+	if !bu.rateLimiter.allow(bidEntity.BidderKey(), bidEntity.AuctionId, bidEntity.Timestamp) {
+		return nil, internal_error.NewTooManyRequestsError("too many bids for this auction, please slow down")
+	}
+
+	// Fim do leilão no cache antes do envio - a goroutine do batch decide o flush antecipado sem ir ao banco
+	bu.loadEndTime(ctx, bidEntity.AuctionId, bidEntity.Timestamp)
+
+	// A ordem de submissão é fixada aqui, ainda na request - o processamento depois é assíncrono e paralelo
+	bidEntity.Sequence = bu.sequence.next(bidEntity.Timestamp)
+
 	// ENVIA para channel (operação não-bloqueante se channel tem buffer)
 	// Equivale a uma queue.push() assíncrono
+	// Sob RLock: depois do Close nenhum lance entra no channel sem ser gravado pelo flush final
+	bu.closeMu.RLock()
+	if bu.closed {
+		bu.closeMu.RUnlock()
+		return nil, internal_error.NewServiceUnavailableError("server is shutting down, bid not accepted")
+	}
+	// Registrado antes do envio: o flush pode processar o lance antes de o CreateBid retornar
+	bu.statuses.pending(bidEntity.Id, bidEntity.Timestamp)
 	bu.bidChannel <- *bidEntity
+	bu.closeMu.RUnlock()
+
 	// Retorna IMEDIATAMENTE - não espera processamento
-	return nil
+	// O comprovante prova o horário de submissão, não a persistência do lance
+	return &BidOutputDTO{
+		Id:        bidEntity.Id,
+		UserId:    bidEntity.UserId,
+		Handle:    bidEntity.Handle,
+		AuctionId: bidEntity.AuctionId,
+		Amount:    bidEntity.Amount,
+		Timestamp: jsontime.New(bidEntity.Timestamp),
+		Sequence:  bidEntity.Sequence,
+		Receipt:   newBidReceipt(bu.receiptSecret, bidEntity),
+
+		SubmissionId: bu.submissionId(bidEntity.Id),
+	}, nil
+}
+
+// submissionId é o id do lance quando o acompanhamento (BID_STATUS_TTL) está ligado
+func (bu *BidUseCase) submissionId(bidId string) string {
+	if !bu.statuses.enabled() {
+		return ""
+	}
+	return bidId
 }
 
 /*
@@ -172,23 +519,3 @@ BENEFÍCIOS:
 - Eficiência (batch inserts)
 - Tolerância a picos de tráfego
 */
-
-func getMaxBatchSizeInterval() time.Duration {
-	batchInsertInterval := os.Getenv("BATCH_INSERT_INTERVAL")
-	duration, err := time.ParseDuration(batchInsertInterval)
-
-	if err != nil {
-		return 3 * time.Minute
-	}
-
-	return duration
-}
-
-func getMaxBatchSize() int {
-	batchSize := os.Getenv("MAX_BATCH_SIZE")
-	batchSizeInt, err := strconv.Atoi(batchSize)
-	if err != nil {
-		return 5
-	}
-	return batchSizeInt
-}
@@ -2,32 +2,139 @@ package bid_usecase
 
 import (
 	"context"
+	"fmt"
+	"time"
 
+	"github.com/Guilherme-G-Cadilhe/Go-AuctionHouse_Concurrency-Server-Api/configuration/tracing"
+	"github.com/Guilherme-G-Cadilhe/Go-AuctionHouse_Concurrency-Server-Api/internal/entity/auction_entity"
+	"github.com/Guilherme-G-Cadilhe/Go-AuctionHouse_Concurrency-Server-Api/internal/entity/bid_entity"
 	"github.com/Guilherme-G-Cadilhe/Go-AuctionHouse_Concurrency-Server-Api/internal/internal_error"
+	"github.com/Guilherme-G-Cadilhe/Go-AuctionHouse_Concurrency-Server-Api/internal/jsontime"
 )
 
-func (bu *BidUseCase) FindBidByAuctionId(ctx context.Context, auctionId string) ([]BidOutputDTO, *internal_error.InternalError) {
-	bidList, err := bu.BidRepository.FindBidByAuctionId(ctx, auctionId)
+// checkBidsVisibility bloqueia a leitura de lances de leilões sealed-bid ainda ativos
+// Retorna o leilão para que a anonimização do user_id use suas regras
+func (bu *BidUseCase) checkBidsVisibility(ctx context.Context, auctionId string) (*auction_entity.Auction, *internal_error.InternalError) {
+	auction, err := bu.AuctionRepository.FindAuctionById(ctx, auctionId)
 	if err != nil {
 		return nil, err
 	}
 
+	if auction.BidsAreHidden() {
+		return nil, internal_error.NewForbiddenError(fmt.Sprintf("bids of sealed auction %s are hidden until it closes", auctionId))
+	}
+	return auction, nil
+}
+
+const (
+	DefaultBidPageSize = 50
+	MaxBidPageSize     = 500
+)
+
+// BidListInputDTO reúne os filtros do GET /bid/:auctionId; valores zero não filtram
+// After (token nextCursor) e Limit ativam a paginação por cursor; sem eles a lista vem inteira
+type BidListInputDTO struct {
+	Since     time.Time
+	MinAmount float64
+	After     string
+	Limit     int
+}
+
+// Paginated indica se o cliente pediu a paginação por cursor
+func (input BidListInputDTO) Paginated() bool {
+	return input.After != "" || input.Limit > 0
+}
+
+// BidPageOutputDTO é uma página do histórico de lances
+// NextCursor vazio indica a última página
+type BidPageOutputDTO struct {
+	Bids       []BidOutputDTO `json:"bids"`
+	NextCursor string         `json:"nextCursor,omitempty"`
+}
+
+func (bu *BidUseCase) FindBidByAuctionId(ctx context.Context, auctionId string, input BidListInputDTO) (*BidPageOutputDTO, *internal_error.InternalError) {
+	ctx, span := tracing.Start(ctx, "BidUseCase.FindBidByAuctionId")
+	defer span.End()
+
+	filter, err := bidListFilter(input)
+	if err != nil {
+		return nil, err
+	}
+
+	auction, err := bu.checkBidsVisibility(ctx, auctionId)
+	if err != nil {
+		return nil, err
+	}
+
+	bidList, err := bu.BidRepository.FindBidByAuctionId(ctx, auctionId, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	// Um lance a mais que o limite indica que existe próxima página - sem consulta extra de contagem
+	var nextCursor string
+	if input.Paginated() && len(bidList) > input.Limit {
+		bidList = bidList[:input.Limit]
+		nextCursor = bid_entity.NewBidCursor(bidList[len(bidList)-1]).Encode()
+	}
+
 	bidOutputList := make([]BidOutputDTO, len(bidList))
 	for i, bid := range bidList {
 		bidOutputList[i] = BidOutputDTO{
 			Id:        bid.Id,
-			UserId:    bid.UserId,
+			UserId:    bu.bidderMasker.VisibleUserId(ctx, auction, bid.UserId),
+			Handle:    bu.bidderMasker.VisibleHandle(ctx, auction, bid.Handle),
 			AuctionId: bid.AuctionId,
 			Amount:    bid.Amount,
-			Timestamp: bid.Timestamp,
+			Timestamp: jsontime.New(bid.Timestamp),
+			Sequence:  bid.Sequence,
 		}
 	}
 
-	return bidOutputList, nil
+	return &BidPageOutputDTO{Bids: bidOutputList, NextCursor: nextCursor}, nil
 
 }
 
+// bidListFilter valida a paginação e monta o filtro do repositório
+// Pede Limit+1 lances para descobrir se há próxima página
+func bidListFilter(input BidListInputDTO) (bid_entity.BidFilter, *internal_error.InternalError) {
+	filter := bid_entity.BidFilter{
+		Since:     input.Since,
+		MinAmount: input.MinAmount,
+	}
+	if !input.Paginated() {
+		return filter, nil
+	}
+
+	if input.Limit < 1 || input.Limit > MaxBidPageSize {
+		return filter, internal_error.NewBadRequestError("invalid query params", internal_error.Cause{
+			Field:   "limit",
+			Message: fmt.Sprintf("limit must be between 1 and %d", MaxBidPageSize),
+		})
+	}
+	if input.After != "" {
+		cursor, errCursor := bid_entity.DecodeBidCursor(input.After)
+		if errCursor != nil {
+			return filter, internal_error.NewBadRequestError("invalid query params", internal_error.Cause{
+				Field:   "after",
+				Message: errCursor.Error(),
+			})
+		}
+		filter.After = cursor
+	}
+	filter.Limit = input.Limit + 1
+	return filter, nil
+}
+
 func (bu *BidUseCase) FindWinningBidByAuctionId(ctx context.Context, auctionId string) (*BidOutputDTO, *internal_error.InternalError) {
+	ctx, span := tracing.Start(ctx, "BidUseCase.FindWinningBidByAuctionId")
+	defer span.End()
+
+	auction, err := bu.checkBidsVisibility(ctx, auctionId)
+	if err != nil {
+		return nil, err
+	}
+
 	bid, err := bu.BidRepository.FindWinningBidByAuctionId(ctx, auctionId)
 	if err != nil {
 		return nil, err
@@ -35,9 +142,11 @@ func (bu *BidUseCase) FindWinningBidByAuctionId(ctx context.Context, auctionId s
 
 	return &BidOutputDTO{
 		Id:        bid.Id,
-		UserId:    bid.UserId,
+		UserId:    bu.bidderMasker.VisibleUserId(ctx, auction, bid.UserId),
+		Handle:    bu.bidderMasker.VisibleHandle(ctx, auction, bid.Handle),
 		AuctionId: bid.AuctionId,
 		Amount:    bid.Amount,
-		Timestamp: bid.Timestamp,
+		Timestamp: jsontime.New(bid.Timestamp),
+		Sequence:  bid.Sequence,
 	}, nil
 }
@@ -0,0 +1,67 @@
+package bid_usecase
+
+import (
+	"context"
+	"testing"
+
+	"github.com/Guilherme-G-Cadilhe/Go-AuctionHouse_Concurrency-Server-Api/internal/entity/auction_entity"
+)
+
+func TestSealedBidsHiddenWhileActive(t *testing.T) {
+	env := newTestEnv(t, testBidConfig())
+	auction := env.createAuction(t, func(a *auction_entity.Auction) { a.Sealed = true })
+	env.storeBids(t, auction.Id, 10, 20)
+
+	if _, err := env.useCase.FindBidByAuctionId(context.Background(), auction.Id, BidListInputDTO{}); err == nil || err.Err != "forbidden" {
+		t.Fatalf("FindBidByAuctionId before close: got %v, want forbidden", err)
+	}
+	if _, err := env.useCase.FindWinningBidByAuctionId(context.Background(), auction.Id); err == nil || err.Err != "forbidden" {
+		t.Fatalf("FindWinningBidByAuctionId before close: got %v, want forbidden", err)
+	}
+}
+
+func TestSealedBidsRevealedAfterClose(t *testing.T) {
+	env := newTestEnv(t, testBidConfig())
+	auction := env.createAuction(t, func(a *auction_entity.Auction) { a.Sealed = true })
+	env.storeBids(t, auction.Id, 10, 20)
+
+	// Passa do EndTime: o timer do repositório fecha o leilão
+	env.clock.Advance(testAuctionConfig().Interval + 1)
+	stored, _ := env.auctions.FindAuctionById(context.Background(), auction.Id)
+	if stored.Status != auction_entity.Completed {
+		t.Fatalf("auction status = %v, want Completed", stored.Status)
+	}
+
+	page, err := env.useCase.FindBidByAuctionId(context.Background(), auction.Id, BidListInputDTO{})
+	if err != nil {
+		t.Fatalf("FindBidByAuctionId after close: %v", err)
+	}
+	if len(page.Bids) != 2 {
+		t.Fatalf("got %d bids after close, want 2", len(page.Bids))
+	}
+
+	winning, err := env.useCase.FindWinningBidByAuctionId(context.Background(), auction.Id)
+	if err != nil {
+		t.Fatalf("FindWinningBidByAuctionId after close: %v", err)
+	}
+	if winning.Amount != 20 {
+		t.Fatalf("winning amount = %v, want 20", winning.Amount)
+	}
+}
+
+func TestOpenAuctionBidsAlwaysVisible(t *testing.T) {
+	env := newTestEnv(t, testBidConfig())
+	auction := env.createAuction(t, nil)
+	env.storeBids(t, auction.Id, 10)
+
+	page, err := env.useCase.FindBidByAuctionId(context.Background(), auction.Id, BidListInputDTO{})
+	if err != nil {
+		t.Fatalf("FindBidByAuctionId: %v", err)
+	}
+	if len(page.Bids) != 1 {
+		t.Fatalf("got %d bids, want 1", len(page.Bids))
+	}
+	if _, err := env.useCase.FindWinningBidByAuctionId(context.Background(), auction.Id); err != nil {
+		t.Fatalf("FindWinningBidByAuctionId: %v", err)
+	}
+}
@@ -0,0 +1,51 @@
+package bid_usecase
+
+import (
+	"context"
+
+	"github.com/Guilherme-G-Cadilhe/Go-AuctionHouse_Concurrency-Server-Api/internal/auth_context"
+	"github.com/Guilherme-G-Cadilhe/Go-AuctionHouse_Concurrency-Server-Api/internal/entity/bid_entity"
+	"github.com/Guilherme-G-Cadilhe/Go-AuctionHouse_Concurrency-Server-Api/internal/internal_error"
+)
+
+// createUserBid é o fluxo padrão: operação protegida, o autor vem do usuário autenticado no context
+func (bu *BidUseCase) createUserBid(ctx context.Context, bidInputDto BidInputDTO) (*bid_entity.Bid, *internal_error.InternalError) {
+	userId, err := auth_context.RequireUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if bidInputDto.UserId != "" && bidInputDto.UserId != userId {
+		return nil, internal_error.NewBadRequestError("user_id does not match the authenticated user")
+	}
+
+	return bid_entity.CreateBid(bidInputDto.Id, userId, bidInputDto.AuctionId, float64(bidInputDto.Amount), bu.clock.Now())
+}
+
+// createGuestBid aceita um lance sem usuário cadastrado, identificado pelo apelido (handle)
+// Exige GUEST_BIDS_ENABLED e um leilão criado com allow_guest_bids
+// Diferente do lance comum, o leilão é lido aqui: a permissão precisa ser respondida na request,
+// não descartada em silêncio no flush do batch
+func (bu *BidUseCase) createGuestBid(ctx context.Context, bidInputDto BidInputDTO) (*bid_entity.Bid, *internal_error.InternalError) {
+	if !bu.guestBidsEnabled {
+		return nil, internal_error.NewBadRequestError("guest bidding is disabled")
+	}
+	// Um usuário autenticado dá lances com a própria conta - apelido e usuário juntos seriam ambíguos
+	if _, authenticated := auth_context.UserIDFromContext(ctx); authenticated || bidInputDto.UserId != "" {
+		return nil, internal_error.NewBadRequestError("handle is only accepted on guest bids, without an authenticated user")
+	}
+
+	bidEntity, err := bid_entity.CreateGuestBid(bidInputDto.Id, bidInputDto.Handle, bidInputDto.AuctionId, float64(bidInputDto.Amount), bu.clock.Now())
+	if err != nil {
+		return nil, err
+	}
+
+	auction, err := bu.AuctionRepository.FindAuctionById(ctx, bidEntity.AuctionId)
+	if err != nil {
+		return nil, err
+	}
+	if !auction.AllowGuestBids {
+		return nil, internal_error.NewForbiddenError("this auction does not accept guest bids")
+	}
+
+	return bidEntity, nil
+}
@@ -0,0 +1,50 @@
+package bid_usecase
+
+import (
+	"context"
+	"testing"
+
+	"github.com/Guilherme-G-Cadilhe/Go-AuctionHouse_Concurrency-Server-Api/internal/auth_context"
+	"github.com/Guilherme-G-Cadilhe/Go-AuctionHouse_Concurrency-Server-Api/internal/entity/auction_entity"
+)
+
+func TestCreateGuestBidDisabled(t *testing.T) {
+	env := newTestEnv(t, testBidConfig())
+	auctionId := env.createAuction(t, func(a *auction_entity.Auction) { a.AllowGuestBids = true }).Id
+
+	_, err := env.useCase.CreateBid(context.Background(), BidInputDTO{AuctionId: auctionId, Handle: "bob", Amount: 10})
+	if err == nil || err.Err != "bad_request" {
+		t.Fatalf("guest bid with GUEST_BIDS_ENABLED=false: got %v, want bad_request", err)
+	}
+
+	// Com a flag desligada o lance comum segue igual
+	ctx := auth_context.WithUserID(context.Background(), testBidderId)
+	if _, err := env.useCase.CreateBid(ctx, BidInputDTO{AuctionId: auctionId, Amount: 10}); err != nil {
+		t.Fatalf("registered bid: %v", err)
+	}
+}
+
+func TestCreateGuestBidEnabled(t *testing.T) {
+	bidCfg := testBidConfig()
+	bidCfg.GuestBidsEnabled = true
+	env := newTestEnv(t, bidCfg)
+	guestAuction := env.createAuction(t, func(a *auction_entity.Auction) { a.AllowGuestBids = true }).Id
+	membersOnly := env.createAuction(t, nil).Id
+
+	output, err := env.useCase.CreateBid(context.Background(), BidInputDTO{AuctionId: guestAuction, Handle: "bob", Amount: 10})
+	if err != nil {
+		t.Fatalf("guest bid: %v", err)
+	}
+	if output.Handle != "bob" || output.UserId != "" {
+		t.Fatalf("guest bid output = %+v, want handle bob without user_id", output)
+	}
+
+	if _, err := env.useCase.CreateBid(context.Background(), BidInputDTO{AuctionId: membersOnly, Handle: "bob", Amount: 10}); err == nil || err.Err != "forbidden" {
+		t.Fatalf("guest bid on an auction without allow_guest_bids: got %v, want forbidden", err)
+	}
+
+	ctx := auth_context.WithUserID(context.Background(), testBidderId)
+	if _, err := env.useCase.CreateBid(ctx, BidInputDTO{AuctionId: guestAuction, Handle: "bob", Amount: 11}); err == nil || err.Err != "bad_request" {
+		t.Fatalf("handle with an authenticated user: got %v, want bad_request", err)
+	}
+}
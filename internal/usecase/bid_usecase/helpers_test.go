@@ -0,0 +1,105 @@
+package bid_usecase
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/Guilherme-G-Cadilhe/Go-AuctionHouse_Concurrency-Server-Api/configuration/config"
+	"github.com/Guilherme-G-Cadilhe/Go-AuctionHouse_Concurrency-Server-Api/internal/clock"
+	"github.com/Guilherme-G-Cadilhe/Go-AuctionHouse_Concurrency-Server-Api/internal/entity/auction_entity"
+	"github.com/Guilherme-G-Cadilhe/Go-AuctionHouse_Concurrency-Server-Api/internal/entity/bid_entity"
+	"github.com/Guilherme-G-Cadilhe/Go-AuctionHouse_Concurrency-Server-Api/internal/infra/database/memory"
+	"github.com/Guilherme-G-Cadilhe/Go-AuctionHouse_Concurrency-Server-Api/internal/sanitize"
+	"github.com/google/uuid"
+)
+
+const testBidderId = "22222222-2222-2222-2222-222222222222"
+
+// testAuctionConfig é a configuração de leilão usada pelos testes do caso de uso
+func testAuctionConfig() config.AuctionConfig {
+	return config.AuctionConfig{
+		Interval:        5 * time.Minute,
+		PersistWinner:   true,
+		MinBidIncrement: 1,
+		StartingPrice:   1,
+		FirstBidPolicy:  "any_positive",
+	}
+}
+
+// testBidConfig mantém o batch maior que os testes e o timer bem depois do fim do leilão
+func testBidConfig() config.BidConfig {
+	return config.BidConfig{
+		AmountMode:            "float",
+		MaxBatchSize:          10,
+		BatchInsertInterval:   time.Minute,
+		BatchFailureThreshold: 3,
+	}
+}
+
+// testEnv reúne os repositórios em memória, o relógio falso e o caso de uso ligados como no main
+type testEnv struct {
+	auctions *memory.AuctionRepository
+	bids     *memory.BidRepository
+	clock    *clock.FakeClock
+	useCase  *BidUseCase
+}
+
+func newTestEnv(t *testing.T, bidCfg config.BidConfig) *testEnv {
+	t.Helper()
+	auctionCfg := testAuctionConfig()
+	clk := clock.NewFake(time.Date(2024, 1, 2, 15, 0, 0, 0, time.UTC))
+	auctionRepository := memory.NewAuctionRepository(auctionCfg, clk)
+	bidRepository := memory.NewBidRepository(auctionRepository, auctionCfg, clk)
+	auctionRepository.SetWinningBidFinder(bidRepository.FindWinningBidByAuctionId)
+	auctionRepository.SetBidsDeleter(bidRepository.DeleteBidsByAuctionId)
+
+	useCase := NewBidUseCase(bidRepository, auctionRepository, bidCfg, auctionCfg, clk).(*BidUseCase)
+	t.Cleanup(func() {
+		useCase.Close(context.Background())
+	})
+
+	return &testEnv{auctions: auctionRepository, bids: bidRepository, clock: clk, useCase: useCase}
+}
+
+// createAuction grava um leilão ativo; edit ajusta os campos antes da gravação
+func (env *testEnv) createAuction(t *testing.T, edit func(*auction_entity.Auction)) *auction_entity.Auction {
+	t.Helper()
+	return createTestAuction(t, env.auctions, env.clock, edit)
+}
+
+func createTestAuction(t *testing.T, ar *memory.AuctionRepository, clk clock.Clock, edit func(*auction_entity.Auction)) *auction_entity.Auction {
+	t.Helper()
+	// Mesmo caminho da criação real: o horário vem do relógio injetado, não é preenchido à mão
+	auction, errBody := auction_entity.CreateAuctionBody("Phone X", "Electronics", "a nice phone here ok",
+		auction_entity.New, auction_entity.AuctionFieldBounds{}, sanitize.NewHTMLPolicy(nil), clk.Now())
+	if errBody != nil {
+		t.Fatalf("CreateAuctionBody: %v", errBody)
+	}
+	if edit != nil {
+		edit(auction)
+	}
+	if err := ar.CreateAuction(context.Background(), auction); err != nil {
+		t.Fatalf("CreateAuction: %v", err)
+	}
+	return auction
+}
+
+// storeBids grava os lances direto no repositório, sem passar pelo batch
+func (env *testEnv) storeBids(t *testing.T, auctionId string, amounts ...float64) {
+	t.Helper()
+	batch := make([]bid_entity.Bid, len(amounts))
+	for i, amount := range amounts {
+		batch[i] = bid_entity.Bid{
+			Id:        uuid.New().String(),
+			UserId:    testBidderId,
+			AuctionId: auctionId,
+			Amount:    amount,
+			Timestamp: env.clock.Now(),
+			Sequence:  int64(i + 1),
+		}
+	}
+	if _, err := env.bids.CreateBidBatch(context.Background(), batch); err != nil {
+		t.Fatalf("CreateBidBatch: %v", err)
+	}
+}
@@ -0,0 +1,136 @@
+package bid_usecase
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/Guilherme-G-Cadilhe/Go-AuctionHouse_Concurrency-Server-Api/internal/clock"
+	"github.com/Guilherme-G-Cadilhe/Go-AuctionHouse_Concurrency-Server-Api/internal/entity/bid_entity"
+)
+
+// nearEndFlush antecipa o flush quando um lance no batch é de um leilão prestes a fechar
+// Sem isso um lance aceito com 201 poderia esperar o BATCH_INSERT_INTERVAL inteiro no buffer,
+// passar do fim do leilão e ser descartado no flush
+type nearEndFlush struct {
+	window time.Duration // BATCH_NEAR_END_FLUSH - o flush acontece até window antes do fim (0 = desabilitado)
+
+	// Fim de cada leilão com lances recentes, carregado pelo CreateBid (request) e lido pela goroutine do batch
+	// Cache adiantado é inofensivo: uma extensão só faria o flush acontecer mais cedo
+	endTimes  map[string]time.Time
+	lastSweep time.Time
+	mutex     *sync.Mutex
+
+	// Só a goroutine do batch acessa: timer do flush antecipado e o instante para o qual está armado
+	timer   clock.Timer
+	flushAt time.Time
+}
+
+func newNearEndFlush(window time.Duration, clk clock.Clock) *nearEndFlush {
+	n := &nearEndFlush{
+		window:    window,
+		endTimes:  make(map[string]time.Time),
+		lastSweep: clk.Now(),
+		mutex:     &sync.Mutex{},
+	}
+	if window > 0 {
+		// Criado parado - só é armado quando chega um lance perto do fim
+		n.timer = clk.NewTimer(window)
+		n.timer.Stop()
+	}
+	return n
+}
+
+func (n *nearEndFlush) enabled() bool {
+	return n.window > 0
+}
+
+// timerC é o channel do timer no select da goroutine; nil (nunca dispara) quando desabilitado
+func (n *nearEndFlush) timerC() <-chan time.Time {
+	if n.timer == nil {
+		return nil
+	}
+	return n.timer.C()
+}
+
+// loadEndTime garante o fim do leilão no cache antes de o lance entrar no channel
+// Roda na request: a goroutine do batch nunca consulta o banco para decidir o flush
+// Entrada já vencida é recarregada - o leilão pode ter sido estendido ou reaberto
+func (bu *BidUseCase) loadEndTime(ctx context.Context, auctionId string, now time.Time) {
+	n := bu.nearEnd
+	if !n.enabled() {
+		return
+	}
+
+	n.mutex.Lock()
+	endTime, ok := n.endTimes[auctionId]
+	n.sweep(now)
+	n.mutex.Unlock()
+	if ok && endTime.After(now) {
+		return
+	}
+
+	// Erro (ex: leilão inexistente) só desliga o flush antecipado deste lance - o flush decide o destino dele
+	auction, err := bu.AuctionRepository.FindAuctionById(ctx, auctionId)
+	if err != nil {
+		return
+	}
+
+	n.mutex.Lock()
+	n.endTimes[auctionId] = auction.EndTime
+	n.mutex.Unlock()
+}
+
+// sweep remove leilões já encerrados, no máximo uma vez por window (chamado sob o mutex)
+func (n *nearEndFlush) sweep(now time.Time) {
+	if now.Sub(n.lastSweep) < n.window {
+		return
+	}
+	for auctionId, endTime := range n.endTimes {
+		if !endTime.After(now) {
+			delete(n.endTimes, auctionId)
+		}
+	}
+	n.lastSweep = now
+}
+
+// armFor é chamado pela goroutine do batch a cada lance recebido
+// Retorna true quando o leilão já está dentro da janela: o batch deve ser gravado agora
+// Caso contrário arma o timer para o início da janela, se for antes do disparo já armado
+func (n *nearEndFlush) armFor(bid bid_entity.Bid, now time.Time) bool {
+	if !n.enabled() {
+		return false
+	}
+
+	n.mutex.Lock()
+	endTime, ok := n.endTimes[bid.AuctionId]
+	n.mutex.Unlock()
+	if !ok {
+		return false
+	}
+
+	flushAt := endTime.Add(-n.window)
+	if !flushAt.After(now) {
+		return true
+	}
+	if n.flushAt.IsZero() || flushAt.Before(n.flushAt) {
+		n.flushAt = flushAt
+		n.timer.Reset(flushAt.Sub(now))
+	}
+	return false
+}
+
+// fired registra o disparo do timer - o próximo lance perto do fim arma de novo
+func (n *nearEndFlush) fired() {
+	n.flushAt = time.Time{}
+}
+
+// stop para o timer no encerramento (mesma regra do timer do batch)
+func (n *nearEndFlush) stop() {
+	if n.timer != nil && !n.timer.Stop() {
+		select {
+		case <-n.timer.C():
+		default:
+		}
+	}
+}
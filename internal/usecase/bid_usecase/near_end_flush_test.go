@@ -0,0 +1,89 @@
+package bid_usecase
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/Guilherme-G-Cadilhe/Go-AuctionHouse_Concurrency-Server-Api/configuration/config"
+	"github.com/Guilherme-G-Cadilhe/Go-AuctionHouse_Concurrency-Server-Api/internal/auth_context"
+	"github.com/Guilherme-G-Cadilhe/Go-AuctionHouse_Concurrency-Server-Api/internal/entity/auction_entity"
+)
+
+// nearEndBidConfig deixa o flush regular bem depois do fim dos leilões - só o flush antecipado grava
+func nearEndBidConfig() config.BidConfig {
+	bidCfg := testBidConfig()
+	bidCfg.BatchInsertInterval = 10 * time.Minute
+	bidCfg.NearEndFlushWindow = 2 * time.Second
+	return bidCfg
+}
+
+func waitForStoredBids(t *testing.T, env *testEnv, auctionIds []string, want int) {
+	t.Helper()
+	deadline := time.Now().Add(time.Second)
+	for countStoredBids(t, env, auctionIds) != want {
+		if time.Now().After(deadline) {
+			t.Fatalf("stored bids = %d, want %d", countStoredBids(t, env, auctionIds), want)
+		}
+		time.Sleep(time.Millisecond)
+	}
+}
+
+// Lance de um leilão já dentro da janela é gravado na hora, sem esperar o BATCH_INSERT_INTERVAL
+func TestNearEndFlushWritesBidInsideWindow(t *testing.T) {
+	env := newTestEnv(t, nearEndBidConfig())
+	auctionId := env.createAuction(t, func(a *auction_entity.Auction) {
+		a.EndTime = env.clock.Now().Add(time.Second)
+	}).Id
+
+	ctx := auth_context.WithUserID(context.Background(), testBidderId)
+	if _, err := env.useCase.CreateBid(ctx, BidInputDTO{AuctionId: auctionId, Amount: 10}); err != nil {
+		t.Fatalf("CreateBid: %v", err)
+	}
+	waitForStoredBids(t, env, []string{auctionId}, 1)
+}
+
+// Fora da janela o lance só arma o timer: o flush acontece no início da janela, antes do fim do leilão
+func TestNearEndFlushTimerFiresAtWindowStart(t *testing.T) {
+	env := newTestEnv(t, nearEndBidConfig())
+	closing := env.createAuction(t, func(a *auction_entity.Auction) {
+		a.EndTime = env.clock.Now().Add(3 * time.Minute)
+	}).Id
+	regular := env.createAuction(t, nil).Id
+
+	ctx := auth_context.WithUserID(context.Background(), testBidderId)
+	for _, auctionId := range []string{closing, regular} {
+		if _, err := env.useCase.CreateBid(ctx, BidInputDTO{AuctionId: auctionId, Amount: 10}); err != nil {
+			t.Fatalf("CreateBid: %v", err)
+		}
+	}
+	// O segundo lance só entra no batch depois de a goroutine armar o timer para o primeiro
+	waitForBatchSize(t, env.useCase, 2)
+
+	env.clock.Advance(3*time.Minute - 3*time.Second)
+	if stored := countStoredBids(t, env, []string{closing, regular}); stored != 0 {
+		t.Fatalf("batch flushed %d bids before the near-end window", stored)
+	}
+
+	// O flush antecipado grava o batch inteiro, inclusive o lance do leilão que não está para fechar
+	env.clock.Advance(time.Second)
+	waitForStoredBids(t, env, []string{closing, regular}, 2)
+}
+
+func TestNearEndFlushDisabled(t *testing.T) {
+	bidCfg := nearEndBidConfig()
+	bidCfg.NearEndFlushWindow = 0
+	env := newTestEnv(t, bidCfg)
+	auctionId := env.createAuction(t, func(a *auction_entity.Auction) {
+		a.EndTime = env.clock.Now().Add(time.Second)
+	}).Id
+
+	ctx := auth_context.WithUserID(context.Background(), testBidderId)
+	if _, err := env.useCase.CreateBid(ctx, BidInputDTO{AuctionId: auctionId, Amount: 10}); err != nil {
+		t.Fatalf("CreateBid: %v", err)
+	}
+	waitForBatchSize(t, env.useCase, 1)
+	if stored := countStoredBids(t, env, []string{auctionId}); stored != 0 {
+		t.Fatalf("got %d stored bids with the near-end flush disabled, want 0", stored)
+	}
+}
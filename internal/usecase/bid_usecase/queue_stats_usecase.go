@@ -0,0 +1,34 @@
+package bid_usecase
+
+import (
+	"time"
+)
+
+// QueueStatsOutputDTO mostra o quanto o pipeline de lances está acumulado
+// TimeSinceLastFlushMs e LastFlushAt ficam nulos até o primeiro flush
+type QueueStatsOutputDTO struct {
+	ChannelLength        int        `json:"channel_length"`
+	ChannelCapacity      int        `json:"channel_capacity"`
+	BatchSize            int        `json:"batch_size"`
+	LastFlushAt          *time.Time `json:"last_flush_at"`
+	TimeSinceLastFlushMs *int64     `json:"time_since_last_flush_ms"`
+}
+
+// QueueStats lê o estado atual da fila de lances
+// len/cap de channel são seguros entre goroutines; batch e último flush vêm dos espelhos atômicos
+func (bu *BidUseCase) QueueStats() QueueStatsOutputDTO {
+	stats := QueueStatsOutputDTO{
+		ChannelLength:   len(bu.bidChannel),
+		ChannelCapacity: cap(bu.bidChannel),
+		BatchSize:       int(bu.batchSize.Load()),
+	}
+
+	if lastFlush := bu.lastFlushAt.Load(); lastFlush != 0 {
+		lastFlushAt := time.Unix(0, lastFlush)
+		sinceMs := bu.clock.Now().Sub(lastFlushAt).Milliseconds()
+		stats.LastFlushAt = &lastFlushAt
+		stats.TimeSinceLastFlushMs = &sinceMs
+	}
+
+	return stats
+}
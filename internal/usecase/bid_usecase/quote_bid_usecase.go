@@ -0,0 +1,161 @@
+package bid_usecase
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/Guilherme-G-Cadilhe/Go-AuctionHouse_Concurrency-Server-Api/configuration/config"
+	"github.com/Guilherme-G-Cadilhe/Go-AuctionHouse_Concurrency-Server-Api/configuration/tracing"
+	"github.com/Guilherme-G-Cadilhe/Go-AuctionHouse_Concurrency-Server-Api/internal/entity/auction_entity"
+	"github.com/Guilherme-G-Cadilhe/Go-AuctionHouse_Concurrency-Server-Api/internal/entity/bid_entity"
+	"github.com/Guilherme-G-Cadilhe/Go-AuctionHouse_Concurrency-Server-Api/internal/internal_error"
+)
+
+// BidQuoteOutputDTO é a resposta do POST /bid/quote - nada é gravado
+// Reason explica a rejeição; NextMinimum é o mesmo next_minimum_bid do GET /auctions/:auctionId
+type BidQuoteOutputDTO struct {
+	Accepted       bool     `json:"accepted"`
+	Reason         string   `json:"reason,omitempty"`
+	WouldBeWinning bool     `json:"wouldBeWinning"`
+	NextMinimum    *float64 `json:"nextMinimum,omitempty"`
+}
+
+// bidQuoteRules são as regras do flush (repositórios) que a cotação precisa repetir de forma síncrona
+type bidQuoteRules struct {
+	closeSkew         time.Duration // AUCTION_CLOSE_SKEW
+	maxBidsPerAuction int           // MAX_BIDS_PER_AUCTION (0 = ilimitado)
+	startingPrice     float64       // AUCTION_STARTING_PRICE
+	minBidIncrement   float64       // AUCTION_MIN_BID_INCREMENT
+	requiredIncrement float64       // MIN_BID_INCREMENT
+	firstBidMinimum   float64       // FIRST_BID_POLICY já resolvido
+}
+
+func newBidQuoteRules(cfg config.AuctionConfig) bidQuoteRules {
+	return bidQuoteRules{
+		closeSkew:         cfg.CloseSkew,
+		maxBidsPerAuction: cfg.MaxBidsPerAuction,
+		startingPrice:     cfg.StartingPrice,
+		minBidIncrement:   cfg.MinBidIncrement,
+		requiredIncrement: cfg.RequiredIncrement,
+		firstBidMinimum:   bid_entity.ParseFirstBidPolicy(cfg.FirstBidPolicy).MinimumFirstBid(cfg.StartingPrice, cfg.MinBidIncrement),
+	}
+}
+
+// QuoteBid é o "dry-run" do POST /bid: mesma entrada e mesmas validações, mas a comparação com o
+// vencedor atual é feita na hora e nada é enfileirado
+// Regras de negócio que recusariam o lance viram accepted=false com o motivo;
+// autenticação, leilão inexistente e sealed-bid continuam sendo erros HTTP
+// A resposta reflete o momento da consulta - outro lance pode chegar antes do envio real
+func (bu *BidUseCase) QuoteBid(ctx context.Context, bidInputDto BidInputDTO) (*BidQuoteOutputDTO, *internal_error.InternalError) {
+	ctx, span := tracing.Start(ctx, "BidUseCase.QuoteBid")
+	defer span.End()
+
+	var bidEntity *bid_entity.Bid
+	var err *internal_error.InternalError
+	if bidInputDto.Handle != "" {
+		bidEntity, err = bu.createGuestBid(ctx, bidInputDto)
+	} else {
+		bidEntity, err = bu.createUserBid(ctx, bidInputDto)
+	}
+	if err != nil {
+		// Validação do lance (valor, ids, apelido) é exatamente o que a cotação responde
+		if err.Err == "bad_request" {
+			return rejectedQuote(err.Message, nil), nil
+		}
+		return nil, err
+	}
+
+	auction, err := bu.AuctionRepository.FindAuctionById(ctx, bidEntity.AuctionId)
+	if err != nil {
+		return nil, err
+	}
+	// Sealed-bid: a posição revelaria o lance vencedor, como no GET /auctions/:auctionId/price
+	if auction.Sealed && auction.Status == auction_entity.Active {
+		return nil, internal_error.NewForbiddenError("bids of a sealed auction are hidden until it closes")
+	}
+	if !auction_entity.IsOpenAt(auction.Status, auction.EndTime, bu.quoteRules.closeSkew, bidEntity.Timestamp) {
+		return rejectedQuote("auction is closed", nil), nil
+	}
+
+	// Consulta sem reservar: a cotação não consome o intervalo do BID_COOLDOWN
+	if retryAfter := bu.cooldown.remaining(bidEntity.BidderKey(), bidEntity.AuctionId, bidEntity.Timestamp); retryAfter > 0 {
+		return rejectedQuote(fmt.Sprintf("bid cooldown: wait %s before bidding again on this auction", retryAfter.Round(time.Millisecond)), nil), nil
+	}
+
+	if err := bu.checkAmountGuard(ctx, bidEntity, bidInputDto.Confirm); err != nil {
+		if err.Err == "bad_request" {
+			return rejectedQuote(err.Message, nil), nil
+		}
+		return nil, err
+	}
+
+	if auction.IsDutch() {
+		return bu.quoteDutchBid(auction, bidEntity), nil
+	}
+	return bu.quoteBid(ctx, auction, bidEntity)
+}
+
+// quoteDutchBid: no holandês o primeiro lance a partir do preço atual arremata o leilão
+func (bu *BidUseCase) quoteDutchBid(auction *auction_entity.Auction, bid *bid_entity.Bid) *BidQuoteOutputDTO {
+	currentPrice := auction.DutchPriceAt(bid.Timestamp)
+	if bid_entity.CompareAmounts(bid.Amount, currentPrice) < 0 {
+		return rejectedQuote(fmt.Sprintf("amount is below the dutch auction current price of %v", currentPrice), &currentPrice)
+	}
+	return &BidQuoteOutputDTO{Accepted: true, WouldBeWinning: true, NextMinimum: &currentPrice}
+}
+
+// quoteBid repete as regras do CreateBidBatch (FIRST_BID_POLICY, MIN_BID_INCREMENT, tabela de incrementos e MAX_BIDS_PER_AUCTION) contra o vencedor atual
+func (bu *BidUseCase) quoteBid(ctx context.Context, auction *auction_entity.Auction, bid *bid_entity.Bid) (*BidQuoteOutputDTO, *internal_error.InternalError) {
+	winningBid, err := bu.BidRepository.FindWinningBidByAuctionId(ctx, bid.AuctionId)
+	if err != nil {
+		// not_found = leilão sem lances; qualquer outro erro é propagado
+		if err.Err != "not_found" {
+			return nil, err
+		}
+		winningBid = nil
+	}
+
+	rules := bu.quoteRules
+	increment := rules.minBidIncrement
+	if winningBid != nil {
+		increment = auction.BidIncrementAt(winningBid.Amount, rules.minBidIncrement)
+	}
+	nextMinimum := bid_entity.NextMinimumBid(winningBid, rules.startingPrice, increment, rules.firstBidMinimum)
+
+	if winningBid == nil {
+		if rules.firstBidMinimum > 0 && !bid.MeetsMinimum(rules.firstBidMinimum) {
+			return rejectedQuote(fmt.Sprintf("first bid must be at least %v", rules.firstBidMinimum), &nextMinimum), nil
+		}
+		// O primeiro lance aceito é o vencedor
+		return &BidQuoteOutputDTO{Accepted: true, WouldBeWinning: true, NextMinimum: &nextMinimum}, nil
+	}
+
+	// Com tabela de incrementos, o lance precisa cobrir o vencedor + incremento da faixa
+	if auction.HasIncrementSchedule() && !bid.MeetsMinimum(nextMinimum) {
+		return rejectedQuote(fmt.Sprintf("bid must be at least %v (winning bid plus the increment of its tier)", nextMinimum), &nextMinimum), nil
+	}
+	// Sem tabela, o lance precisa superar o vencedor pelo MIN_BID_INCREMENT (0 = basta ser maior)
+	if !auction.HasIncrementSchedule() && !bid.BeatsWinningBid(winningBid.Amount, rules.requiredIncrement) {
+		return rejectedQuote(fmt.Sprintf("bid must beat the winning bid of %v by at least %v", winningBid.Amount, rules.requiredIncrement), &nextMinimum), nil
+	}
+
+	// Empate perde: o lance já gravado foi submetido antes (Bid.Outranks)
+	wouldBeWinning := bid.IsHigherThan(winningBid)
+
+	if rules.maxBidsPerAuction > 0 && !wouldBeWinning {
+		count, err := bu.BidRepository.CountBidsByAuctionId(ctx, bid.AuctionId)
+		if err != nil {
+			return nil, err
+		}
+		if count >= int64(rules.maxBidsPerAuction) {
+			return rejectedQuote("auction reached the maximum number of bids, only bids above the winning bid are accepted", &nextMinimum), nil
+		}
+	}
+
+	return &BidQuoteOutputDTO{Accepted: true, WouldBeWinning: wouldBeWinning, NextMinimum: &nextMinimum}, nil
+}
+
+func rejectedQuote(reason string, nextMinimum *float64) *BidQuoteOutputDTO {
+	return &BidQuoteOutputDTO{Accepted: false, Reason: reason, NextMinimum: nextMinimum}
+}
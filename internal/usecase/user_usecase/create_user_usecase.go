@@ -4,19 +4,24 @@ package user_usecase
 import (
 	"context"
 
+	"github.com/Guilherme-G-Cadilhe/Go-AuctionHouse_Concurrency-Server-Api/configuration/tracing"
 	"github.com/Guilherme-G-Cadilhe/Go-AuctionHouse_Concurrency-Server-Api/internal/entity/user_entity"
 	"github.com/Guilherme-G-Cadilhe/Go-AuctionHouse_Concurrency-Server-Api/internal/internal_error"
 )
 
 // DTO para input de criação
 type UserInputDTO struct {
-	Name string `json:"name" binding:"required"` // binding:"required" = validação obrigatória
+	Name  string `json:"name" binding:"required"`         // binding:"required" = validação obrigatória
+	Email string `json:"email" binding:"omitempty,email"` // Opcional - se enviado deve ser um e-mail válido
 }
 
 // CreateUser implementa criação de usuário
 func (uc *UserUseCase) CreateUser(ctx context.Context, userInput UserInputDTO) (*UserOutputDTO, *internal_error.InternalError) {
+	ctx, span := tracing.Start(ctx, "UserUseCase.CreateUser")
+	defer span.End()
+
 	// Cria entidade usando factory function
-	user := user_entity.CreateUser(userInput.Name)
+	user := user_entity.CreateUser(userInput.Name, userInput.Email)
 
 	// Chama repository para persistir
 	err := uc.UserRepository.CreateUser(ctx, user)
@@ -26,7 +31,8 @@ func (uc *UserUseCase) CreateUser(ctx context.Context, userInput UserInputDTO) (
 
 	// Retorna DTO do usuário criado
 	return &UserOutputDTO{
-		Id:   user.Id,
-		Name: user.Name,
+		Id:    user.Id,
+		Name:  user.Name,
+		Email: user.Email,
 	}, nil
 }
@@ -0,0 +1,25 @@
+package user_usecase
+
+import (
+	"context"
+
+	"github.com/Guilherme-G-Cadilhe/Go-AuctionHouse_Concurrency-Server-Api/configuration/tracing"
+	"github.com/Guilherme-G-Cadilhe/Go-AuctionHouse_Concurrency-Server-Api/internal/internal_error"
+)
+
+// DeleteUser remove o usuário preservando o histórico de lances
+// Com ANONYMIZE_DELETED_USER_BIDS=true os lances passam a apontar para o tombstone "deleted"
+func (uc *UserUseCase) DeleteUser(ctx context.Context, id string) *internal_error.InternalError {
+	ctx, span := tracing.Start(ctx, "UserUseCase.DeleteUser")
+	defer span.End()
+
+	if err := uc.UserRepository.DeleteUser(ctx, id); err != nil {
+		return err
+	}
+
+	if !uc.anonymizeDeletedUserBids {
+		return nil
+	}
+
+	return uc.BidRepository.AnonymizeBidsByUserId(ctx, id)
+}
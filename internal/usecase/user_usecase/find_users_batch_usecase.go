@@ -0,0 +1,77 @@
+package user_usecase
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Guilherme-G-Cadilhe/Go-AuctionHouse_Concurrency-Server-Api/configuration/tracing"
+	"github.com/Guilherme-G-Cadilhe/Go-AuctionHouse_Concurrency-Server-Api/internal/internal_error"
+	"github.com/google/uuid"
+)
+
+// UserBatchInputDTO é o corpo do POST /users/batch: {"ids": ["<uuid>", ...]}
+type UserBatchInputDTO struct {
+	Ids []string `json:"ids" binding:"required"`
+}
+
+// FindUsersByIds busca vários usuários em uma única query (ex: nomes dos participantes de um leilão)
+// Ids inexistentes são omitidos; a resposta segue a ordem dos ids enviados, sem repetições
+func (uc *UserUseCase) FindUsersByIds(ctx context.Context, input UserBatchInputDTO) ([]UserOutputDTO, *internal_error.InternalError) {
+	ctx, span := tracing.Start(ctx, "UserUseCase.FindUsersByIds")
+	defer span.End()
+
+	if err := validateUserBatch(input.Ids); err != nil {
+		return nil, err
+	}
+
+	users, err := uc.UserRepository.FindUsersByIds(ctx, input.Ids)
+	if err != nil {
+		return nil, err
+	}
+
+	// O $in não garante ordem - indexa por id para responder na ordem pedida
+	usersById := make(map[string]UserOutputDTO, len(users))
+	for _, user := range users {
+		usersById[user.Id] = UserOutputDTO{
+			Id:    user.Id,
+			Name:  user.Name,
+			Email: user.Email,
+		}
+	}
+
+	output := make([]UserOutputDTO, 0, len(usersById))
+	for _, id := range input.Ids {
+		if user, ok := usersById[id]; ok {
+			output = append(output, user)
+			delete(usersById, id) // Ids repetidos aparecem uma única vez
+		}
+	}
+	return output, nil
+}
+
+// validateUserBatch exige ao menos 1 id, todos UUIDs válidos
+// O máximo (BATCH_MAX_ITEMS) é checado no handler, antes de chegar aqui
+// Cada id inválido gera uma causa própria (ex: "ids[3]")
+func validateUserBatch(ids []string) *internal_error.InternalError {
+	if len(ids) == 0 {
+		return internal_error.NewBadRequestError("invalid fields", internal_error.Cause{
+			Field:   "ids",
+			Message: "ids must contain at least 1 user id",
+		})
+	}
+
+	var causes []internal_error.Cause
+	for i, id := range ids {
+		if err := uuid.Validate(id); err != nil {
+			causes = append(causes, internal_error.Cause{
+				Field:   fmt.Sprintf("ids[%d]", i),
+				Message: "Invalid UUID Value",
+			})
+		}
+	}
+
+	if len(causes) > 0 {
+		return internal_error.NewBadRequestError("invalid fields", causes...)
+	}
+	return nil
+}
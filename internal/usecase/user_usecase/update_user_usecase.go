@@ -0,0 +1,34 @@
+package user_usecase
+
+import (
+	"context"
+
+	"github.com/Guilherme-G-Cadilhe/Go-AuctionHouse_Concurrency-Server-Api/configuration/tracing"
+	"github.com/Guilherme-G-Cadilhe/Go-AuctionHouse_Concurrency-Server-Api/internal/entity/user_entity"
+	"github.com/Guilherme-G-Cadilhe/Go-AuctionHouse_Concurrency-Server-Api/internal/internal_error"
+)
+
+// DTO para input de atualização
+type UserUpdateInputDTO struct {
+	Name string `json:"name" binding:"required,min=2,max=100"`
+}
+
+// UpdateUser atualiza o nome do usuário e retorna o usuário atualizado
+func (uc *UserUseCase) UpdateUser(ctx context.Context, id string, userInput UserUpdateInputDTO) (*UserOutputDTO, *internal_error.InternalError) {
+	ctx, span := tracing.Start(ctx, "UserUseCase.UpdateUser")
+	defer span.End()
+
+	user := &user_entity.User{
+		Id:   id,
+		Name: userInput.Name,
+	}
+
+	if err := uc.UserRepository.UpdateUser(ctx, user); err != nil {
+		return nil, err
+	}
+
+	return &UserOutputDTO{
+		Id:   user.Id,
+		Name: user.Name,
+	}, nil
+}
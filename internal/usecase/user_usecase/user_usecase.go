@@ -6,6 +6,9 @@ package user_usecase
 import (
 	"context"
 
+	"github.com/Guilherme-G-Cadilhe/Go-AuctionHouse_Concurrency-Server-Api/configuration/config"
+	"github.com/Guilherme-G-Cadilhe/Go-AuctionHouse_Concurrency-Server-Api/configuration/tracing"
+	"github.com/Guilherme-G-Cadilhe/Go-AuctionHouse_Concurrency-Server-Api/internal/entity/bid_entity"
 	"github.com/Guilherme-G-Cadilhe/Go-AuctionHouse_Concurrency-Server-Api/internal/entity/user_entity"
 	"github.com/Guilherme-G-Cadilhe/Go-AuctionHouse_Concurrency-Server-Api/internal/internal_error"
 )
@@ -17,6 +20,10 @@ type UserUseCase struct {
 	// UserRepository é a interface, não a implementação concreta
 	// Isso permite injetar diferentes implementações (MongoDB, PostgreSQL, Mock para testes)
 	UserRepository user_entity.UserRepositoryInterface
+	// BidRepository é usado para anonimizar os lances de usuários removidos
+	BidRepository bid_entity.BidEntityRepository
+	// anonymizeDeletedUserBids troca o user_id dos lances de usuários removidos por "deleted"
+	anonymizeDeletedUserBids bool
 }
 
 // UserOutputDTO (Data Transfer Object) define como os dados do usuário serão expostos
@@ -24,13 +31,16 @@ type UserUseCase struct {
 // DTO separa representação interna (entidade) da externa (API)
 // No Node.js seria como ter um "serializer" ou "transformer"
 type UserOutputDTO struct {
-	Id   string `json:"id"`   // Campo "id" no JSON de resposta
-	Name string `json:"name"` // Campo "name" no JSON de resposta
+	Id    string `json:"id"`              // Campo "id" no JSON de resposta
+	Name  string `json:"name"`            // Campo "name" no JSON de resposta
+	Email string `json:"email,omitempty"` // Omitido quando o usuário não cadastrou e-mail
 }
 
-func NewUserUseCase(userRepository user_entity.UserRepositoryInterface) UserUseCaseInterface {
+func NewUserUseCase(userRepository user_entity.UserRepositoryInterface, bidRepository bid_entity.BidEntityRepository, cfg config.UserConfig) UserUseCaseInterface {
 	return &UserUseCase{
-		userRepository,
+		UserRepository:           userRepository,
+		BidRepository:            bidRepository,
+		anonymizeDeletedUserBids: cfg.AnonymizeDeletedUserBids,
 	}
 }
 
@@ -42,12 +52,18 @@ type UserUseCaseInterface interface {
 	// Retorna DTO (não a entidade) para controlar o que é exposto
 	FindUserById(ctx context.Context, id string) (*UserOutputDTO, *internal_error.InternalError)
 	CreateUser(ctx context.Context, userInput UserInputDTO) (*UserOutputDTO, *internal_error.InternalError)
+	UpdateUser(ctx context.Context, id string, userInput UserUpdateInputDTO) (*UserOutputDTO, *internal_error.InternalError)
+	DeleteUser(ctx context.Context, id string) *internal_error.InternalError
+	FindUsersByIds(ctx context.Context, input UserBatchInputDTO) ([]UserOutputDTO, *internal_error.InternalError)
 }
 
 // FindUserById implementa o caso de uso de busca de usuário
 // METHOD RECEIVER "(uc *UserUseCase)" vincula este método à struct UserUseCase
 // Esta função orquestra a operação: chama repository, trata erros, converte para DTO
 func (uc *UserUseCase) FindUserById(ctx context.Context, id string) (*UserOutputDTO, *internal_error.InternalError) {
+	ctx, span := tracing.Start(ctx, "UserUseCase.FindUserById")
+	defer span.End()
+
 	// Chama o repository através da interface (DEPENDÊNCIA INVERTIDA)
 	// Não sabemos se é MongoDB, PostgreSQL, etc. - só sabemos que implementa a interface
 	user, err := uc.UserRepository.FindUserById(ctx, id)
@@ -61,8 +77,9 @@ func (uc *UserUseCase) FindUserById(ctx context.Context, id string) (*UserOutput
 	// Esta conversão garante que apenas os dados necessários sejam expostos na API
 	// É como fazer um "user.toJSON()" customizado no Node.js
 	return &UserOutputDTO{
-		Id:   user.Id,
-		Name: user.Name,
+		Id:    user.Id,
+		Name:  user.Name,
+		Email: user.Email,
 	}, nil
 }
 